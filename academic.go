@@ -0,0 +1,130 @@
+package chronogo
+
+import (
+	"sort"
+	"time"
+)
+
+// AcademicTerm is a named teaching period such as "Fall 2024" or "Hilary", covering
+// whole days from the start of Start's day to the end of End's day.
+type AcademicTerm struct {
+	Name  string
+	Start DateTime // first day of the term, at midnight
+	End   DateTime // last day of the term, at 23:59:59.999999999
+}
+
+// Period returns the term as a Period.
+func (t AcademicTerm) Period() Period {
+	return Period{Start: t.Start, End: t.End}
+}
+
+// Weeks returns the number of weeks in the term, counting a final partial week.
+func (t AcademicTerm) Weeks() int {
+	return calendarDaysBetween(t.Start, t.End)/7 + 1
+}
+
+// AcademicCalendar is a set of academic terms, such as the semesters or quarters of
+// a school year, for schools whose terms do not line up with calendar or fiscal
+// quarters. Term dates are calendar days in the calendar's location, and the time
+// between two consecutive terms is a break.
+//
+// Example:
+//
+//	cal := chronogo.NewAcademicCalendar(time.UTC).
+//	    AddTerm("Fall 2024", chronogo.UTC(2024, 8, 26, 0, 0, 0, 0), chronogo.UTC(2024, 12, 13, 0, 0, 0, 0)).
+//	    AddTerm("Spring 2025", chronogo.UTC(2025, 1, 13, 0, 0, 0, 0), chronogo.UTC(2025, 5, 2, 0, 0, 0, 0))
+//	term, _ := cal.TermOf(chronogo.UTC(2024, 10, 1, 0, 0, 0, 0)) // Fall 2024
+type AcademicCalendar struct {
+	location *time.Location
+	terms    []AcademicTerm
+}
+
+// NewAcademicCalendar creates an empty calendar whose term dates are interpreted in
+// loc (UTC when loc is nil).
+func NewAcademicCalendar(loc *time.Location) *AcademicCalendar {
+	if loc == nil {
+		loc = time.UTC
+	}
+	return &AcademicCalendar{location: loc}
+}
+
+// AddTerm adds a term running from the calendar day of start through the calendar
+// day of end, both taken in the calendar's location, and returns the calendar for
+// chaining. Terms are kept in date order; a term whose end is before its start is
+// ignored.
+func (c *AcademicCalendar) AddTerm(name string, start, end DateTime) *AcademicCalendar {
+	start, end = start.In(c.location), end.In(c.location)
+	if calendarDaysBetween(start, end) < 0 {
+		return c
+	}
+	c.terms = append(c.terms, AcademicTerm{
+		Name:  name,
+		Start: DateTime{midnight(start.Year(), start.Month(), start.Day(), c.location)},
+		End:   end.EndOfDay(),
+	})
+	sort.SliceStable(c.terms, func(i, j int) bool {
+		return c.terms[i].Start.Before(c.terms[j].Start)
+	})
+	return c
+}
+
+// Location returns the location the calendar's term dates are interpreted in.
+func (c *AcademicCalendar) Location() *time.Location {
+	return c.location
+}
+
+// Terms returns the calendar's terms in date order.
+func (c *AcademicCalendar) Terms() []AcademicTerm {
+	return append([]AcademicTerm(nil), c.terms...)
+}
+
+// TermOf returns the term containing dt's calendar day in the calendar's location,
+// or false if dt is outside every term.
+func (c *AcademicCalendar) TermOf(dt DateTime) (AcademicTerm, bool) {
+	local := dt.In(c.location)
+	for _, term := range c.terms {
+		if calendarDaysBetween(term.Start, local) >= 0 && calendarDaysBetween(local, term.End) >= 0 {
+			return term, true
+		}
+	}
+	return AcademicTerm{}, false
+}
+
+// IsTermBreak reports whether dt falls between two terms, such as a winter or
+// spring break. Days before the first term and after the last are not breaks.
+func (c *AcademicCalendar) IsTermBreak(dt DateTime) bool {
+	if len(c.terms) == 0 {
+		return false
+	}
+	if _, ok := c.TermOf(dt); ok {
+		return false
+	}
+	local := dt.In(c.location)
+	return calendarDaysBetween(c.terms[0].Start, local) > 0 && calendarDaysBetween(local, c.terms[len(c.terms)-1].End) > 0
+}
+
+// WeekOfTerm returns the week of the term containing dt, counting the term's first
+// seven days as week 1, or 0 if dt is outside every term.
+//
+// Example:
+//
+//	cal.WeekOfTerm(chronogo.UTC(2024, 9, 3, 0, 0, 0, 0)) // 2 (Fall 2024 began Aug 26)
+func (c *AcademicCalendar) WeekOfTerm(dt DateTime) int {
+	term, ok := c.TermOf(dt)
+	if !ok {
+		return 0
+	}
+	return calendarDaysBetween(term.Start, dt.In(c.location))/7 + 1
+}
+
+// NextTerm returns the first term starting after dt's calendar day, or false if
+// there is none.
+func (c *AcademicCalendar) NextTerm(dt DateTime) (AcademicTerm, bool) {
+	local := dt.In(c.location)
+	for _, term := range c.terms {
+		if calendarDaysBetween(local, term.Start) > 0 {
+			return term, true
+		}
+	}
+	return AcademicTerm{}, false
+}
@@ -0,0 +1,58 @@
+package chronogo
+
+import (
+	"testing"
+	"time"
+)
+
+func TestAcademicCalendar(t *testing.T) {
+	ny, _ := time.LoadLocation("America/New_York")
+	cal := NewAcademicCalendar(ny).
+		AddTerm("Spring 2025", Date(2025, time.January, 13, 0, 0, 0, 0, ny), Date(2025, time.May, 2, 0, 0, 0, 0, ny)).
+		AddTerm("Fall 2024", Date(2024, time.August, 26, 0, 0, 0, 0, ny), Date(2024, time.December, 13, 0, 0, 0, 0, ny)).
+		AddTerm("Backwards", Date(2025, time.June, 1, 0, 0, 0, 0, ny), Date(2025, time.May, 1, 0, 0, 0, 0, ny))
+
+	terms := cal.Terms()
+	if len(terms) != 2 || terms[0].Name != "Fall 2024" || terms[1].Name != "Spring 2025" {
+		t.Fatalf("Terms() = %v", terms)
+	}
+	if got := terms[0].Weeks(); got != 16 {
+		t.Errorf("Fall Weeks() = %d, want 16", got)
+	}
+
+	tests := []struct {
+		dt      DateTime
+		term    string
+		week    int
+		isBreak bool
+	}{
+		{Date(2024, time.August, 25, 12, 0, 0, 0, ny), "", 0, false},
+		{Date(2024, time.August, 26, 0, 0, 0, 0, ny), "Fall 2024", 1, false},
+		{Date(2024, time.September, 3, 9, 0, 0, 0, ny), "Fall 2024", 2, false},
+		{Date(2024, time.December, 13, 23, 30, 0, 0, ny), "Fall 2024", 16, false},
+		{UTC(2024, time.December, 14, 3, 0, 0, 0), "Fall 2024", 16, false}, // still Dec 13 in New York
+		{Date(2024, time.December, 24, 0, 0, 0, 0, ny), "", 0, true},
+		{Date(2025, time.January, 13, 8, 0, 0, 0, ny), "Spring 2025", 1, false},
+		{Date(2025, time.June, 1, 0, 0, 0, 0, ny), "", 0, false},
+	}
+	for _, tt := range tests {
+		term, ok := cal.TermOf(tt.dt)
+		if ok != (tt.term != "") || term.Name != tt.term {
+			t.Errorf("TermOf(%v) = %q, %v; want %q", tt.dt, term.Name, ok, tt.term)
+		}
+		if got := cal.WeekOfTerm(tt.dt); got != tt.week {
+			t.Errorf("WeekOfTerm(%v) = %d, want %d", tt.dt, got, tt.week)
+		}
+		if got := cal.IsTermBreak(tt.dt); got != tt.isBreak {
+			t.Errorf("IsTermBreak(%v) = %v, want %v", tt.dt, got, tt.isBreak)
+		}
+	}
+
+	next, ok := cal.NextTerm(Date(2024, time.December, 20, 0, 0, 0, 0, ny))
+	if !ok || next.Name != "Spring 2025" {
+		t.Errorf("NextTerm() = %q, %v", next.Name, ok)
+	}
+	if _, ok := cal.NextTerm(Date(2025, time.February, 1, 0, 0, 0, 0, ny)); ok {
+		t.Error("NextTerm() after the last term should be false")
+	}
+}
@@ -1,6 +1,8 @@
 package chronogo
 
 import (
+	"errors"
+	"strings"
 	"testing"
 	"time"
 )
@@ -120,6 +122,49 @@ func TestFromFormatTokens(t *testing.T) {
 	}
 }
 
+func TestWeekDateTokensRoundTrip(t *testing.T) {
+	dt := Date(2024, time.June, 22, 0, 0, 0, 0, time.UTC) // Saturday of ISO week 25
+
+	formatted := dt.FormatTokens("GGGG-[W]WW-E")
+	if formatted != "2024-W25-6" {
+		t.Fatalf("FormatTokens week date = %q, want %q", formatted, "2024-W25-6")
+	}
+	parsed, err := FromFormatTokens(formatted, "GGGG-[W]WW-E")
+	if err != nil {
+		t.Fatalf("FromFormatTokens(%q) error: %v", formatted, err)
+	}
+	if !parsed.Equal(dt) {
+		t.Errorf("round trip = %v, want %v", parsed, dt)
+	}
+
+	// ISO week-year differs from the calendar year around New Year
+	newYear := Date(2024, time.December, 30, 0, 0, 0, 0, time.UTC)
+	if got := newYear.FormatTokens("GGGG [W]W E d"); got != "2025 W1 1 1" {
+		t.Errorf("FormatTokens = %q, want %q", got, "2025 W1 1 1")
+	}
+
+	withTime, err := FromFormatTokens("2020W537 08:15", "GGGG[W]WWE HH:mm")
+	if err != nil {
+		t.Fatalf("FromFormatTokens compact error: %v", err)
+	}
+	if want := Date(2021, time.January, 3, 8, 15, 0, 0, time.UTC); !withTime.Equal(want) {
+		t.Errorf("compact week date = %v, want %v", withTime, want)
+	}
+
+	invalid := []struct{ value, format string }{
+		{"2023-W53-1", "GGGG-[W]WW-E"}, // 2023 has 52 ISO weeks
+		{"2024-W00-1", "GGGG-[W]WW-E"},
+		{"2024-W10-8", "GGGG-[W]WW-E"},
+		{"2024-W10", "GGGG-[W]WW-E"},
+		{"2024-W10-1 June", "GGGG-[W]WW-E MMMM"},
+	}
+	for _, tt := range invalid {
+		if _, err := FromFormatTokens(tt.value, tt.format); err == nil {
+			t.Errorf("FromFormatTokens(%q, %q) expected error", tt.value, tt.format)
+		}
+	}
+}
+
 func TestParseOrdinalDate(t *testing.T) {
 	tests := []struct {
 		name     string
@@ -416,3 +461,40 @@ func TestConvertTokenFormat(t *testing.T) {
 		})
 	}
 }
+
+func TestFromFormatTokensMismatch(t *testing.T) {
+	_, err := FromFormatTokens("12/25/2024", "DD/MM/YYYY")
+	var mismatch *FormatMismatchError
+	if !errors.As(err, &mismatch) {
+		t.Fatalf("error = %v, want *FormatMismatchError", err)
+	}
+	if mismatch.Position != 3 || mismatch.Expected != "MM" || mismatch.Reason != "month out of range" {
+		t.Errorf("mismatch = %+v", mismatch)
+	}
+	if !errors.Is(err, ErrInvalidFormat) {
+		t.Error("mismatch should match ErrInvalidFormat")
+	}
+	var ce *ChronoError
+	if !errors.As(err, &ce) || !strings.Contains(ce.Suggestion, `"MM/DD/YYYY"`) {
+		t.Errorf("suggestion = %q, want MM/DD/YYYY", ce.Suggestion)
+	}
+
+	_, err = FromFormatTokens("2024-06-15 14:30", "YYYY-MM-DD")
+	if !errors.As(err, &mismatch) || mismatch.Position != 10 || mismatch.Expected != "end of input" {
+		t.Errorf("extra text mismatch = %+v", mismatch)
+	}
+	if errors.As(err, &ce); !strings.Contains(ce.Suggestion, `"YYYY-MM-DD HH:mm"`) {
+		t.Errorf("suggestion = %q", ce.Suggestion)
+	}
+
+	_, err = FromFormatTokens("2024.06.15", "YYYY-MM-DD")
+	if !errors.As(err, &mismatch) || mismatch.Position != 4 || mismatch.Expected != `"-"` {
+		t.Errorf("literal mismatch = %+v", mismatch)
+	}
+
+	formats := GetSupportedFormats()
+	formats[0] = "changed"
+	if GetSupportedFormats()[0] == "changed" {
+		t.Error("GetSupportedFormats should return a copy")
+	}
+}
@@ -0,0 +1,62 @@
+package chronogo
+
+import "time"
+
+// NextAnniversary returns the first anniversary of the given date (same month and day)
+// on or after dt's calendar day, at midnight in dt's location. A February 29 date is
+// observed on February 28 in common years.
+//
+// Example:
+//
+//	founded := chronogo.Date(2015, time.March, 10, 0, 0, 0, 0, time.UTC)
+//	chronogo.Date(2024, time.June, 1, 0, 0, 0, 0, time.UTC).NextAnniversary(founded)
+//	// 2025-03-10 00:00:00
+func (dt DateTime) NextAnniversary(of DateTime) DateTime {
+	return dt.nextOccurrence(of.Month(), of.Day())
+}
+
+// NextBirthday returns the next birthday for the given birth date, on or after dt's
+// calendar day, at midnight in dt's location. It is NextAnniversary under a clearer
+// name: a February 29 birthday is observed on February 28 in common years.
+//
+// Example:
+//
+//	birth := chronogo.Date(2000, time.February, 29, 0, 0, 0, 0, time.UTC)
+//	chronogo.Date(2025, time.January, 1, 0, 0, 0, 0, time.UTC).NextBirthday(birth)
+//	// 2025-02-28 00:00:00
+func (dt DateTime) NextBirthday(birth DateTime) DateTime {
+	return dt.NextAnniversary(birth)
+}
+
+// UntilNextOccurrence returns the time from Now() to the start of the next occurrence
+// of the given month and day, for countdowns to birthdays, anniversaries or fixed
+// dates such as New Year. It is zero on the day itself. Days past the end of the
+// month, like February 29 in a common year, fall on the month's last day. Now()
+// respects the testing helpers (SetTestNow, FreezeTime).
+//
+// Example:
+//
+//	left := chronogo.UntilNextOccurrence(time.December, 25)
+//	fmt.Printf("%d days until Christmas\n", left.Days())
+func UntilNextOccurrence(month time.Month, day int) Diff {
+	now := Now()
+	next := now.nextOccurrence(month, day)
+	if next.Before(now) {
+		// Today is the day
+		return now.Diff(now)
+	}
+	return next.Diff(now)
+}
+
+// nextOccurrence returns midnight of the first month/day on or after dt's calendar day,
+// clamping day to the length of the month.
+func (dt DateTime) nextOccurrence(month time.Month, day int) DateTime {
+	today := dt.StartOfDay()
+	for year := dt.Year(); ; year++ {
+		d := min(day, DaysInMonthOf(year, month))
+		candidate := Date(year, month, d, 0, 0, 0, 0, dt.Location())
+		if !candidate.Before(today) {
+			return candidate
+		}
+	}
+}
@@ -0,0 +1,66 @@
+package chronogo
+
+import (
+	"testing"
+	"time"
+)
+
+func TestNextAnniversary(t *testing.T) {
+	founded := Date(2015, time.March, 10, 9, 30, 0, 0, time.UTC)
+
+	tests := []struct {
+		name string
+		dt   DateTime
+		want DateTime
+	}{
+		{"later this year", Date(2024, time.January, 5, 0, 0, 0, 0, time.UTC), Date(2024, time.March, 10, 0, 0, 0, 0, time.UTC)},
+		{"already passed", Date(2024, time.June, 1, 0, 0, 0, 0, time.UTC), Date(2025, time.March, 10, 0, 0, 0, 0, time.UTC)},
+		{"on the day", Date(2024, time.March, 10, 18, 0, 0, 0, time.UTC), Date(2024, time.March, 10, 0, 0, 0, 0, time.UTC)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.dt.NextAnniversary(founded); !got.Equal(tt.want) {
+				t.Errorf("NextAnniversary() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	// The result is in dt's location
+	ny, _ := time.LoadLocation("America/New_York")
+	got := Date(2024, time.January, 5, 0, 0, 0, 0, ny).NextAnniversary(founded)
+	if got.Location() != ny || got.Day() != 10 || got.Hour() != 0 {
+		t.Errorf("NextAnniversary() in New York = %v", got)
+	}
+}
+
+func TestNextBirthdayLeapDay(t *testing.T) {
+	birth := Date(2000, time.February, 29, 0, 0, 0, 0, time.UTC)
+
+	if got := Date(2025, time.January, 1, 0, 0, 0, 0, time.UTC).NextBirthday(birth); !got.Equal(Date(2025, time.February, 28, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("NextBirthday() in a common year = %v, want Feb 28", got)
+	}
+	if got := Date(2028, time.January, 1, 0, 0, 0, 0, time.UTC).NextBirthday(birth); !got.Equal(Date(2028, time.February, 29, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("NextBirthday() in a leap year = %v, want Feb 29", got)
+	}
+	if got := Date(2025, time.March, 1, 0, 0, 0, 0, time.UTC).NextBirthday(birth); !got.Equal(Date(2026, time.February, 28, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("NextBirthday() after Feb 28 = %v, want the next year", got)
+	}
+}
+
+func TestUntilNextOccurrence(t *testing.T) {
+	WithTestNow(Date(2024, time.December, 20, 12, 0, 0, 0, time.UTC), func() {
+		left := UntilNextOccurrence(time.December, 25)
+		if left.InHours() != 4*24+12 || left.Days() != 4 {
+			t.Errorf("UntilNextOccurrence(Dec 25) = %v hours", left.InHours())
+		}
+		if got := UntilNextOccurrence(time.January, 1); got.Days() != 11 {
+			t.Errorf("UntilNextOccurrence(Jan 1) = %d days, want 11", got.Days())
+		}
+		if got := UntilNextOccurrence(time.December, 20); !got.IsZero() {
+			t.Errorf("UntilNextOccurrence() on the day = %v, want zero", got)
+		}
+		if got := UntilNextOccurrence(time.February, 29); !got.End().Equal(Date(2025, time.February, 28, 0, 0, 0, 0, time.UTC)) {
+			t.Errorf("UntilNextOccurrence(Feb 29) ends %v, want Feb 28 in a common year", got.End())
+		}
+	})
+}
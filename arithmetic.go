@@ -0,0 +1,277 @@
+package chronogo
+
+import (
+	"fmt"
+	"time"
+)
+
+// ArithmeticMode selects how day and hour arithmetic behaves across DST transitions.
+//
+// By default chronogo follows the time package: AddDays/AddWeeks keep the wall clock
+// (AddDate semantics, so a day can be 23 or 25 hours long), while AddHours adds exact
+// elapsed time (so the wall clock can jump). Use the explicit variants or
+// WithArithmeticMode when the other behavior is needed.
+type ArithmeticMode int
+
+const (
+	// ArithmeticWallClock keeps the local clock reading: adding 1 day to 09:00
+	// yields 09:00 the next day, even across a DST change.
+	ArithmeticWallClock ArithmeticMode = iota
+
+	// ArithmeticAbsolute adds exact elapsed time: a day is always 24 hours, so the
+	// local clock reading shifts by the DST offset change.
+	ArithmeticAbsolute
+)
+
+// String returns the name of the arithmetic mode.
+func (m ArithmeticMode) String() string {
+	switch m {
+	case ArithmeticWallClock:
+		return "wall-clock"
+	case ArithmeticAbsolute:
+		return "absolute"
+	default:
+		return "unknown"
+	}
+}
+
+// AddDaysAbsolute adds exact 24-hour increments, ignoring DST changes in the wall clock.
+//
+// Example:
+//
+//	ny, _ := time.LoadLocation("America/New_York")
+//	dt := chronogo.Date(2024, 3, 9, 12, 0, 0, 0, ny)
+//	dt.AddDays(1)         // 2024-03-10 12:00 EDT (23 hours later)
+//	dt.AddDaysAbsolute(1) // 2024-03-10 13:00 EDT (24 hours later)
+func (dt DateTime) AddDaysAbsolute(days int) DateTime {
+	return DateTime{dt.Time.Add(time.Duration(days) * 24 * time.Hour)}
+}
+
+// AddHoursWallClock adds hours to the local clock reading rather than to elapsed time.
+// Across a DST change the elapsed time differs from the number of hours added.
+//
+// Example:
+//
+//	ny, _ := time.LoadLocation("America/New_York")
+//	dt := chronogo.Date(2024, 3, 9, 12, 0, 0, 0, ny)
+//	dt.AddHours(24)          // 2024-03-10 13:00 EDT
+//	dt.AddHoursWallClock(24) // 2024-03-10 12:00 EDT
+func (dt DateTime) AddHoursWallClock(hours int) DateTime {
+	return DateTime{time.Date(dt.Year(), dt.Month(), dt.Day(), dt.Hour()+hours, dt.Minute(), dt.Second(), dt.Nanosecond(), dt.Location())}
+}
+
+// ModeArithmetic performs day, week and hour arithmetic on a DateTime using a fixed ArithmeticMode.
+// Obtain one with DateTime.WithArithmeticMode.
+type ModeArithmetic struct {
+	dt   DateTime
+	mode ArithmeticMode
+	gap  GapPolicy
+}
+
+// WithArithmeticMode returns a helper whose AddDays, AddWeeks and AddHours all use the
+// given mode, so callers can pick DST semantics once instead of per call.
+//
+// Example:
+//
+//	dt.WithArithmeticMode(chronogo.ArithmeticAbsolute).AddDays(1)  // exactly 24h later
+//	dt.WithArithmeticMode(chronogo.ArithmeticWallClock).AddHours(3) // clock reads 3 hours later
+func (dt DateTime) WithArithmeticMode(mode ArithmeticMode) ModeArithmetic {
+	return ModeArithmetic{dt: dt, mode: mode}
+}
+
+// WithGapPolicy returns a copy that resolves wall-clock results falling in a DST gap
+// using the given policy. It only affects ArithmeticWallClock, since absolute
+// arithmetic always lands on a valid instant.
+//
+// Example:
+//
+//	dt.WithArithmeticMode(chronogo.ArithmeticWallClock).WithGapPolicy(chronogo.GapShiftForward).AddDays(1)
+func (m ModeArithmetic) WithGapPolicy(policy GapPolicy) ModeArithmetic {
+	m.gap = policy
+	return m
+}
+
+// Mode returns the arithmetic mode in use.
+func (m ModeArithmetic) Mode() ArithmeticMode {
+	return m.mode
+}
+
+// AddDays adds the specified number of days using the configured mode.
+func (m ModeArithmetic) AddDays(days int) DateTime {
+	if m.mode == ArithmeticAbsolute {
+		return m.dt.AddDaysAbsolute(days)
+	}
+	dt := m.dt
+	return DateWithGapPolicy(dt.Year(), dt.Month(), dt.Day()+days, dt.Hour(), dt.Minute(), dt.Second(), dt.Nanosecond(), dt.Location(), m.gap)
+}
+
+// AddWeeks adds the specified number of weeks using the configured mode.
+func (m ModeArithmetic) AddWeeks(weeks int) DateTime {
+	return m.AddDays(weeks * 7)
+}
+
+// AddHours adds the specified number of hours using the configured mode.
+func (m ModeArithmetic) AddHours(hours int) DateTime {
+	if m.mode == ArithmeticWallClock {
+		dt := m.dt
+		return DateWithGapPolicy(dt.Year(), dt.Month(), dt.Day(), dt.Hour()+hours, dt.Minute(), dt.Second(), dt.Nanosecond(), dt.Location(), m.gap)
+	}
+	return m.dt.AddHours(hours)
+}
+
+// GapPolicy selects how a wall-clock time that does not exist in its location
+// (because it falls in a spring-forward DST gap) is resolved.
+type GapPolicy int
+
+const (
+	// GapNormalize keeps the result of time.Date, which picks one of the offsets
+	// around the gap. The resulting instant is not guaranteed by the time package.
+	GapNormalize GapPolicy = iota
+
+	// GapShiftForward moves the time to the first valid instant after the gap,
+	// i.e. the transition itself (02:30 on a 1-hour spring-forward day becomes 03:00).
+	GapShiftForward
+
+	// GapPushForward moves the time forward by the length of the gap, interpreting it
+	// with the offset in effect before the transition (02:30 becomes 03:30).
+	GapPushForward
+)
+
+// IsNonExistent reports whether the given wall-clock time does not exist in loc
+// because it falls in a DST gap. A DateTime always holds a valid instant, so the
+// check takes the wall-clock components a caller intended to build. Out-of-range
+// components such as February 30 or hour 25 are not a DST gap and report false;
+// use IsValidDate and IsValidTime to reject them.
+//
+// Example:
+//
+//	ny, _ := time.LoadLocation("America/New_York")
+//	chronogo.IsNonExistent(2024, time.March, 10, 2, 30, 0, 0, ny) // true
+func IsNonExistent(year int, month time.Month, day, hour, min, sec, nsec int, loc *time.Location) bool {
+	if !isValidWallClock(year, month, day, hour, min, sec, nsec) {
+		return false
+	}
+	wall := time.Date(year, month, day, hour, min, sec, nsec, time.UTC)
+	local := time.Date(year, month, day, hour, min, sec, nsec, loc)
+	return !sameWallClock(wall, local)
+}
+
+// DateWithGapPolicy creates a DateTime like Date, resolving wall-clock times that fall
+// in a DST gap with the given policy. Times outside a gap are unaffected.
+//
+// Example:
+//
+//	chronogo.DateWithGapPolicy(2024, time.March, 10, 2, 30, 0, 0, ny, chronogo.GapShiftForward) // 03:00 EDT
+func DateWithGapPolicy(year int, month time.Month, day, hour, min, sec, nsec int, loc *time.Location, policy GapPolicy) DateTime {
+	local := time.Date(year, month, day, hour, min, sec, nsec, loc)
+	wall := time.Date(year, month, day, hour, min, sec, nsec, time.UTC)
+	if policy == GapNormalize || sameWallClock(wall, local) {
+		return DateTime{local}
+	}
+
+	// The transition is the zone boundary next to the wall-clock time time.Date chose
+	start, end := local.ZoneBounds()
+	transition := start
+	localWall := time.Date(local.Year(), local.Month(), local.Day(), local.Hour(), local.Minute(), local.Second(), local.Nanosecond(), time.UTC)
+	if localWall.Before(wall) {
+		transition = end
+	}
+	if transition.IsZero() {
+		return DateTime{local}
+	}
+
+	switch policy {
+	case GapShiftForward:
+		return DateTime{transition}
+	case GapPushForward:
+		_, before := transition.Add(-time.Nanosecond).Zone()
+		return DateTime{wall.Add(-time.Duration(before) * time.Second).In(loc)}
+	default:
+		return DateTime{local}
+	}
+}
+
+// IsAmbiguous reports whether the given wall-clock time occurs twice in loc because
+// it falls in a DST overlap, such as 01:30 on the night clocks fall back.
+//
+// Example:
+//
+//	ny, _ := time.LoadLocation("America/New_York")
+//	chronogo.IsAmbiguous(2024, time.November, 3, 1, 30, 0, 0, ny) // true
+func IsAmbiguous(year int, month time.Month, day, hour, min, sec, nsec int, loc *time.Location) bool {
+	if !isValidWallClock(year, month, day, hour, min, sec, nsec) {
+		return false
+	}
+	wall := time.Date(year, month, day, hour, min, sec, nsec, time.UTC)
+	local := time.Date(year, month, day, hour, min, sec, nsec, loc)
+	if !sameWallClock(wall, local) {
+		return false
+	}
+
+	// The other reading, if any, uses the offset of the neighbouring zone period
+	_, offset := local.Zone()
+	start, end := local.ZoneBounds()
+	var neighbours []time.Time
+	if !start.IsZero() {
+		neighbours = append(neighbours, start.Add(-time.Nanosecond))
+	}
+	if !end.IsZero() {
+		neighbours = append(neighbours, end)
+	}
+	for _, n := range neighbours {
+		_, other := n.Zone()
+		if other == offset {
+			continue
+		}
+		alt := wall.Add(-time.Duration(other) * time.Second).In(loc)
+		if sameWallClock(wall, alt) {
+			return true
+		}
+	}
+	return false
+}
+
+// DateStrict creates a DateTime like Date but refuses wall-clock times that do not
+// identify exactly one instant in loc. The error wraps ErrNonexistentTime for a time
+// skipped by a DST gap, ErrAmbiguousTime for a time repeated by a DST overlap and
+// ErrOutOfRange for components that are not a real date and time, such as February 30.
+//
+// Example:
+//
+//	_, err := chronogo.DateStrict(2024, time.March, 10, 2, 30, 0, 0, ny)
+//	errors.Is(err, chronogo.ErrNonexistentTime) // true
+func DateStrict(year int, month time.Month, day, hour, min, sec, nsec int, loc *time.Location) (DateTime, error) {
+	var err error
+	switch {
+	case !isValidWallClock(year, month, day, hour, min, sec, nsec):
+		err = ErrOutOfRange
+	case IsNonExistent(year, month, day, hour, min, sec, nsec, loc):
+		err = ErrNonexistentTime
+	case IsAmbiguous(year, month, day, hour, min, sec, nsec, loc):
+		err = ErrAmbiguousTime
+	default:
+		return Date(year, month, day, hour, min, sec, nsec, loc), nil
+	}
+	return DateTime{}, &ChronoError{
+		Op:         "Date",
+		Path:       loc.String(),
+		Input:      fmt.Sprintf("%04d-%02d-%02d %02d:%02d:%02d", year, month, day, hour, min, sec),
+		Err:        err,
+		Suggestion: "Use DateWithGapPolicy for skipped times, or build the instant in UTC",
+	}
+}
+
+// isValidWallClock reports whether the components form a real calendar date and
+// clock time, before any normalization by time.Date.
+func isValidWallClock(year int, month time.Month, day, hour, min, sec, nsec int) bool {
+	return IsValidDate(year, month, day) && IsValidTime(hour, min, sec) && nsec >= 0 && nsec < 1e9
+}
+
+// sameWallClock reports whether two times show the same wall-clock reading.
+func sameWallClock(a, b time.Time) bool {
+	y1, m1, d1 := a.Date()
+	y2, m2, d2 := b.Date()
+	return y1 == y2 && m1 == m2 && d1 == d2 &&
+		a.Hour() == b.Hour() && a.Minute() == b.Minute() && a.Second() == b.Second() &&
+		a.Nanosecond() == b.Nanosecond()
+}
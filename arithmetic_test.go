@@ -0,0 +1,141 @@
+package chronogo
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestArithmeticModes(t *testing.T) {
+	ny, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Skip("America/New_York timezone not available")
+	}
+	// Day before the 2024-03-10 spring-forward transition
+	dt := Date(2024, time.March, 9, 12, 0, 0, 0, ny)
+
+	if got := dt.AddDays(1); got.Hour() != 12 || got.Sub(dt) != 23*time.Hour {
+		t.Errorf("AddDays(1) = %v, want 12:00 after 23h", got)
+	}
+	if got := dt.AddDaysAbsolute(1); got.Hour() != 13 || got.Sub(dt) != 24*time.Hour {
+		t.Errorf("AddDaysAbsolute(1) = %v, want 13:00 after 24h", got)
+	}
+	if got := dt.AddHours(24); got.Hour() != 13 {
+		t.Errorf("AddHours(24) = %v, want 13:00", got)
+	}
+	if got := dt.AddHoursWallClock(24); got.Hour() != 12 || got.Day() != 10 {
+		t.Errorf("AddHoursWallClock(24) = %v, want 2024-03-10 12:00", got)
+	}
+
+	absolute := dt.WithArithmeticMode(ArithmeticAbsolute)
+	if absolute.Mode() != ArithmeticAbsolute {
+		t.Errorf("Mode() = %v, want %v", absolute.Mode(), ArithmeticAbsolute)
+	}
+	if got := absolute.AddDays(1); !got.Equal(dt.AddDaysAbsolute(1)) {
+		t.Errorf("absolute AddDays(1) = %v", got)
+	}
+	if got := absolute.AddWeeks(1); got.Sub(dt) != 7*24*time.Hour {
+		t.Errorf("absolute AddWeeks(1) elapsed = %v, want 168h", got.Sub(dt))
+	}
+	if got := absolute.AddHours(24); !got.Equal(dt.AddHours(24)) {
+		t.Errorf("absolute AddHours(24) = %v", got)
+	}
+
+	wall := dt.WithArithmeticMode(ArithmeticWallClock)
+	if got := wall.AddDays(1); !got.Equal(dt.AddDays(1)) {
+		t.Errorf("wall-clock AddDays(1) = %v", got)
+	}
+	if got := wall.AddHours(24); !got.Equal(dt.AddHoursWallClock(24)) {
+		t.Errorf("wall-clock AddHours(24) = %v", got)
+	}
+
+	if ArithmeticWallClock.String() != "wall-clock" || ArithmeticAbsolute.String() != "absolute" {
+		t.Error("unexpected ArithmeticMode names")
+	}
+}
+
+func TestDSTGapPolicies(t *testing.T) {
+	ny, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Skip("America/New_York timezone not available")
+	}
+
+	if !IsNonExistent(2024, time.March, 10, 2, 30, 0, 0, ny) {
+		t.Error("Expected 2024-03-10 02:30 to be nonexistent in New York")
+	}
+	for _, hour := range []int{1, 3} {
+		if IsNonExistent(2024, time.March, 10, hour, 30, 0, 0, ny) {
+			t.Errorf("Expected 2024-03-10 %02d:30 to exist in New York", hour)
+		}
+	}
+	if IsNonExistent(2024, time.November, 3, 1, 30, 0, 0, ny) {
+		t.Error("Ambiguous fall-back times exist and should not be reported as nonexistent")
+	}
+	if IsNonExistent(2024, time.March, 10, 2, 30, 0, 0, time.UTC) {
+		t.Error("UTC has no DST gaps")
+	}
+
+	// Invalid calendar input is out of range, not a DST gap, even when time.Date
+	// would normalize it into one (March 9 hour 26 is March 10 02:00)
+	invalid := []struct {
+		month                   time.Month
+		day, hour, minute, nsec int
+	}{
+		{time.February, 30, 12, 0, 0},
+		{time.March, 9, 26, 30, 0},
+		{time.March, 10, 1, 90, 0},
+		{time.March, 10, 2, 30, -1},
+	}
+	for _, tt := range invalid {
+		if IsNonExistent(2024, tt.month, tt.day, tt.hour, tt.minute, 0, tt.nsec, ny) || IsAmbiguous(2024, tt.month, tt.day, tt.hour, tt.minute, 0, tt.nsec, ny) {
+			t.Errorf("IsNonExistent/IsAmbiguous(%v %d %02d:%02d) should be false for invalid components", tt.month, tt.day, tt.hour, tt.minute)
+		}
+		if _, err := DateStrict(2024, tt.month, tt.day, tt.hour, tt.minute, 0, tt.nsec, ny); !errors.Is(err, ErrOutOfRange) {
+			t.Errorf("DateStrict(%v %d %02d:%02d) error = %v, want ErrOutOfRange", tt.month, tt.day, tt.hour, tt.minute, err)
+		}
+	}
+
+	normalized := DateWithGapPolicy(2024, time.March, 10, 2, 30, 0, 0, ny, GapNormalize)
+	if !normalized.Equal(Date(2024, time.March, 10, 2, 30, 0, 0, ny)) {
+		t.Errorf("GapNormalize = %v, want time.Date result", normalized)
+	}
+	pushed := DateWithGapPolicy(2024, time.March, 10, 2, 30, 0, 0, ny, GapPushForward)
+	if pushed.Hour() != 3 || pushed.Minute() != 30 || !pushed.IsDST() {
+		t.Errorf("GapPushForward = %v, want 03:30 EDT", pushed)
+	}
+	shifted := DateWithGapPolicy(2024, time.March, 10, 2, 30, 0, 0, ny, GapShiftForward)
+	if shifted.Hour() != 3 || shifted.Minute() != 0 || !shifted.IsDST() {
+		t.Errorf("GapShiftForward = %v, want 03:00 EDT", shifted)
+	}
+	valid := DateWithGapPolicy(2024, time.March, 10, 4, 15, 0, 0, ny, GapShiftForward)
+	if !valid.Equal(Date(2024, time.March, 10, 4, 15, 0, 0, ny)) {
+		t.Errorf("GapShiftForward changed a valid time: %v", valid)
+	}
+
+	// Wall-clock arithmetic from 02:30 the day before lands in the gap
+	dt := Date(2024, time.March, 9, 2, 30, 0, 0, ny)
+	wall := dt.WithArithmeticMode(ArithmeticWallClock)
+	if got := wall.AddDays(1); !got.Equal(dt.AddDays(1)) {
+		t.Errorf("default gap policy AddDays(1) = %v, want %v", got, dt.AddDays(1))
+	}
+	if got := wall.WithGapPolicy(GapShiftForward).AddDays(1); got.Hour() != 3 || got.Minute() != 0 {
+		t.Errorf("GapShiftForward AddDays(1) = %v, want 03:00", got)
+	}
+	if got := wall.WithGapPolicy(GapShiftForward).AddHours(24); got.Hour() != 3 || got.Minute() != 0 {
+		t.Errorf("GapShiftForward AddHours(24) = %v, want 03:00", got)
+	}
+	if got := wall.WithGapPolicy(GapPushForward).AddDays(1); got.Hour() != 3 || got.Minute() != 30 {
+		t.Errorf("GapPushForward AddDays(1) = %v, want 03:30", got)
+	}
+
+	// Lord Howe Island springs forward by 30 minutes (02:00 -> 02:30)
+	if lhi, err := time.LoadLocation("Australia/Lord_Howe"); err == nil {
+		if !IsNonExistent(2024, time.October, 6, 2, 15, 0, 0, lhi) {
+			t.Error("Expected 02:15 to be nonexistent on Lord Howe Island")
+		}
+		got := DateWithGapPolicy(2024, time.October, 6, 2, 15, 0, 0, lhi, GapPushForward)
+		if got.Hour() != 2 || got.Minute() != 45 {
+			t.Errorf("Lord Howe GapPushForward = %v, want 02:45", got)
+		}
+	}
+}
@@ -0,0 +1,66 @@
+package chronogo
+
+import "math"
+
+// Astronomical time helpers. Universal Time is approximated by UTC (the difference,
+// DUT1, stays below 0.9 seconds), which is well within the accuracy of the formulas.
+
+const (
+	// julianDayUnixEpoch is the Julian Day of 1970-01-01 00:00:00 UTC.
+	julianDayUnixEpoch = 2440587.5
+	// julianDayJ2000 is the Julian Day of the J2000.0 epoch, 2000-01-01 12:00 TT.
+	julianDayJ2000 = 2451545.0
+)
+
+// JulianDay returns the Julian Day of dt: days, with fraction, since noon UTC on
+// 1 January 4713 BC in the proleptic Julian calendar.
+//
+// Example:
+//
+//	chronogo.Date(2000, 1, 1, 12, 0, 0, 0, time.UTC).JulianDay() // 2451545.0
+func (dt DateTime) JulianDay() float64 {
+	seconds := float64(dt.Unix()) + float64(dt.Nanosecond())/1e9
+	return seconds/86400 + julianDayUnixEpoch
+}
+
+// JulianCenturiesJ2000 returns the time since the J2000.0 epoch in Julian centuries
+// of 36525 days, the time argument T of most astronomical series.
+//
+// Example:
+//
+//	chronogo.Date(2050, 1, 1, 12, 0, 0, 0, time.UTC).JulianCenturiesJ2000() // ≈ 0.5
+func (dt DateTime) JulianCenturiesJ2000() float64 {
+	return (dt.JulianDay() - julianDayJ2000) / 36525
+}
+
+// GMST returns the Greenwich mean sidereal time at dt in hours, in [0, 24), using the
+// IAU 1982 expression.
+//
+// Example:
+//
+//	chronogo.Date(1987, 4, 10, 19, 21, 0, 0, time.UTC).GMST() // ≈ 8.5825 (8h34m57s)
+func (dt DateTime) GMST() float64 {
+	d := dt.JulianDay() - julianDayJ2000
+	t := d / 36525
+	degrees := 280.46061837 + 360.98564736629*d + 0.000387933*t*t - t*t*t/38710000
+	return normalizeHours(degrees / 15)
+}
+
+// LocalSiderealTime returns the local mean sidereal time at dt in hours, in [0, 24),
+// for a longitude in degrees, positive east of Greenwich.
+//
+// Example:
+//
+//	dt.LocalSiderealTime(-77.0365) // Washington, D.C.
+func (dt DateTime) LocalSiderealTime(longitude float64) float64 {
+	return normalizeHours(dt.GMST() + longitude/15)
+}
+
+// normalizeHours reduces hours to [0, 24).
+func normalizeHours(hours float64) float64 {
+	hours = math.Mod(hours, 24)
+	if hours < 0 {
+		hours += 24
+	}
+	return hours
+}
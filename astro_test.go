@@ -0,0 +1,59 @@
+package chronogo
+
+import (
+	"math"
+	"testing"
+	"time"
+)
+
+func TestJulianDay(t *testing.T) {
+	tests := []struct {
+		dt   DateTime
+		want float64
+	}{
+		{Date(2000, time.January, 1, 12, 0, 0, 0, time.UTC), 2451545.0},
+		{Date(1970, time.January, 1, 0, 0, 0, 0, time.UTC), 2440587.5},
+		// Meeus, Astronomical Algorithms, example 7.a
+		{Date(1957, time.October, 4, 19, 26, 24, 0, time.UTC), 2436116.31},
+		{Date(2000, time.January, 1, 13, 0, 0, 0, FixedZone("CET", 3600)), 2451545.0},
+	}
+	for _, tt := range tests {
+		if got := tt.dt.JulianDay(); math.Abs(got-tt.want) > 1e-6 {
+			t.Errorf("JulianDay(%v) = %f, want %f", tt.dt, got, tt.want)
+		}
+	}
+
+	if got := Date(2050, time.January, 1, 12, 0, 0, 0, time.UTC).JulianCenturiesJ2000(); math.Abs(got-0.5) > 1e-4 {
+		t.Errorf("JulianCenturiesJ2000() = %f, want ≈ 0.5", got)
+	}
+	if got := Date(2000, time.January, 1, 12, 0, 0, 0, time.UTC).JulianCenturiesJ2000(); got != 0 {
+		t.Errorf("JulianCenturiesJ2000() at J2000 = %f, want 0", got)
+	}
+}
+
+func TestSiderealTime(t *testing.T) {
+	// Meeus, example 12.b: 8h34m57.0896s
+	dt := Date(1987, time.April, 10, 19, 21, 0, 0, time.UTC)
+	want := 8 + 34.0/60 + 57.0896/3600
+	if got := dt.GMST(); math.Abs(got-want) > 1e-5 {
+		t.Errorf("GMST() = %f, want %f", got, want)
+	}
+
+	// Meeus, example 12.a: 13h10m46.3668s at 0h UT
+	midnight := Date(1987, time.April, 10, 0, 0, 0, 0, time.UTC)
+	if got := midnight.GMST(); math.Abs(got-(13+10.0/60+46.3668/3600)) > 1e-5 {
+		t.Errorf("GMST() at 0h = %f", got)
+	}
+
+	if got := dt.LocalSiderealTime(0); got != dt.GMST() {
+		t.Errorf("LocalSiderealTime(0) = %f, want GMST %f", got, dt.GMST())
+	}
+	// 77° west is 5h08m09s behind Greenwich
+	lst := dt.LocalSiderealTime(-77.0365)
+	if want := dt.GMST() - 77.0365/15; math.Abs(lst-want) > 1e-9 {
+		t.Errorf("LocalSiderealTime(-77.0365) = %f, want %f", lst, want)
+	}
+	if got := dt.LocalSiderealTime(240); got < 0 || got >= 24 {
+		t.Errorf("LocalSiderealTime(240) = %f, want within [0, 24)", got)
+	}
+}
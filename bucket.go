@@ -0,0 +1,101 @@
+package chronogo
+
+import (
+	"sort"
+	"time"
+)
+
+// TimeBucket is one group produced by Bucket or Period.Buckets: the start of a unit
+// (a day, week, month, ...) and the datetimes that fall in it.
+type TimeBucket struct {
+	Start   DateTime // start of the bucket, as returned by Truncate
+	Count   int      // number of datetimes in the bucket
+	Indices []int    // positions of those datetimes in the input slice
+}
+
+// End returns the last nanosecond of the bucket for the given unit.
+func (b TimeBucket) End(unit Unit) DateTime {
+	return b.Start.EndOf(unit)
+}
+
+// Bucket groups datetimes by the unit they fall in, for histograms and dashboards.
+// Each datetime is converted to loc (when non-nil) and truncated to unit, so day and
+// month boundaries follow loc's calendar. Weeks start on Monday. The result holds one
+// bucket per distinct start, in chronological order; empty units are not included
+// (see Period.Buckets to generate them).
+//
+// Example:
+//
+//	buckets := chronogo.Bucket(events, chronogo.UnitDay, ny)
+//	for _, b := range buckets {
+//	    fmt.Println(b.Start.ToDateString(), b.Count)
+//	}
+func Bucket(dts []DateTime, unit Unit, loc *time.Location) []TimeBucket {
+	index := make(map[int64]int)
+	var buckets []TimeBucket
+	for i, dt := range dts {
+		if loc != nil {
+			dt = dt.In(loc)
+		}
+		start := dt.Truncate(unit)
+		key := start.UnixNano()
+		pos, ok := index[key]
+		if !ok {
+			pos = len(buckets)
+			index[key] = pos
+			buckets = append(buckets, TimeBucket{Start: start})
+		}
+		buckets[pos].Count++
+		buckets[pos].Indices = append(buckets[pos].Indices, i)
+	}
+	sort.Slice(buckets, func(i, j int) bool {
+		return buckets[i].Start.Before(buckets[j].Start)
+	})
+	return buckets
+}
+
+// Buckets returns an empty bucket for every unit the period touches, from the unit
+// containing Start to the unit containing End, in the period's start location. A
+// half-open period ending exactly on a unit boundary does not touch the next unit.
+// It is meant for charting, where units without data still need a slot; use
+// FillBuckets to add counts. Unsupported units return nil.
+//
+// Example:
+//
+//	slots := q2.Buckets(chronogo.UnitMonth) // April, May, June
+func (p Period) Buckets(unit Unit) []TimeBucket {
+	if !validUnit(unit) {
+		return nil
+	}
+	p = p.Abs()
+	var buckets []TimeBucket
+	for start := p.Start.Truncate(unit); p.withinEnd(start); start = start.EndOf(unit).Add(time.Nanosecond) {
+		buckets = append(buckets, TimeBucket{Start: start})
+	}
+	return buckets
+}
+
+// FillBuckets groups datetimes into the period's buckets (see Period.Buckets), keeping
+// empty units. Datetimes are converted to the period's start location; those outside
+// the period's buckets are ignored.
+//
+// Example:
+//
+//	for _, b := range june.FillBuckets(signups, chronogo.UnitDay) {
+//	    chart.Add(b.Start, b.Count)
+//	}
+func (p Period) FillBuckets(dts []DateTime, unit Unit) []TimeBucket {
+	buckets := p.Buckets(unit)
+	index := make(map[int64]int, len(buckets))
+	for i, b := range buckets {
+		index[b.Start.UnixNano()] = i
+	}
+	loc := p.Start.Location()
+	for i, dt := range dts {
+		if pos, ok := index[dt.In(loc).Truncate(unit).UnixNano()]; ok {
+			buckets[pos].Count++
+			buckets[pos].Indices = append(buckets[pos].Indices, i)
+		}
+	}
+	return buckets
+}
@@ -0,0 +1,108 @@
+package chronogo
+
+import (
+	"testing"
+	"time"
+)
+
+func TestBucket(t *testing.T) {
+	dts := []DateTime{
+		UTC(2024, time.June, 3, 10, 0, 0, 0),
+		UTC(2024, time.June, 1, 9, 0, 0, 0),
+		UTC(2024, time.June, 3, 23, 30, 0, 0),
+		UTC(2024, time.June, 1, 12, 0, 0, 0),
+		UTC(2024, time.July, 2, 8, 0, 0, 0),
+	}
+
+	days := Bucket(dts, UnitDay, nil)
+	if len(days) != 3 {
+		t.Fatalf("Bucket(UnitDay) = %d buckets, want 3", len(days))
+	}
+	wantStarts := []DateTime{UTC(2024, time.June, 1, 0, 0, 0, 0), UTC(2024, time.June, 3, 0, 0, 0, 0), UTC(2024, time.July, 2, 0, 0, 0, 0)}
+	wantIndices := [][]int{{1, 3}, {0, 2}, {4}}
+	for i, b := range days {
+		if !b.Start.Equal(wantStarts[i]) || b.Count != len(wantIndices[i]) {
+			t.Errorf("bucket %d = %v (%d), want %v (%d)", i, b.Start, b.Count, wantStarts[i], len(wantIndices[i]))
+		}
+		for j, idx := range wantIndices[i] {
+			if j >= len(b.Indices) || b.Indices[j] != idx {
+				t.Errorf("bucket %d indices = %v, want %v", i, b.Indices, wantIndices[i])
+				break
+			}
+		}
+	}
+	if end := days[0].End(UnitDay); !end.Equal(UTC(2024, time.June, 1, 23, 59, 59, 999999999)) {
+		t.Errorf("End(UnitDay) = %v", end)
+	}
+
+	months := Bucket(dts, UnitMonth, nil)
+	if len(months) != 2 || months[0].Count != 4 || months[1].Count != 1 {
+		t.Errorf("Bucket(UnitMonth) = %+v", months)
+	}
+	// 2024-06-03 is a Monday, so June 1 falls in the previous week
+	if weeks := Bucket(dts, UnitWeek, nil); len(weeks) != 3 || !weeks[1].Start.Equal(UTC(2024, time.June, 3, 0, 0, 0, 0)) {
+		t.Errorf("Bucket(UnitWeek) = %+v", weeks)
+	}
+	if Bucket(nil, UnitDay, nil) != nil {
+		t.Error("Expected no buckets for no datetimes")
+	}
+
+	// Day boundaries follow the requested location
+	if ny, err := time.LoadLocation("America/New_York"); err == nil {
+		local := Bucket(dts, UnitDay, ny)
+		// 2024-06-03 23:30 UTC is 19:30 in New York; 2024-06-01 09:00 UTC is 05:00
+		if len(local) != 3 || local[1].Count != 2 || local[1].Start.Location() != ny {
+			t.Errorf("Bucket(UnitDay, New York) = %+v", local)
+		}
+	}
+}
+
+func TestPeriodBuckets(t *testing.T) {
+	q2 := NewPeriod(UTC(2024, time.April, 10, 0, 0, 0, 0), UTC(2024, time.June, 5, 0, 0, 0, 0))
+	months := q2.Buckets(UnitMonth)
+	if len(months) != 3 {
+		t.Fatalf("Buckets(UnitMonth) = %d buckets, want 3", len(months))
+	}
+	for i, m := range []time.Month{time.April, time.May, time.June} {
+		if months[i].Start.Month() != m || months[i].Start.Day() != 1 || months[i].Count != 0 {
+			t.Errorf("bucket %d = %+v", i, months[i])
+		}
+	}
+
+	week := NewPeriod(UTC(2024, time.June, 1, 0, 0, 0, 0), UTC(2024, time.June, 7, 12, 0, 0, 0))
+	if days := week.Buckets(UnitDay); len(days) != 7 {
+		t.Errorf("Buckets(UnitDay) = %d buckets, want 7", len(days))
+	}
+	if hours := NewPeriod(UTC(2024, time.June, 1, 0, 0, 0, 0), UTC(2024, time.June, 1, 5, 0, 0, 0)).Buckets(UnitHour); len(hours) != 6 {
+		t.Errorf("Buckets(UnitHour) = %d buckets, want 6", len(hours))
+	}
+	if week.Buckets(Unit(99)) != nil {
+		t.Error("Expected nil buckets for an unsupported unit")
+	}
+
+	filled := week.FillBuckets([]DateTime{
+		UTC(2024, time.June, 2, 8, 0, 0, 0),
+		UTC(2024, time.June, 2, 9, 0, 0, 0),
+		UTC(2024, time.June, 6, 9, 0, 0, 0),
+		UTC(2024, time.July, 1, 0, 0, 0, 0), // outside the period
+	}, UnitDay)
+	counts := make([]int, len(filled))
+	for i, b := range filled {
+		counts[i] = b.Count
+	}
+	want := []int{0, 2, 0, 0, 0, 1, 0}
+	for i := range want {
+		if counts[i] != want[i] {
+			t.Errorf("FillBuckets counts = %v, want %v", counts, want)
+			break
+		}
+	}
+
+	// Days on a DST change are still one bucket each
+	if ny, err := time.LoadLocation("America/New_York"); err == nil {
+		dst := NewPeriod(Date(2024, time.March, 9, 0, 0, 0, 0, ny), Date(2024, time.March, 11, 0, 0, 0, 0, ny))
+		if days := dst.Buckets(UnitDay); len(days) != 3 || days[2].Start.Day() != 11 {
+			t.Errorf("Buckets(UnitDay) across DST = %+v", days)
+		}
+	}
+}
@@ -1,6 +1,9 @@
 package chronogo
 
 import (
+	"sort"
+	"strings"
+	"sync"
 	"time"
 
 	goholiday "github.com/coredds/goholiday"
@@ -187,7 +190,8 @@ func (hc *DefaultHolidayChecker) findWeekdayOccurrence(year int, month time.Mont
 // to provide fast holiday checking with DateTime support.
 // This replaces the previous dependency on goholiday/chronogo adapter package.
 type fastCountryChecker struct {
-	country *goholiday.Country
+	country    *goholiday.Country
+	categories map[HolidayCategory]bool // nil counts every category
 }
 
 // newFastCountryChecker creates a new fast country checker for the given country code.
@@ -199,19 +203,41 @@ func newFastCountryChecker(countryCode string) *fastCountryChecker {
 
 // IsHoliday checks if the given time is a holiday.
 func (fc *fastCountryChecker) IsHoliday(t time.Time) bool {
-	_, ok := fc.country.IsHoliday(t)
+	_, ok := fc.GetHoliday(t)
 	return ok
 }
 
 // GetHolidayName returns the name of the holiday if the date is a holiday.
 func (fc *fastCountryChecker) GetHolidayName(t time.Time) string {
-	holiday, ok := fc.country.IsHoliday(t)
-	if ok && holiday != nil {
+	if holiday, ok := fc.GetHoliday(t); ok {
 		return holiday.Name
 	}
 	return ""
 }
 
+// GetHoliday returns the goholiday record for the given time, if it is a holiday
+// in one of the counted categories.
+func (fc *fastCountryChecker) GetHoliday(t time.Time) (*goholiday.Holiday, bool) {
+	holiday, ok := fc.country.IsHoliday(t)
+	if !ok || holiday == nil {
+		return nil, false
+	}
+	if !fc.counts(holiday.Category) {
+		return nil, false
+	}
+	return holiday, true
+}
+
+// counts reports whether holidays in the category are counted. Countries file their
+// public holidays under "federal" (US) or "national" (e.g. BR, MX), so selecting
+// CategoryPublic counts those too.
+func (fc *fastCountryChecker) counts(category HolidayCategory) bool {
+	if fc.categories == nil || fc.categories[category] {
+		return true
+	}
+	return fc.categories[CategoryPublic] && (category == CategoryFederal || category == CategoryNational)
+}
+
 // CountHolidaysInRange counts holidays within a date range.
 func (fc *fastCountryChecker) CountHolidaysInRange(start, end time.Time) int {
 	count := 0
@@ -253,6 +279,26 @@ func (fc *fastCountryChecker) ClearCache() {
 	// The cache is managed internally
 }
 
+// HolidayCategory is the kind of a holiday, as reported by goholiday.
+type HolidayCategory = goholiday.HolidayCategory
+
+// Holiday categories for HolidayInfo.Category and GoHolidayChecker.WithCategories.
+// The country data also uses federal, national, regional and cultural categories;
+// WithCategories(CategoryPublic) includes federal and national holidays.
+const (
+	CategoryPublic     = goholiday.CategoryPublic
+	CategoryBank       = goholiday.CategoryBank
+	CategorySchool     = goholiday.CategorySchool
+	CategoryGovernment = goholiday.CategoryGovernment
+	CategoryReligious  = goholiday.CategoryReligious
+	CategoryOptional   = goholiday.CategoryOptional
+
+	CategoryFederal  HolidayCategory = "federal"
+	CategoryNational HolidayCategory = "national"
+	CategoryRegional HolidayCategory = "regional"
+	CategoryCultural HolidayCategory = "cultural"
+)
+
 // GoHolidayChecker wraps the goholiday library to implement the HolidayChecker interface.
 // This provides comprehensive holiday data for multiple countries and regions.
 type GoHolidayChecker struct {
@@ -271,6 +317,40 @@ func NewGoHolidayChecker(country string) *GoHolidayChecker {
 	}
 }
 
+// WithCategories returns a copy of the checker that only counts holidays in the given
+// categories, so IsBusinessDay and the other business-day functions can, for example,
+// ignore school holidays or include bank holidays. Every query on the copy (IsHoliday,
+// GetHolidayName, GetHoliday and the range methods) applies the filter. Calling it
+// without categories counts every category again.
+//
+// Example:
+//
+//	bankDays := chronogo.NewGoHolidayChecker("GB").WithCategories(chronogo.CategoryPublic, chronogo.CategoryBank)
+//	dt.IsBusinessDay(bankDays)
+func (ghc *GoHolidayChecker) WithCategories(categories ...HolidayCategory) *GoHolidayChecker {
+	checker := &fastCountryChecker{country: ghc.checker.country}
+	if len(categories) > 0 {
+		checker.categories = make(map[HolidayCategory]bool, len(categories))
+		for _, c := range categories {
+			checker.categories[c] = true
+		}
+	}
+	return &GoHolidayChecker{checker: checker, country: ghc.country}
+}
+
+// Categories returns the categories the checker counts, or nil if it counts all of them.
+func (ghc *GoHolidayChecker) Categories() []HolidayCategory {
+	if ghc.checker.categories == nil {
+		return nil
+	}
+	categories := make([]HolidayCategory, 0, len(ghc.checker.categories))
+	for c := range ghc.checker.categories {
+		categories = append(categories, c)
+	}
+	sort.Slice(categories, func(i, j int) bool { return categories[i] < categories[j] })
+	return categories
+}
+
 // IsHoliday checks if the given date is a holiday using the goholiday library.
 func (ghc *GoHolidayChecker) IsHoliday(dt DateTime) bool {
 	return ghc.checker.IsHoliday(dt.Time)
@@ -281,6 +361,34 @@ func (ghc *GoHolidayChecker) GetHolidayName(dt DateTime) string {
 	return ghc.checker.GetHolidayName(dt.Time)
 }
 
+// GetHoliday returns the metadata of the holiday on the given date, or false if the
+// date is not a holiday.
+//
+// Example:
+//
+//	if info, ok := checker.GetHoliday(dt); ok {
+//	    fmt.Println(info.LocalizedName("es"), info.Category)
+//	}
+func (ghc *GoHolidayChecker) GetHoliday(dt DateTime) (*HolidayInfo, bool) {
+	holiday, ok := ghc.checker.GetHoliday(dt.Time)
+	if !ok {
+		return nil, false
+	}
+	info := &HolidayInfo{
+		Name:     holiday.Name,
+		Date:     Date(dt.Year(), dt.Month(), dt.Day(), 0, 0, 0, 0, dt.Location()),
+		Category: holiday.Category,
+		Observed: holiday.IsObserved,
+	}
+	if len(holiday.Languages) > 0 {
+		info.LocalizedNames = make(map[string]string, len(holiday.Languages))
+		for lang, name := range holiday.Languages {
+			info.LocalizedNames[lang] = name
+		}
+	}
+	return info, true
+}
+
 // CountHolidaysInRange counts holidays within a date range.
 func (ghc *GoHolidayChecker) CountHolidaysInRange(start, end DateTime) int {
 	return ghc.checker.CountHolidaysInRange(start.Time, end.Time)
@@ -354,51 +462,91 @@ func NewHolidayChecker(country string) HolidayChecker {
 	return NewGoHolidayChecker(country)
 }
 
-// defaultUSHolidayChecker is a cached US holiday checker for convenience functions
-var defaultUSHolidayChecker = NewGoHolidayChecker("US")
+// defaultChecker holds the holiday checker used when none is passed. It is created
+// on first use, so programs that never ask about holidays do not load any.
+var defaultChecker struct {
+	mu      sync.RWMutex
+	country string
+	checker HolidayChecker
+}
+
+// defaultHolidayChecker returns the default holiday checker, creating it on first use.
+func defaultHolidayChecker() HolidayChecker {
+	defaultChecker.mu.RLock()
+	checker := defaultChecker.checker
+	defaultChecker.mu.RUnlock()
+	if checker != nil {
+		return checker
+	}
+
+	defaultChecker.mu.Lock()
+	defer defaultChecker.mu.Unlock()
+	if defaultChecker.checker == nil {
+		if defaultChecker.country == "" {
+			defaultChecker.country = "US"
+		}
+		defaultChecker.checker = NewGoHolidayChecker(defaultChecker.country)
+	}
+	return defaultChecker.checker
+}
+
+// SetDefaultHolidayCountry sets the country whose holidays IsBusinessDay, IsHoliday
+// and the other business-day helpers use when no holiday checker is passed. The
+// default is "US". The new checker is created on its next use; calls that already
+// hold the previous checker finish with it. Safe for concurrent use.
+//
+// Example:
+//
+//	chronogo.SetDefaultHolidayCountry("DE")
+//	chronogo.Date(2024, time.October, 3, 0, 0, 0, 0, time.UTC).IsHoliday() // true, German Unity Day
+func SetDefaultHolidayCountry(country string) {
+	country = strings.ToUpper(strings.TrimSpace(country))
+	defaultChecker.mu.Lock()
+	defer defaultChecker.mu.Unlock()
+	if country == defaultChecker.country && defaultChecker.checker != nil {
+		return
+	}
+	defaultChecker.country = country
+	defaultChecker.checker = nil
+}
+
+// DefaultHolidayCountry returns the country code of the default holiday checker.
+func DefaultHolidayCountry() string {
+	defaultChecker.mu.RLock()
+	defer defaultChecker.mu.RUnlock()
+	if defaultChecker.country == "" {
+		return "US"
+	}
+	return defaultChecker.country
+}
 
 // Business date operations for DateTime
 
 // IsBusinessDay returns true if the date is a business day (Monday-Friday and not a holiday).
-// If no holiday checker is provided, it uses the default US holiday checker.
+// If no holiday checker is provided, it uses the default holiday checker.
 func (dt DateTime) IsBusinessDay(holidayChecker ...HolidayChecker) bool {
 	if dt.IsWeekend() {
 		return false
 	}
 
-	var checker HolidayChecker
-	if len(holidayChecker) > 0 && holidayChecker[0] != nil {
-		checker = holidayChecker[0]
-	} else {
-		checker = defaultUSHolidayChecker
-	}
+	checker := holidayCheckerOrDefault(holidayChecker)
 
 	return !checker.IsHoliday(dt)
 }
 
 // IsHoliday returns true if the date is a holiday.
-// If no holiday checker is provided, it uses the default US holiday checker.
+// If no holiday checker is provided, it uses the default holiday checker.
 func (dt DateTime) IsHoliday(holidayChecker ...HolidayChecker) bool {
-	var checker HolidayChecker
-	if len(holidayChecker) > 0 && holidayChecker[0] != nil {
-		checker = holidayChecker[0]
-	} else {
-		checker = defaultUSHolidayChecker
-	}
+	checker := holidayCheckerOrDefault(holidayChecker)
 
 	return checker.IsHoliday(dt)
 }
 
 // GetHolidayName returns the name of the holiday if the date is a holiday.
 // Returns empty string if the date is not a holiday.
-// If no holiday checker is provided, it uses the default US holiday checker.
+// If no holiday checker is provided, it uses the default holiday checker.
 func (dt DateTime) GetHolidayName(holidayChecker ...HolidayChecker) string {
-	var checker HolidayChecker
-	if len(holidayChecker) > 0 && holidayChecker[0] != nil {
-		checker = holidayChecker[0]
-	} else {
-		checker = defaultUSHolidayChecker
-	}
+	checker := holidayCheckerOrDefault(holidayChecker)
 
 	// Try to cast to GoHolidayChecker for enhanced functionality
 	if ghc, ok := checker.(*GoHolidayChecker); ok {
@@ -412,6 +560,87 @@ func (dt DateTime) GetHolidayName(holidayChecker ...HolidayChecker) string {
 	return ""
 }
 
+// HolidayInfo describes a holiday in more detail than GetHolidayName, for UIs that
+// show translated names or distinguish public holidays from bank or school holidays.
+type HolidayInfo struct {
+	// Name is the holiday name in the checker's default language.
+	Name string
+	// Date is the day of the holiday at midnight, in the location of the queried date.
+	Date DateTime
+	// LocalizedNames maps language codes such as "en" or "es" to translated names.
+	LocalizedNames map[string]string
+	// Category is the kind of holiday, such as CategoryPublic or CategoryBank.
+	Category HolidayCategory
+	// Subdivisions lists the regions (e.g. "CA", "NY") where the holiday applies.
+	// It is empty for nationwide holidays. GoHolidayChecker always leaves it empty
+	// because goholiday does not report per-holiday subdivisions yet; custom
+	// checkers may fill it in.
+	Subdivisions []string
+	// Observed is true when this is the observed day of a holiday that falls on a weekend.
+	Observed bool
+}
+
+// LocalizedName returns the holiday name in the given language, falling back to Name
+// when no translation is available.
+func (h HolidayInfo) LocalizedName(lang string) string {
+	if name, ok := h.LocalizedNames[lang]; ok && name != "" {
+		return name
+	}
+	return h.Name
+}
+
+// IsNationwide reports whether the holiday applies to the whole country: it lists no
+// subdivisions and is not in CategoryRegional.
+func (h HolidayInfo) IsNationwide() bool {
+	return len(h.Subdivisions) == 0 && h.Category != CategoryRegional
+}
+
+// AppliesTo reports whether the holiday applies in the given subdivision. Nationwide
+// holidays apply everywhere; a regional holiday without listed subdivisions applies
+// nowhere, since its regions are unknown.
+func (h HolidayInfo) AppliesTo(subdivision string) bool {
+	if h.IsNationwide() {
+		return true
+	}
+	for _, s := range h.Subdivisions {
+		if strings.EqualFold(s, subdivision) {
+			return true
+		}
+	}
+	return false
+}
+
+// holidayInfoProvider is implemented by checkers that expose holiday metadata.
+type holidayInfoProvider interface {
+	GetHoliday(dt DateTime) (*HolidayInfo, bool)
+}
+
+// GetHoliday returns the metadata of the holiday on this date, or false if it is not a
+// holiday. If no holiday checker is provided, it uses the default holiday checker.
+// Checkers without metadata (custom HolidayChecker implementations) yield a public
+// holiday named "Holiday", matching GetHolidayName.
+//
+// Example:
+//
+//	if info, ok := dt.GetHoliday(); ok && info.Category == chronogo.CategoryPublic {
+//	    fmt.Println("Closed for", info.Name)
+//	}
+func (dt DateTime) GetHoliday(holidayChecker ...HolidayChecker) (*HolidayInfo, bool) {
+	checker := holidayCheckerOrDefault(holidayChecker)
+
+	if provider, ok := checker.(holidayInfoProvider); ok {
+		return provider.GetHoliday(dt)
+	}
+	if !checker.IsHoliday(dt) {
+		return nil, false
+	}
+	return &HolidayInfo{
+		Name:     "Holiday",
+		Date:     dt.StartOfDay(),
+		Category: CategoryPublic,
+	}, true
+}
+
 // NextBusinessDay returns the next business day.
 func (dt DateTime) NextBusinessDay(holidayChecker ...HolidayChecker) DateTime {
 	next := dt.AddDays(1)
@@ -430,16 +659,116 @@ func (dt DateTime) PreviousBusinessDay(holidayChecker ...HolidayChecker) DateTim
 	return prev
 }
 
+// RollForwardBusiness returns dt if it is a business day, otherwise the next business day
+// (the "following" date-roll convention). The time of day is kept.
+//
+// Example:
+//
+//	sat := chronogo.Date(2024, 6, 15, 0, 0, 0, 0, time.UTC)
+//	sat.RollForwardBusiness() // Monday 2024-06-17
+func (dt DateTime) RollForwardBusiness(holidayChecker ...HolidayChecker) DateTime {
+	if dt.IsBusinessDay(holidayChecker...) {
+		return dt
+	}
+	return dt.NextBusinessDay(holidayChecker...)
+}
+
+// RollBackwardBusiness returns dt if it is a business day, otherwise the previous
+// business day (the "preceding" date-roll convention). The time of day is kept.
+func (dt DateTime) RollBackwardBusiness(holidayChecker ...HolidayChecker) DateTime {
+	if dt.IsBusinessDay(holidayChecker...) {
+		return dt
+	}
+	return dt.PreviousBusinessDay(holidayChecker...)
+}
+
+// ModifiedFollowing rolls dt forward to a business day unless that crosses into the next
+// month, in which case it rolls back instead (the "modified following" convention used
+// for payment and settlement dates).
+//
+// Example:
+//
+//	// 2024-08-31 is a Saturday; rolling forward would land in September
+//	chronogo.Date(2024, 8, 31, 0, 0, 0, 0, time.UTC).ModifiedFollowing() // Friday 2024-08-30
+func (dt DateTime) ModifiedFollowing(holidayChecker ...HolidayChecker) DateTime {
+	rolled := dt.RollForwardBusiness(holidayChecker...)
+	if rolled.Month() != dt.Month() || rolled.Year() != dt.Year() {
+		return dt.RollBackwardBusiness(holidayChecker...)
+	}
+	return rolled
+}
+
+// ModifiedPreceding rolls dt back to a business day unless that crosses into the previous
+// month, in which case it rolls forward instead.
+func (dt DateTime) ModifiedPreceding(holidayChecker ...HolidayChecker) DateTime {
+	rolled := dt.RollBackwardBusiness(holidayChecker...)
+	if rolled.Month() != dt.Month() || rolled.Year() != dt.Year() {
+		return dt.RollForwardBusiness(holidayChecker...)
+	}
+	return rolled
+}
+
+// NearestBusinessDay returns dt if it is a business day, otherwise whichever of the
+// previous and next business days is fewer calendar days away, for adjusting due dates
+// that fall on weekends or holidays. Ties go to the next business day; use
+// NearestBusinessDayPreferring to choose otherwise. The time of day is kept.
+//
+// Example:
+//
+//	// Saturday 2024-06-15: Friday is one day back, Monday two days ahead
+//	chronogo.Date(2024, 6, 15, 0, 0, 0, 0, time.UTC).NearestBusinessDay() // Friday 2024-06-14
+func (dt DateTime) NearestBusinessDay(holidayChecker ...HolidayChecker) DateTime {
+	return dt.NearestBusinessDayPreferring(RollFollowing, holidayChecker...)
+}
+
+// NearestBusinessDayPreferring is NearestBusinessDay with a tie-break for dates exactly
+// between two business days. RollPreceding picks the earlier day and RollFollowing the
+// later one; RollModifiedFollowing and RollModifiedPreceding prefer the later or
+// earlier day unless it is in a different month than dt. Other conventions behave
+// like RollFollowing.
+func (dt DateTime) NearestBusinessDayPreferring(tieBreak RollConvention, holidayChecker ...HolidayChecker) DateTime {
+	if dt.IsBusinessDay(holidayChecker...) {
+		return dt
+	}
+	prev := dt.PreviousBusinessDay(holidayChecker...)
+	next := dt.NextBusinessDay(holidayChecker...)
+	back, ahead := calendarDaysBetween(prev, dt), calendarDaysBetween(dt, next)
+	switch {
+	case back < ahead:
+		return prev
+	case ahead < back:
+		return next
+	}
+
+	sameMonth := func(d DateTime) bool { return d.Month() == dt.Month() && d.Year() == dt.Year() }
+	switch tieBreak {
+	case RollPreceding:
+		return prev
+	case RollModifiedFollowing:
+		if !sameMonth(next) {
+			return prev
+		}
+	case RollModifiedPreceding:
+		if sameMonth(prev) {
+			return prev
+		}
+	}
+	return next
+}
+
+// calendarDaysBetween returns the number of calendar dates from from to to, ignoring
+// the time of day and DST.
+func calendarDaysBetween(from, to DateTime) int {
+	a := time.Date(from.Year(), from.Month(), from.Day(), 0, 0, 0, 0, time.UTC)
+	b := time.Date(to.Year(), to.Month(), to.Day(), 0, 0, 0, 0, time.UTC)
+	return int(b.Sub(a) / (24 * time.Hour))
+}
+
 // GetHolidaysInRange returns all holidays between this date and the end date.
-// If no holiday checker is provided, it uses the default US holiday checker.
+// If no holiday checker is provided, it uses the default holiday checker.
 // New in goholiday v0.6.4+ - optimized for calendar operations.
 func (dt DateTime) GetHolidaysInRange(end DateTime, holidayChecker ...HolidayChecker) map[DateTime]string {
-	var checker HolidayChecker
-	if len(holidayChecker) > 0 && holidayChecker[0] != nil {
-		checker = holidayChecker[0]
-	} else {
-		checker = defaultUSHolidayChecker
-	}
+	checker := holidayCheckerOrDefault(holidayChecker)
 
 	// Try to cast to GoHolidayChecker for enhanced functionality
 	if ghc, ok := checker.(*GoHolidayChecker); ok {
@@ -545,3 +874,192 @@ func (dt DateTime) BusinessDaysInYear(holidayChecker ...HolidayChecker) int {
 
 	return count
 }
+
+// Business date operations for Period
+
+// Holidays returns the holidays that fall on the period's calendar days, as sorted
+// start-of-day DateTimes. It uses the same range lookup as DateTime.GetHolidaysInRange.
+// If no holiday checker is provided, it uses the default holiday checker.
+//
+// Example:
+//
+//	q4 := chronogo.NewPeriod(chronogo.UTC(2024, 10, 1, 0, 0, 0, 0), chronogo.UTC(2024, 12, 31, 0, 0, 0, 0))
+//	holidays := q4.Holidays(chronogo.NewHolidayChecker("US"))
+func (p Period) Holidays(holidayChecker ...HolidayChecker) []DateTime {
+	p = p.Abs()
+	holidays := p.Start.StartOfDay().GetHolidaysInRange(p.End, holidayChecker...)
+	result := make([]DateTime, 0, len(holidays))
+	for date := range holidays {
+		result = append(result, date.StartOfDay())
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Before(result[j])
+	})
+	return result
+}
+
+// BusinessDays returns the number of business days in the period, counted like
+// DateTime.BusinessDaysBetween: the start day is included and the end day is not.
+// Holidays are looked up once for the whole period.
+// If no holiday checker is provided, it uses the default holiday checker.
+func (p Period) BusinessDays(holidayChecker ...HolidayChecker) int {
+	p = p.Abs()
+	holidays := holidayDateSet(p.Holidays(holidayChecker...))
+
+	count := 0
+	for current := p.Start; current.Before(p.End); current = current.AddDays(1) {
+		if !current.IsWeekend() && !holidays[current.ToDateString()] {
+			count++
+		}
+	}
+	return count
+}
+
+// BusinessHours returns the time within the period covered by the schedule's opening
+// hours, skipping occurrences that start on a holiday. A nil schedule means Monday to
+// Friday, 09:00-17:00 in the location of the period's start.
+// If no holiday checker is provided, it uses the default holiday checker.
+//
+// Example:
+//
+//	hours, _ := chronogo.ParseClockRange("08:30-17:30")
+//	schedule := chronogo.NewWeeklySchedule(ny).Add(hours, time.Monday, time.Tuesday, time.Wednesday, time.Thursday, time.Friday)
+//	worked := sprint.BusinessHours(schedule) // time.Duration
+func (p Period) BusinessHours(schedule *WeeklySchedule, holidayChecker ...HolidayChecker) time.Duration {
+	p = p.Abs()
+	if schedule == nil {
+		schedule = defaultBusinessSchedule(p.Start.Location())
+	}
+
+	occurrences := schedule.OccurrencesBetween(p)
+	if len(occurrences) == 0 {
+		return 0
+	}
+	holidays := holidayDateSet(NewPeriod(occurrences[0].Start, occurrences[len(occurrences)-1].Start).Holidays(holidayChecker...))
+
+	// Occurrences are sorted by start; covered tracks the end of time already counted
+	// so overlapping ranges are not counted twice
+	var total time.Duration
+	covered := p.Start
+	for _, occ := range occurrences {
+		if holidays[occ.Start.ToDateString()] {
+			continue
+		}
+		start, end := occ.Start, occ.End
+		if covered.After(start) {
+			start = covered
+		}
+		if p.End.Before(end) {
+			end = p.End
+		}
+		if end.After(start) {
+			total += end.Sub(start)
+			covered = end
+		}
+	}
+	return total
+}
+
+// defaultBusinessSchedule is Monday to Friday, 09:00-17:00 in loc.
+func defaultBusinessSchedule(loc *time.Location) *WeeklySchedule {
+	return NewWeeklySchedule(loc).Add(ClockRange{Start: 9 * time.Hour, End: 17 * time.Hour},
+		time.Monday, time.Tuesday, time.Wednesday, time.Thursday, time.Friday)
+}
+
+// StartOfBusinessDay returns the opening time on dt's date under the schedule: the
+// earliest start of the ranges on that weekday, in the schedule's location. It returns
+// the zero DateTime if the business is closed all day, because the schedule has no
+// hours on that weekday or the date is a holiday. A nil schedule means Monday to
+// Friday, 09:00-17:00 in dt's location. If no holiday checker is provided, it uses the
+// default holiday checker.
+//
+// Example:
+//
+//	hours, _ := chronogo.ParseClockRange("08:30-17:30")
+//	office := chronogo.NewWeeklySchedule(ny).Add(hours, time.Monday, time.Tuesday, time.Wednesday, time.Thursday, time.Friday)
+//	friday.StartOfBusinessDay(office) // Friday 08:30 New York time
+func (dt DateTime) StartOfBusinessDay(schedule *WeeklySchedule, holidayChecker ...HolidayChecker) DateTime {
+	occurrences := dt.businessOccurrences(schedule, holidayChecker)
+	if len(occurrences) == 0 {
+		return DateTime{}
+	}
+	start := occurrences[0].Start
+	for _, occ := range occurrences[1:] {
+		if occ.Start.Before(start) {
+			start = occ.Start
+		}
+	}
+	return start
+}
+
+// EndOfBusinessDay returns the closing time on dt's date under the schedule: the
+// latest end of the ranges on that weekday, in the schedule's location, so "due by end
+// of business Friday" is friday.EndOfBusinessDay(office). A range that wraps past
+// midnight closes the next day. Closed days, the nil schedule and the holiday checker
+// behave as in StartOfBusinessDay.
+//
+// Example:
+//
+//	deadline := friday.EndOfBusinessDay(office) // Friday 17:30 New York time
+//	if submitted.After(deadline) { ... }
+func (dt DateTime) EndOfBusinessDay(schedule *WeeklySchedule, holidayChecker ...HolidayChecker) DateTime {
+	occurrences := dt.businessOccurrences(schedule, holidayChecker)
+	if len(occurrences) == 0 {
+		return DateTime{}
+	}
+	end := occurrences[0].End
+	for _, occ := range occurrences[1:] {
+		if occ.End.After(end) {
+			end = occ.End
+		}
+	}
+	return end
+}
+
+// IsWithinBusinessHours reports whether dt falls within the schedule's hours, excluding
+// hours that start on a holiday. A nil schedule means Monday to Friday, 09:00-17:00 in
+// dt's location. If no holiday checker is provided, it uses the default holiday
+// checker.
+//
+// Example:
+//
+//	if !chronogo.Now().IsWithinBusinessHours(office) {
+//	    fmt.Println("We'll reply on the next business day")
+//	}
+func (dt DateTime) IsWithinBusinessHours(schedule *WeeklySchedule, holidayChecker ...HolidayChecker) bool {
+	if schedule == nil {
+		schedule = defaultBusinessSchedule(dt.Location())
+	}
+	local := dt.In(schedule.Location())
+	// Ranges that started the previous day may wrap past midnight into dt's day
+	for _, date := range []DateTime{local.AddDays(-1), local} {
+		for _, occ := range date.businessOccurrences(schedule, holidayChecker) {
+			if !dt.Before(occ.Start) && dt.Before(occ.End) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// businessOccurrences returns the schedule's periods starting on dt's date in the
+// schedule's location, or none if that date is a holiday.
+func (dt DateTime) businessOccurrences(schedule *WeeklySchedule, holidayChecker []HolidayChecker) []Period {
+	if schedule == nil {
+		schedule = defaultBusinessSchedule(dt.Location())
+	}
+	date := dt.In(schedule.Location())
+	if date.IsHoliday(holidayChecker...) {
+		return nil
+	}
+	return schedule.occurrencesOn(date)
+}
+
+// holidayDateSet indexes holiday dates by their "YYYY-MM-DD" string.
+func holidayDateSet(holidays []DateTime) map[string]bool {
+	set := make(map[string]bool, len(holidays))
+	for _, h := range holidays {
+		set[h.ToDateString()] = true
+	}
+	return set
+}
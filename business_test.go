@@ -1,6 +1,7 @@
 package chronogo
 
 import (
+	"sync"
 	"testing"
 	"time"
 )
@@ -492,6 +493,121 @@ func TestGoHolidayGetHolidayName(t *testing.T) {
 	}
 }
 
+func TestGetHoliday(t *testing.T) {
+	usChecker := NewGoHolidayChecker("US")
+
+	july4 := Date(2024, time.July, 4, 15, 30, 0, 0, time.UTC)
+	info, ok := usChecker.GetHoliday(july4)
+	if !ok {
+		t.Fatal("GetHoliday() should find Independence Day")
+	}
+	if info.Name != usChecker.GetHolidayName(july4) {
+		t.Errorf("Name = %q, want %q", info.Name, usChecker.GetHolidayName(july4))
+	}
+	if !info.Date.Equal(Date(2024, time.July, 4, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("Date = %v, want midnight of the holiday", info.Date)
+	}
+	// goholiday files every US holiday under "federal"
+	if info.Category != CategoryFederal || info.Observed {
+		t.Errorf("Category = %q, Observed = %v", info.Category, info.Observed)
+	}
+	if info.LocalizedName("en") == "" || info.LocalizedName("xx") != info.Name {
+		t.Errorf("LocalizedName() should translate or fall back to Name")
+	}
+	if !info.IsNationwide() || !info.AppliesTo("CA") {
+		t.Error("a federal holiday should apply in every subdivision")
+	}
+
+	// The returned metadata is a copy
+	for lang := range info.LocalizedNames {
+		info.LocalizedNames[lang] = "changed"
+	}
+	if again, _ := usChecker.GetHoliday(july4); again.LocalizedName("en") == "changed" {
+		t.Error("GetHoliday() should not share maps with the checker")
+	}
+
+	if _, ok := usChecker.GetHoliday(Date(2024, time.March, 5, 0, 0, 0, 0, time.UTC)); ok {
+		t.Error("GetHoliday() should report false for a regular day")
+	}
+
+	// DateTime.GetHoliday uses the default checker and falls back for custom checkers
+	if info, ok := july4.GetHoliday(); !ok || info.Name == "" {
+		t.Errorf("GetHoliday() with default checker = %v, %v", info, ok)
+	}
+	christmas := Date(2024, time.December, 25, 0, 0, 0, 0, time.UTC)
+	if info, ok := christmas.GetHoliday(NewUSHolidayChecker()); !ok || info.Name != "Holiday" {
+		t.Errorf("GetHoliday() with custom checker = %v, %v", info, ok)
+	}
+
+	regional := HolidayInfo{Name: "Cesar Chavez Day", Subdivisions: []string{"CA"}}
+	if regional.IsNationwide() || !regional.AppliesTo("ca") || regional.AppliesTo("NY") {
+		t.Error("AppliesTo() should match listed subdivisions case-insensitively")
+	}
+	alsace := HolidayInfo{Name: "Saint-Étienne", Category: CategoryRegional}
+	if alsace.IsNationwide() || alsace.AppliesTo("75") {
+		t.Error("a regional holiday without subdivisions should not be nationwide")
+	}
+}
+
+func TestGoHolidayCheckerWithCategories(t *testing.T) {
+	all := NewGoHolidayChecker("US")
+	july4 := Date(2024, time.July, 4, 0, 0, 0, 0, time.UTC)
+
+	public := all.WithCategories(CategoryPublic, CategoryBank)
+	if !public.IsHoliday(july4) || july4.IsBusinessDay(public) {
+		t.Error("a public holiday should count when public holidays are selected")
+	}
+	if got := public.Categories(); len(got) != 2 || got[0] != CategoryBank || got[1] != CategoryPublic {
+		t.Errorf("Categories() = %v", got)
+	}
+
+	school := all.WithCategories(CategorySchool)
+	if school.IsHoliday(july4) || !july4.IsBusinessDay(school) {
+		t.Error("a public holiday should not count when only school holidays are selected")
+	}
+	if school.GetHolidayName(july4) != "" || school.CountHolidaysInRange(Date(2024, 1, 1, 0, 0, 0, 0, time.UTC), Date(2024, 12, 31, 0, 0, 0, 0, time.UTC)) != 0 {
+		t.Error("every query should apply the category filter")
+	}
+	if _, ok := school.GetHoliday(july4); ok {
+		t.Error("GetHoliday() should apply the category filter")
+	}
+
+	// The original checker is unchanged, and no categories means all of them
+	if !all.IsHoliday(july4) || all.Categories() != nil {
+		t.Error("WithCategories() should not modify the receiver")
+	}
+	if !school.WithCategories().IsHoliday(july4) {
+		t.Error("WithCategories() without arguments should count every category")
+	}
+}
+
+func TestGoHolidayCheckerCategoriesUS(t *testing.T) {
+	all := NewGoHolidayChecker("US")
+	start := Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC)
+	end := Date(2024, time.December, 31, 0, 0, 0, 0, time.UTC)
+	total := all.CountHolidaysInRange(start, end)
+	if total == 0 {
+		t.Fatal("expected US holidays in 2024")
+	}
+
+	// Every US holiday is filed as federal, which selecting public includes
+	for _, categories := range [][]HolidayCategory{{CategoryFederal}, {CategoryPublic}, {CategoryPublic, CategoryBank}} {
+		if got := all.WithCategories(categories...).CountHolidaysInRange(start, end); got != total {
+			t.Errorf("WithCategories(%v) counts %d holidays, want %d", categories, got, total)
+		}
+	}
+	thanksgiving := Date(2024, time.November, 28, 0, 0, 0, 0, time.UTC)
+	if info, ok := all.WithCategories(CategoryPublic).GetHoliday(thanksgiving); !ok || info.Category != CategoryFederal {
+		t.Errorf("GetHoliday(Thanksgiving) = %v, %v", info, ok)
+	}
+
+	for _, categories := range [][]HolidayCategory{{CategoryBank}, {CategoryRegional, CategoryCultural}} {
+		if got := all.WithCategories(categories...).CountHolidaysInRange(start, end); got != 0 {
+			t.Errorf("WithCategories(%v) counts %d US holidays, want 0", categories, got)
+		}
+	}
+}
+
 func TestDefaultHolidayChecker(t *testing.T) {
 	// Test that business day functions use goholiday by default
 	newYears := Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC) // New Year's Day
@@ -750,3 +866,204 @@ func TestNewCountriesV63(t *testing.T) {
 		})
 	}
 }
+
+func TestPeriodBusinessMetrics(t *testing.T) {
+	checker := NewUSHolidayChecker()
+	// July 2024: Independence Day falls on Thursday July 4
+	july := NewPeriod(UTC(2024, time.July, 1, 0, 0, 0, 0), UTC(2024, time.August, 1, 0, 0, 0, 0))
+
+	holidays := july.Holidays(checker)
+	if len(holidays) != 1 || !holidays[0].Equal(UTC(2024, time.July, 4, 0, 0, 0, 0)) {
+		t.Errorf("Holidays(July 2024) = %v, want [2024-07-04]", holidays)
+	}
+	if got, want := july.BusinessDays(checker), july.Start.BusinessDaysBetween(july.End, checker); got != want || got != 22 {
+		t.Errorf("BusinessDays(July 2024) = %d, want %d (22)", got, want)
+	}
+	if got := NewPeriod(july.End, july.Start).BusinessDays(checker); got != 22 {
+		t.Errorf("BusinessDays of a negative period = %d, want 22", got)
+	}
+
+	// Default schedule: Monday-Friday 09:00-17:00
+	week := NewPeriod(UTC(2024, time.July, 1, 0, 0, 0, 0), UTC(2024, time.July, 8, 0, 0, 0, 0))
+	if got := week.BusinessHours(nil, checker); got != 4*8*time.Hour {
+		t.Errorf("BusinessHours(week of July 4) = %v, want 32h", got)
+	}
+	partial := NewPeriod(UTC(2024, time.July, 1, 15, 0, 0, 0), UTC(2024, time.July, 2, 10, 30, 0, 0))
+	if got := partial.BusinessHours(nil, checker); got != 3*time.Hour+30*time.Minute {
+		t.Errorf("BusinessHours(partial) = %v, want 3h30m", got)
+	}
+
+	// Custom schedule with overlapping ranges counted once
+	morning, _ := ParseClockRange("08:00-12:00")
+	overlap, _ := ParseClockRange("11:00-13:00")
+	schedule := NewWeeklySchedule(time.UTC).Add(morning, time.Saturday).Add(overlap, time.Saturday)
+	if got := week.BusinessHours(schedule, checker); got != 5*time.Hour {
+		t.Errorf("BusinessHours(custom schedule) = %v, want 5h", got)
+	}
+}
+
+func TestBusinessDayBoundaries(t *testing.T) {
+	checker := NewUSHolidayChecker()
+	ny, _ := time.LoadLocation("America/New_York")
+	hours, _ := ParseClockRange("08:30-17:30")
+	office := NewWeeklySchedule(ny).Add(hours, time.Monday, time.Tuesday, time.Wednesday, time.Thursday, time.Friday)
+
+	// Friday July 5 2024, given in UTC: the schedule's location decides the day
+	friday := UTC(2024, time.July, 5, 14, 0, 0, 0)
+	if got := friday.StartOfBusinessDay(office, checker); !got.Equal(Date(2024, time.July, 5, 8, 30, 0, 0, ny)) {
+		t.Errorf("StartOfBusinessDay = %v, want 08:30 New York", got)
+	}
+	deadline := friday.EndOfBusinessDay(office, checker)
+	if !deadline.Equal(Date(2024, time.July, 5, 17, 30, 0, 0, ny)) || deadline.Location() != ny {
+		t.Errorf("EndOfBusinessDay = %v, want 17:30 New York", deadline)
+	}
+
+	// Closed days: holidays and weekdays without hours
+	if got := UTC(2024, time.July, 4, 14, 0, 0, 0).EndOfBusinessDay(office, checker); !got.IsZero() {
+		t.Errorf("EndOfBusinessDay on a holiday = %v, want zero", got)
+	}
+	if got := UTC(2024, time.July, 6, 14, 0, 0, 0).StartOfBusinessDay(office, checker); !got.IsZero() {
+		t.Errorf("StartOfBusinessDay on a Saturday = %v, want zero", got)
+	}
+
+	// Nil schedule: Monday-Friday 09:00-17:00 in dt's location
+	if got := UTC(2024, time.July, 5, 1, 0, 0, 0).EndOfBusinessDay(nil, checker); !got.Equal(UTC(2024, time.July, 5, 17, 0, 0, 0)) {
+		t.Errorf("EndOfBusinessDay(nil) = %v, want 17:00 UTC", got)
+	}
+
+	tests := []struct {
+		name string
+		dt   DateTime
+		want bool
+	}{
+		{"open", Date(2024, time.July, 5, 9, 0, 0, 0, ny), true},
+		{"at opening", Date(2024, time.July, 5, 8, 30, 0, 0, ny), true},
+		{"at closing", Date(2024, time.July, 5, 17, 30, 0, 0, ny), false},
+		{"holiday", Date(2024, time.July, 4, 12, 0, 0, 0, ny), false},
+		{"weekend", Date(2024, time.July, 6, 12, 0, 0, 0, ny), false},
+	}
+	for _, tt := range tests {
+		if got := tt.dt.IsWithinBusinessHours(office, checker); got != tt.want {
+			t.Errorf("%s: IsWithinBusinessHours = %v, want %v", tt.name, got, tt.want)
+		}
+	}
+
+	// A night shift wrapping past midnight counts on the morning after
+	night, _ := ParseClockRange("22:00-06:00")
+	shifts := NewWeeklySchedule(time.UTC).Add(night, time.Friday)
+	if !UTC(2024, time.July, 6, 3, 0, 0, 0).IsWithinBusinessHours(shifts, checker) {
+		t.Error("IsWithinBusinessHours should include a range wrapping from the previous day")
+	}
+	if got := UTC(2024, time.July, 5, 12, 0, 0, 0).EndOfBusinessDay(shifts, checker); !got.Equal(UTC(2024, time.July, 6, 6, 0, 0, 0)) {
+		t.Errorf("EndOfBusinessDay with a wrapping range = %v, want 06:00 the next day", got)
+	}
+}
+
+func TestBusinessDateRolls(t *testing.T) {
+	checker := NewUSHolidayChecker()
+	day := func(m time.Month, d int) DateTime { return Date(2024, m, d, 10, 30, 0, 0, time.UTC) }
+
+	tests := []struct {
+		name                               string
+		dt                                 DateTime
+		forward, backward, modFol, modPrec DateTime
+	}{
+		{"business day", day(time.June, 12), day(time.June, 12), day(time.June, 12), day(time.June, 12), day(time.June, 12)},
+		{"Saturday mid-month", day(time.June, 15), day(time.June, 17), day(time.June, 14), day(time.June, 17), day(time.June, 14)},
+		{"Saturday at month end", day(time.August, 31), day(time.September, 3), day(time.August, 30), day(time.August, 30), day(time.August, 30)},
+		{"Sunday at month start", day(time.September, 1), day(time.September, 3), day(time.August, 30), day(time.September, 3), day(time.September, 3)},
+		{"holiday", day(time.July, 4), day(time.July, 5), day(time.July, 3), day(time.July, 5), day(time.July, 3)},
+	}
+	for _, tt := range tests {
+		if got := tt.dt.RollForwardBusiness(checker); !got.Equal(tt.forward) {
+			t.Errorf("%s: RollForwardBusiness = %v, want %v", tt.name, got, tt.forward)
+		}
+		if got := tt.dt.RollBackwardBusiness(checker); !got.Equal(tt.backward) {
+			t.Errorf("%s: RollBackwardBusiness = %v, want %v", tt.name, got, tt.backward)
+		}
+		if got := tt.dt.ModifiedFollowing(checker); !got.Equal(tt.modFol) {
+			t.Errorf("%s: ModifiedFollowing = %v, want %v", tt.name, got, tt.modFol)
+		}
+		if got := tt.dt.ModifiedPreceding(checker); !got.Equal(tt.modPrec) {
+			t.Errorf("%s: ModifiedPreceding = %v, want %v", tt.name, got, tt.modPrec)
+		}
+	}
+}
+
+func TestNearestBusinessDay(t *testing.T) {
+	day := func(y int, m time.Month, d int) DateTime { return Date(y, m, d, 10, 30, 0, 0, time.UTC) }
+
+	// Weekends go to whichever weekday is closer; business days are unchanged
+	if got := day(2024, time.June, 15).NearestBusinessDay(&NullChecker{}); !got.Equal(day(2024, time.June, 14)) {
+		t.Errorf("Saturday: NearestBusinessDay = %v, want Friday", got)
+	}
+	if got := day(2024, time.June, 16).NearestBusinessDay(&NullChecker{}); !got.Equal(day(2024, time.June, 17)) {
+		t.Errorf("Sunday: NearestBusinessDay = %v, want Monday", got)
+	}
+	if got := day(2024, time.June, 12).NearestBusinessDay(&NullChecker{}); !got.Equal(day(2024, time.June, 12)) {
+		t.Errorf("business day: NearestBusinessDay = %v, want unchanged", got)
+	}
+
+	// A midweek holiday on a month end is a tie between Tuesday and Thursday
+	year := 2024
+	checker := NewUSHolidayChecker()
+	checker.AddHoliday(Holiday{Name: "Company Day", Month: time.July, Day: 31, Year: &year})
+	holiday := day(2024, time.July, 31)
+	tests := []struct {
+		tieBreak RollConvention
+		want     DateTime
+	}{
+		{RollFollowing, day(2024, time.August, 1)},
+		{RollPreceding, day(2024, time.July, 30)},
+		{RollModifiedFollowing, day(2024, time.July, 30)},
+		{RollModifiedPreceding, day(2024, time.July, 30)},
+	}
+	for _, tt := range tests {
+		if got := holiday.NearestBusinessDayPreferring(tt.tieBreak, checker); !got.Equal(tt.want) {
+			t.Errorf("NearestBusinessDayPreferring(%d) = %v, want %v", tt.tieBreak, got, tt.want)
+		}
+	}
+	if got := holiday.NearestBusinessDay(checker); !got.Equal(day(2024, time.August, 1)) {
+		t.Errorf("NearestBusinessDay tie = %v, want the next business day", got)
+	}
+
+	// New Year's Day 2025 is a Wednesday: the modified preceding tie-break stays in January
+	if got := day(2025, time.January, 1).NearestBusinessDayPreferring(RollModifiedPreceding, checker); !got.Equal(day(2025, time.January, 2)) {
+		t.Errorf("NearestBusinessDayPreferring(RollModifiedPreceding) = %v, want January 2", got)
+	}
+	if got := RollNearest.Apply(day(2024, time.June, 15), &NullChecker{}); !got.Equal(day(2024, time.June, 14)) {
+		t.Errorf("RollNearest.Apply = %v, want Friday", got)
+	}
+}
+
+func TestSetDefaultHolidayCountry(t *testing.T) {
+	defer SetDefaultHolidayCountry("US")
+
+	if got := DefaultHolidayCountry(); got != "US" {
+		t.Fatalf("DefaultHolidayCountry() = %q, want US", got)
+	}
+	SetDefaultHolidayCountry(" de ")
+	if got := DefaultHolidayCountry(); got != "DE" {
+		t.Errorf("DefaultHolidayCountry() = %q, want DE", got)
+	}
+	checker, ok := defaultHolidayChecker().(*GoHolidayChecker)
+	if !ok || checker.GetCountry() != "DE" {
+		t.Fatalf("default checker = %#v, want a DE GoHolidayChecker", defaultHolidayChecker())
+	}
+	if defaultHolidayChecker() != HolidayChecker(checker) {
+		t.Error("default checker should be created once and reused")
+	}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			if i%2 == 0 {
+				SetDefaultHolidayCountry([]string{"US", "GB"}[i%4/2])
+			}
+			Date(2024, time.December, 25, 0, 0, 0, 0, time.UTC).IsHoliday()
+		}(i)
+	}
+	wg.Wait()
+}
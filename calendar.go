@@ -2,7 +2,9 @@ package chronogo
 
 import (
 	"fmt"
+	"strings"
 	"time"
+	"unicode/utf8"
 
 	goholiday "github.com/coredds/goholiday"
 )
@@ -146,3 +148,96 @@ func (dt DateTime) GetUpcomingHolidays(countryCode string, count int) []Calendar
 	calendar := NewHolidayCalendar(countryCode)
 	return calendar.GetUpcomingHolidays(dt, count)
 }
+
+// MonthCalendarOptions configures MonthCalendarString.
+type MonthCalendarOptions struct {
+	// WeekStart is the first column of the grid (time.Sunday, the zero value, by default).
+	WeekStart time.Weekday
+
+	// Locale is the locale code for month and weekday names. Empty or unknown codes
+	// use the default locale.
+	Locale string
+
+	// HighlightToday brackets today's date, e.g. "[15]". Today defaults to Now().
+	HighlightToday bool
+	Today          DateTime
+
+	// HolidayChecker marks holidays with an asterisk, e.g. " 4*". Nil marks none.
+	HolidayChecker HolidayChecker
+}
+
+// MonthCalendarString renders a month as a text grid in the style of the Unix cal
+// command, for CLI tools and logs. Each day takes four columns; when a day is both
+// today and a holiday, the today brackets win.
+//
+// Example:
+//
+//	fmt.Print(chronogo.MonthCalendarString(2024, time.July, chronogo.MonthCalendarOptions{
+//		WeekStart:      time.Monday,
+//		HolidayChecker: chronogo.NewUSHolidayChecker(),
+//	}))
+//
+//	         July 2024
+//	 Mo  Tu  We  Th  Fr  Sa  Su
+//	  1   2   3   4*  5   6   7
+//	  8   9  10  11  12  13  14
+//	 ...
+func MonthCalendarString(year int, month time.Month, opts MonthCalendarOptions) string {
+	locale, err := GetLocale(opts.Locale)
+	if err != nil {
+		locale, _ = GetLocale(GetDefaultLocale())
+	}
+
+	first := Date(year, month, 1, 0, 0, 0, 0, time.UTC)
+	var b strings.Builder
+
+	title := fmt.Sprintf("%s %d", locale.MonthNames[first.Month()-1], first.Year())
+	if pad := (28 - utf8.RuneCountInString(title)) / 2; pad > 0 {
+		b.WriteString(strings.Repeat(" ", pad))
+	}
+	b.WriteString(title)
+	b.WriteString("\n")
+
+	var header strings.Builder
+	for i := 0; i < 7; i++ {
+		abbr := []rune(locale.WeekdayAbbr[(int(opts.WeekStart)+i)%7])
+		if len(abbr) > 2 {
+			abbr = abbr[:2]
+		}
+		fmt.Fprintf(&header, " %2s ", string(abbr))
+	}
+	b.WriteString(strings.TrimRight(header.String(), " "))
+	b.WriteString("\n")
+
+	todayKey := 0
+	if opts.HighlightToday {
+		today := opts.Today
+		if today.IsZero() {
+			today = Now()
+		}
+		todayKey = today.DateKey()
+	}
+
+	var line strings.Builder
+	line.WriteString(strings.Repeat("    ", (int(first.Weekday())-int(opts.WeekStart)+7)%7))
+	for day := first; day.Month() == first.Month(); day = day.AddDays(1) {
+		switch {
+		case day.DateKey() == todayKey:
+			fmt.Fprintf(&line, "[%2d]", day.Day())
+		case opts.HolidayChecker != nil && opts.HolidayChecker.IsHoliday(day):
+			fmt.Fprintf(&line, " %2d*", day.Day())
+		default:
+			fmt.Fprintf(&line, " %2d ", day.Day())
+		}
+		if day.AddDays(1).Weekday() == opts.WeekStart {
+			b.WriteString(strings.TrimRight(line.String(), " "))
+			b.WriteString("\n")
+			line.Reset()
+		}
+	}
+	if line.Len() > 0 {
+		b.WriteString(strings.TrimRight(line.String(), " "))
+		b.WriteString("\n")
+	}
+	return b.String()
+}
@@ -0,0 +1,47 @@
+package chronogo
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestMonthCalendarString(t *testing.T) {
+	got := MonthCalendarString(2024, time.July, MonthCalendarOptions{
+		WeekStart:      time.Monday,
+		HolidayChecker: NewUSHolidayChecker(),
+		HighlightToday: true,
+		Today:          Date(2024, time.July, 15, 9, 0, 0, 0, time.UTC),
+	})
+	want := "         July 2024\n" +
+		" Mo  Tu  We  Th  Fr  Sa  Su\n" +
+		"  1   2   3   4*  5   6   7\n" +
+		"  8   9  10  11  12  13  14\n" +
+		"[15] 16  17  18  19  20  21\n" +
+		" 22  23  24  25  26  27  28\n" +
+		" 29  30  31\n"
+	if got != want {
+		t.Errorf("MonthCalendarString() =\n%s\nwant\n%s", got, want)
+	}
+
+	// Sunday start and locale names; no highlighting without options
+	got = MonthCalendarString(2024, time.February, MonthCalendarOptions{Locale: "fr-FR"})
+	lines := strings.Split(strings.TrimSuffix(got, "\n"), "\n")
+	if strings.TrimSpace(lines[0]) != "février 2024" || lines[1] != " di  lu  ma  me  je  ve  sa" {
+		t.Errorf("MonthCalendarString() header =\n%s", got)
+	}
+	if lines[2] != "                  1   2   3" || lines[len(lines)-1] != " 25  26  27  28  29" {
+		t.Errorf("MonthCalendarString() grid =\n%s", got)
+	}
+	if strings.ContainsAny(got, "[]*") {
+		t.Errorf("MonthCalendarString() should not mark days by default:\n%s", got)
+	}
+
+	// Today comes from the testable clock when not given
+	WithTestNow(Date(2024, time.February, 29, 12, 0, 0, 0, time.UTC), func() {
+		got := MonthCalendarString(2024, time.February, MonthCalendarOptions{Locale: "unknown", HighlightToday: true})
+		if !strings.Contains(got, "[29]") || !strings.Contains(got, "February 2024") {
+			t.Errorf("MonthCalendarString() with test now =\n%s", got)
+		}
+	})
+}
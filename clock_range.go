@@ -0,0 +1,328 @@
+package chronogo
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// clockDay is the length of the day a ClockRange repeats over.
+const clockDay = 24 * time.Hour
+
+// ClockRange is a daily time-of-day window such as opening hours (09:00–17:30) or a
+// maintenance window (22:00–06:00). Unlike Period it is not anchored to a date and
+// repeats every day in whatever location it is applied to.
+//
+// Start and End are offsets from midnight. The range includes Start and excludes End.
+// When End is before Start the range wraps past midnight; when they are equal the
+// range covers the whole day.
+type ClockRange struct {
+	Start time.Duration
+	End   time.Duration
+}
+
+// NewClockRange creates a ClockRange from hour and minute components.
+// The end may be given as 24:00 to mean midnight at the end of the day.
+//
+// Example:
+//
+//	hours, _ := chronogo.NewClockRange(9, 0, 17, 30)     // 09:00-17:30
+//	maintenance, _ := chronogo.NewClockRange(22, 0, 6, 0) // wraps past midnight
+func NewClockRange(startHour, startMinute, endHour, endMinute int) (ClockRange, error) {
+	if !IsValidTime(startHour, startMinute, 0) || (!IsValidTime(endHour, endMinute, 0) && !(endHour == 24 && endMinute == 0)) {
+		return ClockRange{}, &ChronoError{
+			Op:         "NewClockRange",
+			Input:      fmt.Sprintf("%02d:%02d-%02d:%02d", startHour, startMinute, endHour, endMinute),
+			Err:        fmt.Errorf("%w: clock times must be between 00:00 and 24:00", ErrInvalidRange),
+			Suggestion: "Use hours 0-23 and minutes 0-59 (24:00 is allowed as an end time)",
+		}
+	}
+	start := time.Duration(startHour)*time.Hour + time.Duration(startMinute)*time.Minute
+	end := (time.Duration(endHour)*time.Hour + time.Duration(endMinute)*time.Minute) % clockDay
+	return ClockRange{Start: start, End: end}, nil
+}
+
+// ParseClockRange parses a range written as "HH:MM-HH:MM" (seconds are optional),
+// e.g. "09:00-17:30" or "22:00-06:00".
+func ParseClockRange(value string) (ClockRange, error) {
+	parts := strings.Split(strings.ReplaceAll(value, "–", "-"), "-")
+	if len(parts) != 2 {
+		return ClockRange{}, ParseError(value, fmt.Errorf("%w: expected a range like \"09:00-17:30\"", ErrInvalidFormat))
+	}
+	start, err := parseClockTime(strings.TrimSpace(parts[0]), false)
+	if err != nil {
+		return ClockRange{}, ParseError(value, err)
+	}
+	end, err := parseClockTime(strings.TrimSpace(parts[1]), true)
+	if err != nil {
+		return ClockRange{}, ParseError(value, err)
+	}
+	return ClockRange{Start: start, End: end % clockDay}, nil
+}
+
+// parseClockTime parses "HH:MM" or "HH:MM:SS" into an offset from midnight.
+// allowMidnightEnd permits "24:00" (with zero seconds).
+func parseClockTime(value string, allowMidnightEnd bool) (time.Duration, error) {
+	fields := strings.Split(value, ":")
+	if len(fields) < 2 || len(fields) > 3 {
+		return 0, fmt.Errorf("%w: invalid clock time %q", ErrInvalidFormat, value)
+	}
+	var parts [3]int
+	for i, f := range fields {
+		n, err := strconv.Atoi(f)
+		if err != nil || len(f) > 2 {
+			return 0, fmt.Errorf("%w: invalid clock time %q", ErrInvalidFormat, value)
+		}
+		parts[i] = n
+	}
+	hour, minute, second := parts[0], parts[1], parts[2]
+	if !IsValidTime(hour, minute, second) && !(allowMidnightEnd && hour == 24 && minute == 0 && second == 0) {
+		return 0, fmt.Errorf("%w: clock time %q out of range", ErrInvalidRange, value)
+	}
+	return time.Duration(hour)*time.Hour + time.Duration(minute)*time.Minute + time.Duration(second)*time.Second, nil
+}
+
+// WrapsMidnight reports whether the range continues past midnight into the next day.
+func (r ClockRange) WrapsMidnight() bool {
+	return r.End < r.Start || (r.End == r.Start && r.Start != 0)
+}
+
+// Duration returns the length of the range; a whole-day range is 24 hours.
+// DST changes on a particular day are not taken into account.
+func (r ClockRange) Duration() time.Duration {
+	if r.End > r.Start {
+		return r.End - r.Start
+	}
+	return clockDay - r.Start + r.End
+}
+
+// ContainsClock reports whether a time of day, given as an offset from midnight,
+// falls within the range.
+func (r ClockRange) ContainsClock(clock time.Duration) bool {
+	switch {
+	case r.Start == r.End:
+		return true
+	case r.Start < r.End:
+		return clock >= r.Start && clock < r.End
+	default:
+		return clock >= r.Start || clock < r.End
+	}
+}
+
+// Contains reports whether the datetime's wall-clock time falls within the range.
+//
+// Example:
+//
+//	window, _ := chronogo.ParseClockRange("22:00-06:00")
+//	window.Contains(chronogo.Date(2024, 6, 15, 23, 30, 0, 0, time.UTC)) // true
+//	window.Contains(chronogo.Date(2024, 6, 15, 12, 0, 0, 0, time.UTC))  // false
+func (r ClockRange) Contains(dt DateTime) bool {
+	return r.ContainsClock(clockOf(dt))
+}
+
+// NextOpen returns dt if it falls within the range, otherwise the next time the range
+// starts, in dt's location.
+//
+// Example:
+//
+//	hours, _ := chronogo.ParseClockRange("09:00-17:00")
+//	hours.NextOpen(chronogo.Date(2024, 6, 15, 18, 0, 0, 0, time.UTC)) // 2024-06-16 09:00
+func (r ClockRange) NextOpen(dt DateTime) DateTime {
+	if r.Contains(dt) {
+		return dt
+	}
+	next := atClock(dt, r.Start)
+	if !next.After(dt) {
+		next = atClock(dt.AddDays(1), r.Start)
+	}
+	return next
+}
+
+// NextClose returns the next time at or after dt when the range ends, in dt's location.
+// For a dt outside the range this is the end of the next opening. A whole-day range
+// never closes and returns the zero DateTime.
+func (r ClockRange) NextClose(dt DateTime) DateTime {
+	if r.Start == r.End {
+		return DateTime{}
+	}
+	open := r.NextOpen(dt)
+	end := atClock(open, r.End)
+	if !end.After(open) {
+		end = atClock(open.AddDays(1), r.End)
+	}
+	return end
+}
+
+// Overlaps reports whether two ranges share any time of day, taking ranges that wrap
+// past midnight into account.
+//
+// Example:
+//
+//	night, _ := chronogo.ParseClockRange("22:00-06:00")
+//	early, _ := chronogo.ParseClockRange("05:00-09:00")
+//	night.Overlaps(early) // true (05:00-06:00)
+func (r ClockRange) Overlaps(other ClockRange) bool {
+	return r.OverlapDuration(other) > 0
+}
+
+// OverlapDuration returns how much time of day the two ranges have in common.
+func (r ClockRange) OverlapDuration(other ClockRange) time.Duration {
+	var total time.Duration
+	for _, a := range r.segments() {
+		for _, b := range other.segments() {
+			if start, end := max(a[0], b[0]), min(a[1], b[1]); end > start {
+				total += end - start
+			}
+		}
+	}
+	return total
+}
+
+// String formats the range as "HH:MM-HH:MM", including seconds when either end has them.
+func (r ClockRange) String() string {
+	seconds := r.Start%time.Minute != 0 || r.End%time.Minute != 0
+	return formatClock(r.Start, seconds) + "-" + formatClock(r.End, seconds)
+}
+
+// segments splits the range into non-wrapping [start, end) pieces within a single day.
+func (r ClockRange) segments() [][2]time.Duration {
+	switch {
+	case r.Start == r.End:
+		return [][2]time.Duration{{0, clockDay}}
+	case r.Start < r.End:
+		return [][2]time.Duration{{r.Start, r.End}}
+	default:
+		return [][2]time.Duration{{r.Start, clockDay}, {0, r.End}}
+	}
+}
+
+// WeeklySchedule is a set of ClockRanges per weekday in a fixed location, such as
+// opening hours or a recurring maintenance window. A range that wraps past midnight
+// belongs to the weekday it starts on.
+type WeeklySchedule struct {
+	location *time.Location
+	ranges   [7][]ClockRange
+}
+
+// NewWeeklySchedule creates an empty schedule whose ranges are interpreted in loc
+// (UTC when loc is nil).
+//
+// Example:
+//
+//	window, _ := chronogo.ParseClockRange("02:00-04:00")
+//	maintenance := chronogo.NewWeeklySchedule(time.UTC).Add(window, time.Sunday)
+func NewWeeklySchedule(loc *time.Location) *WeeklySchedule {
+	if loc == nil {
+		loc = time.UTC
+	}
+	return &WeeklySchedule{location: loc}
+}
+
+// Add adds a range on each of the given weekdays and returns the schedule for chaining.
+// With no weekdays the range is added to every day; invalid weekdays are ignored.
+func (s *WeeklySchedule) Add(r ClockRange, weekdays ...time.Weekday) *WeeklySchedule {
+	if len(weekdays) == 0 {
+		weekdays = []time.Weekday{time.Sunday, time.Monday, time.Tuesday, time.Wednesday, time.Thursday, time.Friday, time.Saturday}
+	}
+	for _, wd := range weekdays {
+		if wd < time.Sunday || wd > time.Saturday {
+			continue
+		}
+		s.ranges[wd] = append(s.ranges[wd], r)
+	}
+	return s
+}
+
+// Location returns the location the schedule's clock times are interpreted in.
+func (s *WeeklySchedule) Location() *time.Location {
+	return s.location
+}
+
+// Ranges returns the ranges starting on the given weekday.
+func (s *WeeklySchedule) Ranges(weekday time.Weekday) []ClockRange {
+	if weekday < time.Sunday || weekday > time.Saturday {
+		return nil
+	}
+	return append([]ClockRange(nil), s.ranges[weekday]...)
+}
+
+// IsActiveAt reports whether dt falls within any occurrence of the schedule,
+// including ranges that started the previous day and wrap past midnight.
+func (s *WeeklySchedule) IsActiveAt(dt DateTime) bool {
+	local := dt.In(s.location)
+	for _, date := range []DateTime{local.AddDays(-1), local} {
+		for _, occ := range s.occurrencesOn(date) {
+			if !dt.Before(occ.Start) && dt.Before(occ.End) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// OccurrencesBetween expands the schedule into concrete Periods, in the schedule's
+// location, for every occurrence that overlaps p. Occurrences are returned whole (not
+// clipped to p) and sorted by start time.
+//
+// Example:
+//
+//	month := chronogo.NewPeriod(chronogo.UTC(2024, 6, 1, 0, 0, 0, 0), chronogo.UTC(2024, 7, 1, 0, 0, 0, 0))
+//	windows := maintenance.OccurrencesBetween(month) // five Sunday 02:00-04:00 windows
+func (s *WeeklySchedule) OccurrencesBetween(p Period) []Period {
+	p = p.Abs()
+	var result []Period
+	first := p.Start.In(s.location).AddDays(-1).StartOfDay()
+	last := p.End.In(s.location)
+	for date := first; !date.After(last); date = date.AddDays(1) {
+		for _, occ := range s.occurrencesOn(date) {
+			if occ.Start.Before(p.End) && occ.End.After(p.Start) {
+				result = append(result, occ)
+			}
+		}
+	}
+	sort.SliceStable(result, func(i, j int) bool {
+		return result[i].Start.Before(result[j].Start)
+	})
+	return result
+}
+
+// occurrencesOn returns the periods of the ranges starting on date's weekday.
+func (s *WeeklySchedule) occurrencesOn(date DateTime) []Period {
+	ranges := s.ranges[date.Weekday()]
+	if len(ranges) == 0 {
+		return nil
+	}
+	periods := make([]Period, 0, len(ranges))
+	for _, r := range ranges {
+		start := atClock(date, r.Start)
+		end := atClock(date, r.End)
+		if r.End <= r.Start {
+			end = atClock(date.AddDays(1), r.End)
+		}
+		periods = append(periods, NewPeriod(start, end))
+	}
+	return periods
+}
+
+// clockOf returns the datetime's wall-clock time as an offset from midnight.
+func clockOf(dt DateTime) time.Duration {
+	return time.Duration(dt.Hour())*time.Hour + time.Duration(dt.Minute())*time.Minute +
+		time.Duration(dt.Second())*time.Second + time.Duration(dt.Nanosecond())
+}
+
+// atClock returns the given wall-clock time on dt's date in dt's location.
+func atClock(dt DateTime, clock time.Duration) DateTime {
+	return Date(dt.Year(), dt.Month(), dt.Day(), 0, 0, 0, int(clock), dt.Location())
+}
+
+// formatClock formats an offset from midnight as "HH:MM" or "HH:MM:SS".
+func formatClock(clock time.Duration, seconds bool) string {
+	h := int(clock / time.Hour)
+	m := int(clock % time.Hour / time.Minute)
+	if seconds {
+		return fmt.Sprintf("%02d:%02d:%02d", h, m, int(clock%time.Minute/time.Second))
+	}
+	return fmt.Sprintf("%02d:%02d", h, m)
+}
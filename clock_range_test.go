@@ -0,0 +1,195 @@
+package chronogo
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestClockRangeContains(t *testing.T) {
+	hours, err := NewClockRange(9, 0, 17, 30)
+	if err != nil {
+		t.Fatalf("NewClockRange error: %v", err)
+	}
+	night, err := ParseClockRange("22:00-06:00")
+	if err != nil {
+		t.Fatalf("ParseClockRange error: %v", err)
+	}
+
+	tests := []struct {
+		r     ClockRange
+		hour  int
+		min   int
+		wants bool
+	}{
+		{hours, 9, 0, true},
+		{hours, 17, 29, true},
+		{hours, 17, 30, false},
+		{hours, 8, 59, false},
+		{night, 23, 0, true},
+		{night, 0, 0, true},
+		{night, 5, 59, true},
+		{night, 6, 0, false},
+		{night, 12, 0, false},
+		{night, 22, 0, true},
+	}
+	for _, tt := range tests {
+		dt := Date(2024, time.June, 15, tt.hour, tt.min, 0, 0, time.UTC)
+		if got := tt.r.Contains(dt); got != tt.wants {
+			t.Errorf("%s.Contains(%02d:%02d) = %v, want %v", tt.r, tt.hour, tt.min, got, tt.wants)
+		}
+	}
+
+	if hours.WrapsMidnight() || !night.WrapsMidnight() {
+		t.Error("unexpected WrapsMidnight result")
+	}
+	if hours.Duration() != 8*time.Hour+30*time.Minute || night.Duration() != 8*time.Hour {
+		t.Errorf("Duration() = %v / %v", hours.Duration(), night.Duration())
+	}
+	allDay := ClockRange{}
+	if !allDay.Contains(Date(2024, time.June, 15, 3, 0, 0, 0, time.UTC)) || allDay.Duration() != 24*time.Hour {
+		t.Error("Expected an empty ClockRange to cover the whole day")
+	}
+	if night.String() != "22:00-06:00" {
+		t.Errorf("String() = %q", night.String())
+	}
+}
+
+func TestClockRangeNextOpenClose(t *testing.T) {
+	hours, _ := ParseClockRange("09:00-17:00")
+	night, _ := ParseClockRange("22:00-06:00")
+
+	at := func(day, hour, min int) DateTime { return Date(2024, time.June, day, hour, min, 0, 0, time.UTC) }
+
+	tests := []struct {
+		name      string
+		r         ClockRange
+		dt        DateTime
+		open      DateTime
+		nextClose DateTime
+	}{
+		{"before opening", hours, at(15, 8, 0), at(15, 9, 0), at(15, 17, 0)},
+		{"while open", hours, at(15, 10, 0), at(15, 10, 0), at(15, 17, 0)},
+		{"after closing", hours, at(15, 18, 0), at(16, 9, 0), at(16, 17, 0)},
+		{"at closing", hours, at(15, 17, 0), at(16, 9, 0), at(16, 17, 0)},
+		{"overnight evening", night, at(15, 23, 0), at(15, 23, 0), at(16, 6, 0)},
+		{"overnight morning", night, at(15, 2, 0), at(15, 2, 0), at(15, 6, 0)},
+		{"overnight daytime", night, at(15, 12, 0), at(15, 22, 0), at(16, 6, 0)},
+	}
+	for _, tt := range tests {
+		if got := tt.r.NextOpen(tt.dt); !got.Equal(tt.open) {
+			t.Errorf("%s: NextOpen = %v, want %v", tt.name, got, tt.open)
+		}
+		if got := tt.r.NextClose(tt.dt); !got.Equal(tt.nextClose) {
+			t.Errorf("%s: NextClose = %v, want %v", tt.name, got, tt.nextClose)
+		}
+	}
+	if !(ClockRange{}).NextClose(at(15, 12, 0)).IsZero() {
+		t.Error("Expected a whole-day range never to close")
+	}
+}
+
+func TestClockRangeOverlaps(t *testing.T) {
+	parse := func(s string) ClockRange {
+		r, err := ParseClockRange(s)
+		if err != nil {
+			t.Fatalf("ParseClockRange(%q) error: %v", s, err)
+		}
+		return r
+	}
+	tests := []struct {
+		a, b    string
+		overlap time.Duration
+	}{
+		{"09:00-17:00", "16:00-18:00", time.Hour},
+		{"09:00-17:00", "17:00-18:00", 0},
+		{"22:00-06:00", "05:00-09:00", time.Hour},
+		{"22:00-06:00", "21:00-23:00", time.Hour},
+		{"22:00-06:00", "23:00-02:00", 3 * time.Hour},
+		{"22:00-06:00", "12:00-13:00", 0},
+		{"20:00-04:00", "02:00-22:00", 4 * time.Hour},
+	}
+	for _, tt := range tests {
+		a, b := parse(tt.a), parse(tt.b)
+		if got := a.OverlapDuration(b); got != tt.overlap {
+			t.Errorf("%s overlap %s = %v, want %v", tt.a, tt.b, got, tt.overlap)
+		}
+		if a.Overlaps(b) != (tt.overlap > 0) || b.Overlaps(a) != (tt.overlap > 0) {
+			t.Errorf("%s.Overlaps(%s) inconsistent with overlap %v", tt.a, tt.b, tt.overlap)
+		}
+	}
+}
+
+func TestClockRangeErrors(t *testing.T) {
+	if r, err := ParseClockRange("18:00-24:00"); err != nil || r.End != 0 || r.Duration() != 6*time.Hour {
+		t.Errorf("ParseClockRange(18:00-24:00) = %v, %v", r, err)
+	}
+	for _, input := range []string{"", "09:00", "9-17", "25:00-06:00", "09:60-10:00", "24:00-06:00", "09:00-10:00-11:00"} {
+		if _, err := ParseClockRange(input); err == nil {
+			t.Errorf("ParseClockRange(%q) expected error", input)
+		}
+	}
+	if _, err := NewClockRange(9, 0, 24, 30); !errors.Is(err, ErrInvalidRange) {
+		t.Errorf("NewClockRange(9, 0, 24, 30) error = %v, want ErrInvalidRange", err)
+	}
+}
+
+func TestWeeklySchedule(t *testing.T) {
+	window, _ := ParseClockRange("02:00-04:00")
+	maintenance := NewWeeklySchedule(time.UTC).Add(window, time.Sunday)
+
+	june := NewPeriod(UTC(2024, time.June, 1, 0, 0, 0, 0), UTC(2024, time.July, 1, 0, 0, 0, 0))
+	occurrences := maintenance.OccurrencesBetween(june)
+	if len(occurrences) != 5 {
+		t.Fatalf("OccurrencesBetween(June) = %d occurrences, want 5", len(occurrences))
+	}
+	for i, occ := range occurrences {
+		if occ.Start.Weekday() != time.Sunday || occ.Start.Hour() != 2 || occ.Duration() != 2*time.Hour {
+			t.Errorf("occurrence %d = %v - %v", i, occ.Start, occ.End)
+		}
+	}
+	if !occurrences[0].Start.Equal(UTC(2024, time.June, 2, 2, 0, 0, 0)) {
+		t.Errorf("first occurrence = %v, want 2024-06-02 02:00", occurrences[0].Start)
+	}
+
+	if !maintenance.IsActiveAt(UTC(2024, time.June, 9, 3, 0, 0, 0)) {
+		t.Error("Expected schedule to be active Sunday 03:00")
+	}
+	if maintenance.IsActiveAt(UTC(2024, time.June, 9, 4, 0, 0, 0)) || maintenance.IsActiveAt(UTC(2024, time.June, 10, 3, 0, 0, 0)) {
+		t.Error("Expected schedule to be inactive at 04:00 and on Monday")
+	}
+}
+
+func TestWeeklyScheduleWrapsAndLocations(t *testing.T) {
+	ny, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Skip("America/New_York timezone not available")
+	}
+	night, _ := ParseClockRange("22:00-06:00")
+	day, _ := ParseClockRange("09:00-12:00")
+	schedule := NewWeeklySchedule(ny).Add(night, time.Friday).Add(day)
+
+	// Friday 22:00 to Saturday 06:00 New York time, checked with UTC instants
+	if !schedule.IsActiveAt(Date(2024, time.June, 15, 5, 0, 0, 0, ny)) {
+		t.Error("Expected Friday's overnight window to be active early Saturday")
+	}
+	if !schedule.IsActiveAt(Date(2024, time.June, 14, 23, 0, 0, 0, ny).UTC()) {
+		t.Error("Expected schedule to evaluate UTC instants in its location")
+	}
+	if schedule.IsActiveAt(Date(2024, time.June, 16, 5, 0, 0, 0, ny)) {
+		t.Error("Expected Sunday 05:00 to be inactive")
+	}
+	if len(schedule.Ranges(time.Friday)) != 2 || len(schedule.Ranges(time.Monday)) != 1 {
+		t.Error("unexpected Ranges result")
+	}
+
+	// An occurrence starting before the period but overlapping it is included whole
+	saturdayMorning := NewPeriod(Date(2024, time.June, 15, 0, 0, 0, 0, ny), Date(2024, time.June, 15, 8, 0, 0, 0, ny))
+	occurrences := schedule.OccurrencesBetween(saturdayMorning)
+	if len(occurrences) != 1 || !occurrences[0].Start.Equal(Date(2024, time.June, 14, 22, 0, 0, 0, ny)) || occurrences[0].Duration() != 8*time.Hour {
+		t.Errorf("OccurrencesBetween(Saturday morning) = %v", occurrences)
+	}
+	if occurrences[0].Start.Location() != ny {
+		t.Error("Expected occurrences in the schedule's location")
+	}
+}
@@ -1,5 +1,11 @@
 package chronogo
 
+import (
+	"math"
+	"slices"
+	"time"
+)
+
 // IsBirthday checks if the given DateTime represents the same birthday (month and day).
 // This is useful for checking if a date is someone's birthday, regardless of the year.
 //
@@ -165,15 +171,403 @@ func (dt DateTime) IsSameQuarter(other DateTime) bool {
 	return dt.Year() == other.Year() && dt.Quarter() == other.Quarter()
 }
 
-// IsSameWeek checks if the given DateTime is in the same ISO week and year.
+// IsSameHalf checks if the given DateTime is in the same half of the same year.
+func (dt DateTime) IsSameHalf(other DateTime) bool {
+	return dt.Year() == other.Year() && dt.Half() == other.Half()
+}
+
+// IsSameWeek checks if the given DateTime is in the same week.
+// Weeks start on Monday by default, which matches ISO 8601 weeks; pass a
+// different start day (e.g. time.Sunday) for other conventions.
+//
+// Example:
+//
+//	dt1 := chronogo.Date(2024, 1, 13, 0, 0, 0, 0, time.UTC) // Saturday
+//	dt2 := chronogo.Date(2024, 1, 14, 0, 0, 0, 0, time.UTC) // Sunday
+//	dt1.IsSameWeek(dt2)               // Returns true (Monday-start week)
+//	dt1.IsSameWeek(dt2, time.Sunday)  // Returns false (Sunday starts a new week)
+func (dt DateTime) IsSameWeek(other DateTime, weekStart ...time.Weekday) bool {
+	start := time.Monday
+	if len(weekStart) > 0 {
+		start = weekStart[0]
+	}
+	return dt.startOfWeekOn(start).IsSameDay(other.startOfWeekOn(start))
+}
+
+// IsSameISOWeek checks if the given DateTime is in the same ISO 8601 week-numbering
+// year and week.
 //
 // Example:
 //
-//	dt1 := chronogo.Date(2024, 1, 15, 0, 0, 0, 0, time.UTC)
-//	dt2 := chronogo.Date(2024, 1, 17, 0, 0, 0, 0, time.UTC)
-//	dt1.IsSameWeek(dt2) // Returns true if in same ISO week
-func (dt DateTime) IsSameWeek(other DateTime) bool {
+//	dt1 := chronogo.Date(2024, 12, 30, 0, 0, 0, 0, time.UTC) // 2025-W01
+//	dt2 := chronogo.Date(2025, 1, 2, 0, 0, 0, 0, time.UTC)   // 2025-W01
+//	dt1.IsSameISOWeek(dt2) // Returns true
+func (dt DateTime) IsSameISOWeek(other DateTime) bool {
 	year1, week1 := dt.ISOWeek()
 	year2, week2 := other.ISOWeek()
 	return year1 == year2 && week1 == week2
 }
+
+// IsSameHour checks if the given DateTime is in the same hour of the same day.
+func (dt DateTime) IsSameHour(other DateTime) bool {
+	return dt.IsSameDay(other) && dt.Hour() == other.Hour()
+}
+
+// IsSameMinute checks if the given DateTime is in the same minute of the same hour.
+func (dt DateTime) IsSameMinute(other DateTime) bool {
+	return dt.IsSameHour(other) && dt.Minute() == other.Minute()
+}
+
+// IsSameSecond checks if the given DateTime is in the same second of the same minute.
+func (dt DateTime) IsSameSecond(other DateTime) bool {
+	return dt.IsSameMinute(other) && dt.Second() == other.Second()
+}
+
+// IsSame checks if the given DateTime falls in the same period of the given unit.
+// UnitWeek uses Monday-start weeks. Returns false for unknown units.
+//
+// Example:
+//
+//	dt1.IsSame(chronogo.UnitMonth, dt2) // Same as dt1.IsSameMonth(dt2)
+func (dt DateTime) IsSame(unit Unit, other DateTime) bool {
+	switch unit {
+	case UnitSecond:
+		return dt.IsSameSecond(other)
+	case UnitMinute:
+		return dt.IsSameMinute(other)
+	case UnitHour:
+		return dt.IsSameHour(other)
+	case UnitDay:
+		return dt.IsSameDay(other)
+	case UnitWeek:
+		return dt.IsSameWeek(other)
+	case UnitMonth:
+		return dt.IsSameMonth(other)
+	case UnitQuarter:
+		return dt.IsSameQuarter(other)
+	case UnitHalfYear:
+		return dt.IsSameHalf(other)
+	case UnitYear:
+		return dt.IsSameYear(other)
+	case UnitDecade:
+		return dt.IsSameDecade(other)
+	case UnitCentury:
+		return dt.IsSameCentury(other)
+	default:
+		return false
+	}
+}
+
+// EqualWithin reports whether dt and other are at most tolerance apart, in either
+// direction. It suits values from systems with different precisions, such as
+// JavaScript milliseconds and Go nanoseconds.
+//
+// Example:
+//
+//	goTime := chronogo.Date(2024, 1, 15, 10, 0, 0, 123456789, time.UTC)
+//	jsTime := chronogo.FromUnixMilli(goTime.UnixMilli(), time.UTC)
+//	goTime.Equal(jsTime)                         // false
+//	goTime.EqualWithin(jsTime, time.Millisecond) // true
+func (dt DateTime) EqualWithin(other DateTime, tolerance time.Duration) bool {
+	diff := dt.Sub(other)
+	if diff < 0 {
+		diff = -diff
+	}
+	if tolerance < 0 {
+		tolerance = -tolerance
+	}
+	return diff <= tolerance
+}
+
+// EqualTruncated reports whether dt and other are equal once both are truncated to
+// unit. Unlike IsSame, other is first converted to dt's location, so the same
+// instant seen from different zones compares equal. Returns false for unknown units.
+//
+// Example:
+//
+//	a := chronogo.Date(2024, 1, 15, 10, 0, 0, 900000000, time.UTC)
+//	b := chronogo.Date(2024, 1, 15, 11, 0, 0, 100000000, chronogo.FixedZone("", 3600))
+//	a.EqualTruncated(b, chronogo.UnitSecond) // true
+func (dt DateTime) EqualTruncated(other DateTime, unit Unit) bool {
+	if !validUnit(unit) {
+		return false
+	}
+	return dt.Truncate(unit).Equal(other.In(dt.Location()).Truncate(unit))
+}
+
+// IsSameDecade checks if the given DateTime is in the same decade (e.g. 2020-2029).
+func (dt DateTime) IsSameDecade(other DateTime) bool {
+	return dt.StartOfDecade().Year() == other.StartOfDecade().Year()
+}
+
+// IsSameCentury checks if the given DateTime is in the same century (e.g. 2001-2100).
+func (dt DateTime) IsSameCentury(other DateTime) bool {
+	return dt.StartOfCentury().Year() == other.StartOfCentury().Year()
+}
+
+// IsToday reports whether dt falls on the current day. "Now" is taken from Now()
+// (so it respects SetTestNow) and evaluated in dt's location.
+//
+// Example:
+//
+//	if msg.SentAt.IsToday() {
+//	    label = msg.SentAt.Format("HH:mm")
+//	}
+func (dt DateTime) IsToday() bool {
+	return dt.IsSameDay(dt.now())
+}
+
+// IsTomorrow reports whether dt falls on the day after the current day.
+func (dt DateTime) IsTomorrow() bool {
+	return dt.IsSameDay(dt.now().StartOfDay().AddDays(1))
+}
+
+// IsYesterday reports whether dt falls on the day before the current day.
+func (dt DateTime) IsYesterday() bool {
+	return dt.IsSameDay(dt.now().StartOfDay().AddDays(-1))
+}
+
+// IsThisWeek reports whether dt falls in the current week. Weeks start on Monday
+// unless another start day is given, as with IsSameWeek.
+func (dt DateTime) IsThisWeek(weekStart ...time.Weekday) bool {
+	return dt.IsSameWeek(dt.now(), weekStart...)
+}
+
+// IsNextWeek reports whether dt falls in the week after the current one.
+func (dt DateTime) IsNextWeek(weekStart ...time.Weekday) bool {
+	return dt.IsSameWeek(dt.now().StartOfDay().AddDays(7), weekStart...)
+}
+
+// IsLastWeek reports whether dt falls in the week before the current one.
+func (dt DateTime) IsLastWeek(weekStart ...time.Weekday) bool {
+	return dt.IsSameWeek(dt.now().StartOfDay().AddDays(-7), weekStart...)
+}
+
+// IsThisMonth reports whether dt falls in the current month of the current year.
+func (dt DateTime) IsThisMonth() bool {
+	return dt.IsSameMonth(dt.now())
+}
+
+// IsNextMonth reports whether dt falls in the month after the current one.
+func (dt DateTime) IsNextMonth() bool {
+	return dt.IsSameMonth(dt.now().StartOfMonth().AddMonths(1))
+}
+
+// IsLastMonth reports whether dt falls in the month before the current one.
+func (dt DateTime) IsLastMonth() bool {
+	return dt.IsSameMonth(dt.now().StartOfMonth().AddMonths(-1))
+}
+
+// IsThisQuarter reports whether dt falls in the current quarter of the current year.
+func (dt DateTime) IsThisQuarter() bool {
+	return dt.IsSameQuarter(dt.now())
+}
+
+// IsThisYear reports whether dt falls in the current year.
+func (dt DateTime) IsThisYear() bool {
+	return dt.IsSameYear(dt.now())
+}
+
+// IsNextYear reports whether dt falls in the year after the current one.
+func (dt DateTime) IsNextYear() bool {
+	return dt.Year() == dt.now().Year()+1
+}
+
+// IsLastYear reports whether dt falls in the year before the current one.
+func (dt DateTime) IsLastYear() bool {
+	return dt.Year() == dt.now().Year()-1
+}
+
+// now returns the current (testable) time in dt's location.
+func (dt DateTime) now() DateTime {
+	return NowIn(dt.Location())
+}
+
+// startOfWeekOn returns the start of the week containing dt for weeks beginning on start.
+func (dt DateTime) startOfWeekOn(start time.Weekday) DateTime {
+	daysFromStart := (int(dt.Weekday()) - int(start) + 7) % 7
+	return dt.AddDays(-daysFromStart).StartOfDay()
+}
+
+// Min returns the earliest of the given DateTimes.
+// Returns zero DateTime if no values are given.
+//
+// Example:
+//
+//	earliest := chronogo.Min(dt1, dt2, dt3)
+func Min(dates ...DateTime) DateTime {
+	if len(dates) == 0 {
+		return DateTime{}
+	}
+
+	earliest := dates[0]
+	for _, dt := range dates[1:] {
+		if dt.Before(earliest) {
+			earliest = dt
+		}
+	}
+	return earliest
+}
+
+// Max returns the latest of the given DateTimes.
+// Returns zero DateTime if no values are given.
+//
+// Example:
+//
+//	latest := chronogo.Max(dt1, dt2, dt3)
+func Max(dates ...DateTime) DateTime {
+	if len(dates) == 0 {
+		return DateTime{}
+	}
+
+	latest := dates[0]
+	for _, dt := range dates[1:] {
+		if dt.After(latest) {
+			latest = dt
+		}
+	}
+	return latest
+}
+
+// Sort sorts the slice in place in ascending chronological order.
+// The sort is stable, so equal instants keep their relative order.
+func Sort(dates []DateTime) {
+	slices.SortStableFunc(dates, func(a, b DateTime) int {
+		return a.Time.Compare(b.Time)
+	})
+}
+
+// SortDesc sorts the slice in place in descending chronological order.
+// The sort is stable, so equal instants keep their relative order.
+func SortDesc(dates []DateTime) {
+	slices.SortStableFunc(dates, func(a, b DateTime) int {
+		return b.Time.Compare(a.Time)
+	})
+}
+
+// Unique returns a new slice with duplicate instants removed, keeping the first
+// occurrence of each. Values representing the same instant in different
+// timezones are considered duplicates.
+//
+// Example:
+//
+//	deduped := chronogo.Unique(events)
+func Unique(dates []DateTime) []DateTime {
+	seen := make(map[time.Time]struct{}, len(dates))
+	result := make([]DateTime, 0, len(dates))
+	for _, dt := range dates {
+		// Normalize to UTC without monotonic reading so equal instants share a key
+		key := dt.Time.UTC().Round(0)
+		if _, ok := seen[key]; ok {
+			continue
+		}
+		seen[key] = struct{}{}
+		result = append(result, dt)
+	}
+	return result
+}
+
+// Median returns the median instant of the given DateTimes.
+// For an even number of values it returns the midpoint of the two middle values.
+// Returns zero DateTime if no values are given. The input slice is not modified.
+func Median(dates ...DateTime) DateTime {
+	if len(dates) == 0 {
+		return DateTime{}
+	}
+
+	sorted := slices.Clone(dates)
+	Sort(sorted)
+
+	mid := len(sorted) / 2
+	if len(sorted)%2 == 1 {
+		return sorted[mid]
+	}
+	return sorted[mid-1].Average(sorted[mid])
+}
+
+// Mean returns the arithmetic mean instant of the given DateTimes, in the location
+// of the first value, rounded down to the nanosecond. It does not overflow for
+// values far apart. Returns zero DateTime if no values are given.
+//
+// Example:
+//
+//	avgArrival := chronogo.Mean(arrivals...)
+func Mean(dates ...DateTime) DateTime {
+	if len(dates) == 0 {
+		return DateTime{}
+	}
+
+	// Sum whole-second offsets from the first value and nanoseconds separately, then
+	// divide with flooring so the result is the mean rounded down to the nanosecond
+	base := dates[0].Unix()
+	n := int64(len(dates))
+	var secs, nanos int64
+	for _, dt := range dates {
+		secs += dt.Unix() - base
+		nanos += int64(dt.Nanosecond())
+	}
+
+	q, r := secs/n, secs%n
+	if r < 0 {
+		q, r = q-1, r+n
+	}
+	ns := (r*int64(time.Second) + nanos) / n
+	return DateTime{time.Unix(base+q, ns).In(dates[0].Location())}
+}
+
+// Percentile returns the p-th percentile (0 to 100) of the given DateTimes,
+// interpolating linearly between the two nearest values, so Percentile(50, ...)
+// equals Median. p is clamped to [0, 100]. Returns zero DateTime if no values are
+// given. The input is not modified.
+//
+// Example:
+//
+//	p95 := chronogo.Percentile(95, completions...)
+func Percentile(p float64, dates ...DateTime) DateTime {
+	if len(dates) == 0 {
+		return DateTime{}
+	}
+	p = math.Max(0, math.Min(100, p))
+
+	sorted := slices.Clone(dates)
+	Sort(sorted)
+
+	rank := p / 100 * float64(len(sorted)-1)
+	lo := int(math.Floor(rank))
+	frac := rank - float64(lo)
+	if frac == 0 {
+		return sorted[lo]
+	}
+	gap := sorted[lo+1].Time.Sub(sorted[lo].Time)
+	return sorted[lo].Add(time.Duration(frac * float64(gap)))
+}
+
+// GapStdDev returns the population standard deviation of the gaps between
+// consecutive DateTimes in chronological order, a measure of how regular an event
+// stream is: zero for perfectly periodic events. Returns 0 for fewer than three
+// values. The input is not modified.
+//
+// Example:
+//
+//	jitter := chronogo.GapStdDev(heartbeats...)
+func GapStdDev(dates ...DateTime) time.Duration {
+	if len(dates) < 3 {
+		return 0
+	}
+
+	sorted := slices.Clone(dates)
+	Sort(sorted)
+
+	gaps := make([]float64, len(sorted)-1)
+	var sum float64
+	for i := range gaps {
+		gaps[i] = float64(sorted[i+1].Time.Sub(sorted[i].Time))
+		sum += gaps[i]
+	}
+	mean := sum / float64(len(gaps))
+	var variance float64
+	for _, g := range gaps {
+		variance += (g - mean) * (g - mean)
+	}
+	return time.Duration(math.Sqrt(variance / float64(len(gaps))))
+}
@@ -128,6 +128,110 @@ func TestIsSameWeek(t *testing.T) {
 	if dt1.IsSameWeek(dt3) {
 		t.Error("Expected IsSameWeek to return false for different week")
 	}
+
+	sat := Date(2024, 1, 13, 0, 0, 0, 0, time.UTC)
+	sun := Date(2024, 1, 14, 0, 0, 0, 0, time.UTC)
+	if !sat.IsSameWeek(sun) {
+		t.Error("Expected Saturday and Sunday in the same Monday-start week")
+	}
+	if sat.IsSameWeek(sun, time.Sunday) {
+		t.Error("Expected Sunday to start a new Sunday-start week")
+	}
+	if !sun.IsSameWeek(Date(2024, 1, 20, 23, 0, 0, 0, time.UTC), time.Sunday) {
+		t.Error("Expected Sunday through Saturday in the same Sunday-start week")
+	}
+}
+
+func TestIsSameISOWeek(t *testing.T) {
+	dt1 := Date(2024, 12, 30, 0, 0, 0, 0, time.UTC)
+	dt2 := Date(2025, 1, 2, 0, 0, 0, 0, time.UTC)
+	if !dt1.IsSameISOWeek(dt2) {
+		t.Error("Expected dates spanning New Year to share ISO week 2025-W01")
+	}
+	if dt1.IsSameISOWeek(Date(2024, 12, 29, 0, 0, 0, 0, time.UTC)) {
+		t.Error("Expected Sunday 2024-12-29 to be in a different ISO week")
+	}
+}
+
+func TestIsSameUnit(t *testing.T) {
+	base := Date(2024, 3, 15, 10, 30, 45, 0, time.UTC)
+
+	if !base.IsSameHour(base.AddMinutes(29)) || base.IsSameHour(base.AddMinutes(30)) {
+		t.Error("IsSameHour boundaries incorrect")
+	}
+	if !base.IsSameMinute(base.AddSeconds(14)) || base.IsSameMinute(base.AddSeconds(15)) {
+		t.Error("IsSameMinute boundaries incorrect")
+	}
+	if base.IsSameHour(base.AddDays(1)) {
+		t.Error("Expected IsSameHour to be false for the same hour on another day")
+	}
+
+	tests := []struct {
+		unit  Unit
+		other DateTime
+		want  bool
+	}{
+		{UnitSecond, base.Add(time.Second), false},
+		{UnitSecond, Date(2024, 3, 15, 10, 30, 45, 999, time.UTC), true},
+		{UnitMinute, Date(2024, 3, 15, 10, 30, 0, 0, time.UTC), true},
+		{UnitHour, Date(2024, 3, 15, 11, 0, 0, 0, time.UTC), false},
+		{UnitDay, Date(2024, 3, 15, 23, 59, 0, 0, time.UTC), true},
+		{UnitWeek, Date(2024, 3, 17, 0, 0, 0, 0, time.UTC), true},
+		{UnitWeek, Date(2024, 3, 18, 0, 0, 0, 0, time.UTC), false},
+		{UnitMonth, Date(2024, 3, 1, 0, 0, 0, 0, time.UTC), true},
+		{UnitQuarter, Date(2024, 1, 1, 0, 0, 0, 0, time.UTC), true},
+		{UnitQuarter, Date(2024, 4, 1, 0, 0, 0, 0, time.UTC), false},
+		{UnitYear, Date(2024, 12, 31, 0, 0, 0, 0, time.UTC), true},
+	}
+	for _, tt := range tests {
+		if got := base.IsSame(tt.unit, tt.other); got != tt.want {
+			t.Errorf("IsSame(%v, %v) = %v, want %v", tt.unit, tt.other, got, tt.want)
+		}
+	}
+}
+
+func TestEqualWithin(t *testing.T) {
+	goTime := Date(2024, time.January, 15, 10, 0, 0, 123456789, time.UTC)
+	jsTime := FromUnixMilli(goTime.UnixMilli(), time.UTC)
+
+	if goTime.Equal(jsTime) {
+		t.Fatal("Expected differing precisions to be unequal")
+	}
+	if !goTime.EqualWithin(jsTime, time.Millisecond) || !jsTime.EqualWithin(goTime, time.Millisecond) {
+		t.Error("Expected values within a millisecond to match in both directions")
+	}
+	if goTime.EqualWithin(jsTime, time.Microsecond) {
+		t.Error("Expected a 456.789µs difference to exceed a microsecond tolerance")
+	}
+	if !goTime.EqualWithin(jsTime, -time.Millisecond) {
+		t.Error("Expected a negative tolerance to be treated as its absolute value")
+	}
+	if !goTime.EqualWithin(goTime.In(FixedZone("", 3600)), 0) {
+		t.Error("Expected the same instant in another zone to match with zero tolerance")
+	}
+}
+
+func TestEqualTruncated(t *testing.T) {
+	a := Date(2024, time.January, 15, 10, 0, 0, 900000000, time.UTC)
+	b := Date(2024, time.January, 15, 11, 0, 0, 100000000, FixedZone("", 3600))
+
+	if !a.EqualTruncated(b, UnitSecond) {
+		t.Error("Expected the same second seen from two zones to match")
+	}
+	if a.EqualTruncated(b.AddSeconds(1), UnitSecond) {
+		t.Error("Expected different seconds not to match")
+	}
+	if !a.EqualTruncated(b.AddMinutes(30), UnitHour) {
+		t.Error("Expected values in the same hour to match")
+	}
+	// 23:30 UTC and 00:30 the next day in +01:00 are the same instant
+	late := Date(2024, time.January, 15, 23, 30, 0, 0, time.UTC)
+	if !late.EqualTruncated(late.In(FixedZone("", 3600)), UnitDay) {
+		t.Error("Expected the same instant to match regardless of zone")
+	}
+	if a.EqualTruncated(a, Unit(99)) {
+		t.Error("Expected unknown units to return false")
+	}
 }
 
 func TestAverage(t *testing.T) {
@@ -255,3 +359,181 @@ func TestToAtomString(t *testing.T) {
 		t.Errorf("Expected Atom and W3C strings to match: %s vs %s", atom, w3c)
 	}
 }
+
+func TestMinMax(t *testing.T) {
+	a := Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	b := Date(2024, 6, 15, 0, 0, 0, 0, time.UTC)
+	c := Date(2023, 12, 31, 0, 0, 0, 0, time.UTC)
+
+	if got := Min(a, b, c); !got.Equal(c) {
+		t.Errorf("Min() = %v, want %v", got, c)
+	}
+	if got := Max(a, b, c); !got.Equal(b) {
+		t.Errorf("Max() = %v, want %v", got, b)
+	}
+	if !Min().IsZero() || !Max().IsZero() {
+		t.Error("Expected Min() and Max() of no values to be zero")
+	}
+}
+
+func TestSortAndUnique(t *testing.T) {
+	a := Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	b := Date(2024, 6, 15, 0, 0, 0, 0, time.UTC)
+	c := Date(2023, 12, 31, 0, 0, 0, 0, time.UTC)
+	tokyo := time.FixedZone("JST", 9*3600)
+
+	dates := []DateTime{a, b, c}
+	Sort(dates)
+	if !dates[0].Equal(c) || !dates[1].Equal(a) || !dates[2].Equal(b) {
+		t.Errorf("Sort() = %v", dates)
+	}
+	SortDesc(dates)
+	if !dates[0].Equal(b) || !dates[1].Equal(a) || !dates[2].Equal(c) {
+		t.Errorf("SortDesc() = %v", dates)
+	}
+
+	unique := Unique([]DateTime{a, b, a.In(tokyo), c, b})
+	if len(unique) != 3 {
+		t.Fatalf("Unique() returned %d values, want 3", len(unique))
+	}
+	if !unique[0].Equal(a) || !unique[1].Equal(b) || !unique[2].Equal(c) {
+		t.Errorf("Unique() = %v, want first occurrences in order", unique)
+	}
+}
+
+func TestMedian(t *testing.T) {
+	a := Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	b := Date(2024, 1, 3, 0, 0, 0, 0, time.UTC)
+	c := Date(2024, 1, 10, 0, 0, 0, 0, time.UTC)
+
+	input := []DateTime{c, a, b}
+	if got := Median(input...); !got.Equal(b) {
+		t.Errorf("Median() odd = %v, want %v", got, b)
+	}
+	if !input[0].Equal(c) {
+		t.Error("Median() should not modify its input")
+	}
+	if got := Median(a, b); !got.Equal(Date(2024, 1, 2, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("Median() even = %v", got)
+	}
+	if !Median().IsZero() {
+		t.Error("Expected Median() of no values to be zero")
+	}
+}
+
+func TestMean(t *testing.T) {
+	a := Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	b := Date(2024, 1, 3, 0, 0, 0, 0, time.UTC)
+	c := Date(2024, 1, 11, 0, 0, 0, 1, time.UTC)
+
+	if got := Mean(c, a, b); !got.Equal(Date(2024, 1, 5, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("Mean() = %v, want 2024-01-05", got)
+	}
+	tokyo := FixedZone("JST", 9*3600)
+	if got := Mean(a.In(tokyo), b); !got.Equal(Date(2024, 1, 2, 0, 0, 0, 0, time.UTC)) || got.Location() != tokyo {
+		t.Errorf("Mean() = %v, want 2024-01-02 in the first value's location", got)
+	}
+
+	// Values centuries apart would overflow a sum of durations
+	early := Date(1000, 1, 1, 0, 0, 0, 0, time.UTC)
+	late := Date(3000, 1, 1, 0, 0, 0, 0, time.UTC)
+	if got := Mean(early, late, late); got.Year() != 2333 {
+		t.Errorf("Mean() of distant values = %v", got)
+	}
+	if !Mean().IsZero() {
+		t.Error("Expected Mean() of no values to be zero")
+	}
+}
+
+func TestPercentile(t *testing.T) {
+	base := Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	var dates []DateTime
+	for i := 10; i >= 0; i-- {
+		dates = append(dates, base.AddHours(i))
+	}
+
+	tests := []struct {
+		p    float64
+		want DateTime
+	}{
+		{0, base},
+		{50, base.AddHours(5)},
+		{95, base.AddMinutes(570)},
+		{100, base.AddHours(10)},
+		{-5, base},
+		{150, base.AddHours(10)},
+	}
+	for _, tt := range tests {
+		if got := Percentile(tt.p, dates...); !got.Equal(tt.want) {
+			t.Errorf("Percentile(%v) = %v, want %v", tt.p, got, tt.want)
+		}
+	}
+	if got, want := Percentile(50, dates[:4]...), Median(dates[:4]...); !got.Equal(want) {
+		t.Errorf("Percentile(50) = %v, want Median %v", got, want)
+	}
+	if !dates[0].Equal(base.AddHours(10)) {
+		t.Error("Percentile() should not modify its input")
+	}
+	if !Percentile(50).IsZero() {
+		t.Error("Expected Percentile() of no values to be zero")
+	}
+}
+
+func TestGapStdDev(t *testing.T) {
+	base := Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	periodic := []DateTime{base.AddMinutes(2), base, base.AddMinutes(1), base.AddMinutes(3)}
+	if got := GapStdDev(periodic...); got != 0 {
+		t.Errorf("GapStdDev() of periodic events = %v, want 0", got)
+	}
+
+	// Gaps of 1m and 3m: mean 2m, deviation 1m
+	if got := GapStdDev(base, base.AddMinutes(1), base.AddMinutes(4)); got != time.Minute {
+		t.Errorf("GapStdDev() = %v, want 1m", got)
+	}
+	if got := GapStdDev(base, base.AddHours(1)); got != 0 {
+		t.Errorf("GapStdDev() of two values = %v, want 0", got)
+	}
+}
+
+func TestRelativeToNowPredicates(t *testing.T) {
+	// Wednesday 2024-01-31 15:00 UTC
+	WithTestNow(UTC(2024, time.January, 31, 15, 0, 0, 0), func() {
+		tests := []struct {
+			name string
+			got  bool
+			want bool
+		}{
+			{"IsToday", UTC(2024, time.January, 31, 0, 0, 0, 0).IsToday(), true},
+			{"IsToday (yesterday)", UTC(2024, time.January, 30, 23, 59, 0, 0).IsToday(), false},
+			{"IsTomorrow", UTC(2024, time.February, 1, 8, 0, 0, 0).IsTomorrow(), true},
+			{"IsYesterday", UTC(2024, time.January, 30, 8, 0, 0, 0).IsYesterday(), true},
+			{"IsThisWeek", UTC(2024, time.January, 29, 0, 0, 0, 0).IsThisWeek(), true},
+			{"IsThisWeek (Sunday)", UTC(2024, time.February, 4, 0, 0, 0, 0).IsThisWeek(), true},
+			{"IsThisWeek (Sunday start)", UTC(2024, time.February, 4, 0, 0, 0, 0).IsThisWeek(time.Sunday), false},
+			{"IsNextWeek", UTC(2024, time.February, 5, 0, 0, 0, 0).IsNextWeek(), true},
+			{"IsLastWeek", UTC(2024, time.January, 28, 0, 0, 0, 0).IsLastWeek(), true},
+			{"IsThisMonth", UTC(2024, time.January, 1, 0, 0, 0, 0).IsThisMonth(), true},
+			{"IsThisMonth (last year)", UTC(2023, time.January, 31, 0, 0, 0, 0).IsThisMonth(), false},
+			{"IsNextMonth (from Jan 31)", UTC(2024, time.February, 29, 0, 0, 0, 0).IsNextMonth(), true},
+			{"IsNextMonth (March)", UTC(2024, time.March, 1, 0, 0, 0, 0).IsNextMonth(), false},
+			{"IsLastMonth", UTC(2023, time.December, 15, 0, 0, 0, 0).IsLastMonth(), true},
+			{"IsThisQuarter", UTC(2024, time.March, 31, 0, 0, 0, 0).IsThisQuarter(), true},
+			{"IsThisYear", UTC(2024, time.December, 31, 0, 0, 0, 0).IsThisYear(), true},
+			{"IsNextYear", UTC(2025, time.June, 1, 0, 0, 0, 0).IsNextYear(), true},
+			{"IsLastYear", UTC(2023, time.June, 1, 0, 0, 0, 0).IsLastYear(), true},
+		}
+		for _, tt := range tests {
+			if tt.got != tt.want {
+				t.Errorf("%s = %v, want %v", tt.name, tt.got, tt.want)
+			}
+		}
+
+		// "Today" is evaluated in the datetime's own location: 15:00 UTC is already
+		// February 1 in Tokyo
+		if tokyo, err := time.LoadLocation("Asia/Tokyo"); err == nil {
+			if !Date(2024, time.February, 1, 9, 0, 0, 0, tokyo).IsToday() {
+				t.Error("Expected February 1 to be today in Tokyo")
+			}
+		}
+	})
+}
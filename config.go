@@ -0,0 +1,158 @@
+package chronogo
+
+import (
+	"context"
+	"time"
+)
+
+// Config bundles the defaults that package-level helpers otherwise take from global
+// settings (SetDefaultLocale, SetDefaultParseLanguages, SetDefaultHolidayCountry).
+// Each application module can hold its own Config, or pass one through a context, so
+// libraries built on chronogo do not fight over global defaults.
+//
+// Config is a plain value: copy it and change fields to derive a variant. Its methods
+// act as a factory bound to the configuration.
+//
+// Example:
+//
+//	cfg := chronogo.NewConfig()
+//	cfg.Locale = "es-ES"
+//	cfg.Location, _ = time.LoadLocation("Europe/Madrid")
+//	cfg.HolidayChecker = chronogo.NewGoHolidayChecker("ES")
+//
+//	dt, _ := cfg.Parse("mañana")
+//	cfg.FormatLocalized(dt, "dddd D [de] MMMM") // "martes 16 de enero"
+//	cfg.IsBusinessDay(dt)
+type Config struct {
+	// Locale is the locale code used for localized formatting and human strings.
+	// Empty uses the global default locale.
+	Locale string
+
+	// Location is the timezone for Now, Today, Date and Parse. Nil means UTC.
+	Location *time.Location
+
+	// HolidayChecker is used by business-day helpers. Nil uses the default holiday checker.
+	HolidayChecker HolidayChecker
+
+	// WeekStart is the first day of the week for StartOfWeek, EndOfWeek and IsSameWeek.
+	// NewConfig sets it to Monday; note the zero value is Sunday.
+	WeekStart time.Weekday
+
+	// ParseLanguages are the languages for natural language parsing.
+	// Empty uses the global default parse languages.
+	ParseLanguages []string
+}
+
+// NewConfig returns a Config with the package defaults: the en-US locale, UTC,
+// the default holiday checker, Monday-start weeks and all parse languages.
+func NewConfig() Config {
+	return Config{
+		Locale:         "en-US",
+		Location:       time.UTC,
+		WeekStart:      time.Monday,
+		ParseLanguages: append([]string(nil), DefaultParseConfig.Languages...),
+	}
+}
+
+type configContextKey struct{}
+
+// ContextWithConfig returns a copy of ctx carrying cfg.
+//
+// Example:
+//
+//	ctx = chronogo.ContextWithConfig(ctx, cfg)
+//	chronogo.ConfigFromContext(ctx).Now()
+func ContextWithConfig(ctx context.Context, cfg Config) context.Context {
+	return context.WithValue(ctx, configContextKey{}, cfg)
+}
+
+// ConfigFromContext returns the Config stored in ctx by ContextWithConfig, or
+// NewConfig() when ctx carries none.
+func ConfigFromContext(ctx context.Context) Config {
+	if cfg, ok := ctx.Value(configContextKey{}).(Config); ok {
+		return cfg
+	}
+	return NewConfig()
+}
+
+// location returns the configured location, defaulting to UTC.
+func (c Config) location() *time.Location {
+	if c.Location == nil {
+		return time.UTC
+	}
+	return c.Location
+}
+
+// locale returns the configured locale code, defaulting to the global default.
+func (c Config) locale() string {
+	if c.Locale == "" {
+		return GetDefaultLocale()
+	}
+	return c.Locale
+}
+
+// Now returns the current datetime in the configured location.
+// Testing helpers such as SetTestNow are respected.
+func (c Config) Now() DateTime {
+	return NowIn(c.location())
+}
+
+// Today returns today's date at midnight in the configured location.
+func (c Config) Today() DateTime {
+	return c.Now().StartOfDay()
+}
+
+// Date creates a DateTime in the configured location.
+func (c Config) Date(year int, month time.Month, day, hour, min, sec, nsec int) DateTime {
+	return Date(year, month, day, hour, min, sec, nsec, c.location())
+}
+
+// Parse parses value in the configured location and languages, like ParseWith.
+func (c Config) Parse(value string) (DateTime, error) {
+	return ParseWith(value, c.ParseConfig())
+}
+
+// ParseConfig returns a ParseConfig carrying the configured location and languages,
+// for callers that need to set further parse options.
+func (c Config) ParseConfig() ParseConfig {
+	return ParseConfig{
+		Languages: c.ParseLanguages,
+		Location:  c.location(),
+	}
+}
+
+// FormatLocalized formats dt with the configured locale.
+func (c Config) FormatLocalized(dt DateTime, pattern string) (string, error) {
+	return dt.FormatLocalized(pattern, c.locale())
+}
+
+// HumanString returns a human-readable difference in the configured locale,
+// like DateTime.HumanStringLocalized.
+func (c Config) HumanString(dt DateTime, other ...DateTime) (string, error) {
+	return dt.HumanStringLocalized(c.locale(), other...)
+}
+
+// IsBusinessDay reports whether dt is a business day under the configured holiday checker.
+func (c Config) IsBusinessDay(dt DateTime) bool {
+	return dt.IsBusinessDay(c.HolidayChecker)
+}
+
+// AddBusinessDays adds business days to dt using the configured holiday checker.
+func (c Config) AddBusinessDays(dt DateTime, days int) DateTime {
+	return dt.AddBusinessDays(days, c.HolidayChecker)
+}
+
+// StartOfWeek returns the start of dt's week using the configured week start.
+func (c Config) StartOfWeek(dt DateTime) DateTime {
+	return dt.startOfWeekOn(c.WeekStart)
+}
+
+// EndOfWeek returns the end of dt's week using the configured week start.
+func (c Config) EndOfWeek(dt DateTime) DateTime {
+	return c.StartOfWeek(dt).AddDays(6).EndOfDay()
+}
+
+// IsSameWeek reports whether a and b fall in the same week using the configured week start.
+func (c Config) IsSameWeek(a, b DateTime) bool {
+	return a.IsSameWeek(b, c.WeekStart)
+}
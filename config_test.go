@@ -0,0 +1,88 @@
+package chronogo
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestConfig(t *testing.T) {
+	cfg := NewConfig()
+	if cfg.Locale != "en-US" || cfg.Location != time.UTC || cfg.WeekStart != time.Monday || len(cfg.ParseLanguages) == 0 {
+		t.Errorf("NewConfig() = %+v", cfg)
+	}
+
+	tokyo, err := time.LoadLocation("Asia/Tokyo")
+	if err != nil {
+		t.Skip("Asia/Tokyo timezone not available")
+	}
+	cfg.Locale = "es-ES"
+	cfg.Location = tokyo
+	cfg.WeekStart = time.Sunday
+
+	WithTestNow(UTC(2024, time.January, 15, 20, 0, 0, 0), func() {
+		if now := cfg.Now(); now.Location() != tokyo || now.Day() != 16 || now.Hour() != 5 {
+			t.Errorf("Now() = %v, want 2024-01-16 05:00 JST", now)
+		}
+		if today := cfg.Today(); today.Day() != 16 || today.Hour() != 0 {
+			t.Errorf("Today() = %v", today)
+		}
+	})
+
+	dt := cfg.Date(2024, time.March, 1, 10, 0, 0, 0) // Friday
+	if dt.Location() != tokyo {
+		t.Errorf("Date() location = %v, want Asia/Tokyo", dt.Location())
+	}
+	if s, err := cfg.FormatLocalized(dt, "MMMM"); err != nil || s != "marzo" {
+		t.Errorf("FormatLocalized() = %q, %v; want marzo", s, err)
+	}
+	if got := cfg.StartOfWeek(dt); got.Weekday() != time.Sunday || got.Day() != 25 {
+		t.Errorf("StartOfWeek() = %v, want Sunday 2024-02-25", got)
+	}
+	if got := cfg.EndOfWeek(dt); got.Weekday() != time.Saturday || got.Day() != 2 {
+		t.Errorf("EndOfWeek() = %v, want Saturday 2024-03-02", got)
+	}
+	if !cfg.IsSameWeek(dt, dt.AddDays(1)) || cfg.IsSameWeek(dt, dt.AddDays(2)) {
+		t.Error("IsSameWeek() should use the Sunday week start")
+	}
+
+	parsed, err := cfg.Parse("2024-06-15 14:30:00")
+	if err != nil || parsed.Location() != tokyo || parsed.Hour() != 14 {
+		t.Errorf("Parse() = %v, %v; want 14:30 in Asia/Tokyo", parsed, err)
+	}
+
+	// Global defaults stay untouched
+	if GetDefaultLocale() != "en-US" {
+		t.Errorf("GetDefaultLocale() = %q, want en-US", GetDefaultLocale())
+	}
+}
+
+func TestConfigBusinessDays(t *testing.T) {
+	checker := NewUSHolidayChecker()
+	checker.AddHoliday(Holiday{Name: "Company Day", Month: time.March, Day: 4})
+
+	cfg := NewConfig()
+	cfg.HolidayChecker = checker
+
+	monday := cfg.Date(2024, time.March, 4, 9, 0, 0, 0)
+	if cfg.IsBusinessDay(monday) {
+		t.Error("Expected the configured holiday to be a non-business day")
+	}
+	friday := cfg.Date(2024, time.March, 1, 9, 0, 0, 0)
+	if got := cfg.AddBusinessDays(friday, 1); got.Day() != 5 {
+		t.Errorf("AddBusinessDays(1) = %v, want 2024-03-05", got)
+	}
+}
+
+func TestConfigContext(t *testing.T) {
+	if cfg := ConfigFromContext(context.Background()); cfg.Locale != "en-US" {
+		t.Errorf("ConfigFromContext(empty) = %+v, want defaults", cfg)
+	}
+
+	cfg := NewConfig()
+	cfg.Locale = "fr-FR"
+	ctx := ContextWithConfig(context.Background(), cfg)
+	if got := ConfigFromContext(ctx); got.Locale != "fr-FR" {
+		t.Errorf("ConfigFromContext() locale = %q, want fr-FR", got.Locale)
+	}
+}
@@ -1,13 +1,23 @@
 // Package chronogo provides a Go implementation of powerful datetime handling
 // for easy-to-use datetime and timezone operations.
+//
+// # Concurrency
+//
+// DateTime, Period and Diff values are immutable and safe to share between
+// goroutines. The following package-level settings may be changed at runtime
+// while other goroutines use the package: SetDefaultLocale, RegisterLocale,
+// SetMarshalPrecision, SetMarshalZeroAsNull, SetStrictJSON,
+// SetNaturalLanguageParsing, SetDefaultHolidayCountry and the testing helpers
+// (SetTestNow, FreezeTime, TravelTo and friends). Exported variables such as
+// DefaultParseConfig and DefaultDayPartBoundaries are plain values: set them during
+// initialization, before other goroutines start using the package.
 package chronogo
 
 import (
 	"database/sql/driver"
 	"fmt"
-	"strconv"
 	"strings"
-	"sync"
+	"sync/atomic"
 	"time"
 )
 
@@ -23,39 +33,29 @@ const (
 	UnitMonth
 	UnitQuarter
 	UnitYear
+	UnitDecade
+	UnitCentury
+	// UnitHalfYear is a half year (H1 January-June, H2 July-December). It is declared
+	// last so the values of the other units stay unchanged.
+	UnitHalfYear
 )
 
+// validUnit reports whether unit is one of the declared Unit constants.
+func validUnit(unit Unit) bool {
+	return unit >= UnitSecond && unit <= UnitHalfYear
+}
+
 // DateTime wraps Go's time.Time to extend functionality while maintaining compatibility.
 // It provides timezone-aware datetime operations with a fluent API.
 type DateTime struct {
 	time.Time
 }
 
-// cache for standard (non-DST) timezone offsets per (location, year)
-var standardOffsetCache sync.Map // key string -> int (seconds east of UTC)
-
-func standardOffsetKey(loc *time.Location, year int) string {
-	// loc.String() returns IANA name (e.g., "America/New_York") or "Local"
-	return loc.String() + "|" + strconv.Itoa(year)
-}
-
-func getStandardOffset(loc *time.Location, year int) int {
-	key := standardOffsetKey(loc, year)
-	if v, ok := standardOffsetCache.Load(key); ok {
-		return v.(int)
-	}
-	// Compute minimum offset observed across the year as standard offset
-	minOffset := int(1<<31 - 1)
-	for month := time.January; month <= time.December; month++ {
-		t := time.Date(year, month, 1, 0, 0, 0, 0, loc)
-		_, off := t.Zone()
-		if off < minOffset {
-			minOffset = off
-		}
-	}
-	standardOffsetCache.Store(key, minOffset)
-	return minOffset
-}
+// ClearDSTCache is kept for compatibility and does nothing.
+//
+// Deprecated: IsDST reads the daylight saving flag from the zone data and no
+// longer keeps a cache.
+func ClearDSTCache() {}
 
 // Now returns the current datetime in the local timezone.
 // When testing helpers are active (SetTestNow, FreezeTime, TravelTo),
@@ -82,8 +82,9 @@ func Today() DateTime {
 }
 
 // TodayIn returns today's date at midnight in the specified timezone.
+// Like Now, it respects the testing helpers.
 func TodayIn(loc *time.Location) DateTime {
-	now := time.Now().In(loc)
+	now := getTestableNow().In(loc)
 	return DateTime{time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, loc)}
 }
 
@@ -97,6 +98,24 @@ func Yesterday() DateTime {
 	return Now().AddDays(-1).StartOfDay()
 }
 
+// Since returns the duration elapsed since dt, measured against Now().
+// It respects the testing helpers (SetTestNow, FreezeTime, TravelTo).
+//
+// Example:
+//
+//	elapsed := chronogo.Since(start)
+//	fmt.Println(elapsed.HumanString()) // "3 minutes"
+func Since(dt DateTime) ChronoDuration {
+	return ChronoDuration{getTestableNow().Sub(dt.Time)}
+}
+
+// Until returns the duration remaining until dt, measured against Now().
+// The result is negative if dt is in the past.
+// It respects the testing helpers (SetTestNow, FreezeTime, TravelTo).
+func Until(dt DateTime) ChronoDuration {
+	return ChronoDuration{dt.Time.Sub(getTestableNow())}
+}
+
 // Date creates a DateTime similar to time.Date() but returns our DateTime type.
 func Date(year int, month time.Month, day, hour, min, sec, nsec int, loc *time.Location) DateTime {
 	return DateTime{time.Date(year, month, day, hour, min, sec, nsec, loc)}
@@ -143,6 +162,24 @@ func (dt DateTime) In(loc *time.Location) DateTime {
 	return DateTime{dt.Time.In(loc)}
 }
 
+// WithZoneSameLocal returns the same wall-clock time in another location, so 14:30 in
+// New York becomes 14:30 in Tokyo: a different instant, unlike In, which keeps the
+// instant and changes the wall clock. A wall-clock time that falls in a DST gap in loc
+// moves forward to the transition (GapShiftForward); use DateWithGapPolicy for other
+// policies. A nil loc means UTC.
+//
+// Example:
+//
+//	meeting := chronogo.Date(2024, time.June, 15, 14, 30, 0, 0, ny)
+//	meeting.WithZoneSameLocal(tokyo) // 2024-06-15 14:30 JST
+//	meeting.In(tokyo)                // 2024-06-16 03:30 JST
+func (dt DateTime) WithZoneSameLocal(loc *time.Location) DateTime {
+	if loc == nil {
+		loc = time.UTC
+	}
+	return DateWithGapPolicy(dt.Year(), dt.Month(), dt.Day(), dt.Hour(), dt.Minute(), dt.Second(), dt.Nanosecond(), loc, GapShiftForward)
+}
+
 // UTC converts the datetime to UTC timezone.
 func (dt DateTime) UTC() DateTime {
 	return DateTime{dt.Time.UTC()}
@@ -158,14 +195,20 @@ func (dt DateTime) Location() *time.Location {
 	return dt.Time.Location()
 }
 
-// IsDST returns whether the datetime is in daylight saving time.
+// IsDST returns whether the datetime is in daylight saving time, as recorded in
+// the time zone database (see time.Time.IsDST). This is correct for
+// southern-hemisphere zones such as Australia/Sydney, where DST spans the new year,
+// and for zones that changed their standard offset or stopped observing DST. Zones
+// with negative DST follow the database too: Europe/Dublin reports winter (GMT) as
+// DST and summer (IST) as standard time.
+//
+// Example:
+//
+//	syd, _ := time.LoadLocation("Australia/Sydney")
+//	chronogo.Date(2024, time.January, 15, 12, 0, 0, 0, syd).IsDST() // true
+//	chronogo.Date(2024, time.July, 15, 12, 0, 0, 0, syd).IsDST()    // false
 func (dt DateTime) IsDST() bool {
-	// Determine standard (non-DST) offset via cached minimum offset across the year.
-	loc := dt.Location()
-	year := dt.Year()
-	minOffset := getStandardOffset(loc, year)
-	_, currentOffset := dt.Zone()
-	return currentOffset != minOffset
+	return dt.Time.IsDST()
 }
 
 // IsUTC returns whether the datetime is in UTC timezone.
@@ -180,8 +223,7 @@ func (dt DateTime) IsLocal() bool {
 
 // IsLeapYear returns whether the datetime's year is a leap year.
 func (dt DateTime) IsLeapYear() bool {
-	year := dt.Year()
-	return year%4 == 0 && (year%100 != 0 || year%400 == 0)
+	return IsLeap(dt.Year())
 }
 
 // IsLongYear returns whether the datetime's year is an ISO 8601 long year.
@@ -208,14 +250,34 @@ func (dt DateTime) IsLongYear() bool {
 	return week == 53
 }
 
-// IsPast returns whether the datetime is in the past compared to now.
+// IsPast returns whether the datetime is before Now(), which respects the testing
+// helpers (SetTestNow, FreezeTime).
 func (dt DateTime) IsPast() bool {
-	return dt.Time.Before(time.Now())
+	return dt.Time.Before(getTestableNow())
 }
 
-// IsFuture returns whether the datetime is in the future compared to now.
+// IsPastAt returns whether the datetime is before the reference.
+func (dt DateTime) IsPastAt(reference DateTime) bool {
+	return dt.Time.Before(reference.Time)
+}
+
+// IsFuture returns whether the datetime is after Now(), which respects the testing
+// helpers (SetTestNow, FreezeTime).
 func (dt DateTime) IsFuture() bool {
-	return dt.Time.After(time.Now())
+	return dt.Time.After(getTestableNow())
+}
+
+// IsFutureAt returns whether the datetime is after the reference.
+func (dt DateTime) IsFutureAt(reference DateTime) bool {
+	return dt.Time.After(reference.Time)
+}
+
+// referenceTime returns the first optional reference, or the testable current time.
+func referenceTime(reference []DateTime) time.Time {
+	if len(reference) > 0 {
+		return reference[0].Time
+	}
+	return getTestableNow()
 }
 
 // AddYears adds the specified number of years.
@@ -228,6 +290,16 @@ func (dt DateTime) AddMonths(months int) DateTime {
 	return DateTime{dt.Time.AddDate(0, months, 0)}
 }
 
+// AddQuarters adds the specified number of quarters (3 months each).
+func (dt DateTime) AddQuarters(quarters int) DateTime {
+	return dt.AddMonths(quarters * 3)
+}
+
+// AddWeeks adds the specified number of weeks (7 calendar days each).
+func (dt DateTime) AddWeeks(weeks int) DateTime {
+	return dt.AddDays(weeks * 7)
+}
+
 // AddDays adds the specified number of days.
 func (dt DateTime) AddDays(days int) DateTime {
 	return DateTime{dt.Time.AddDate(0, 0, days)}
@@ -248,6 +320,21 @@ func (dt DateTime) AddSeconds(seconds int) DateTime {
 	return DateTime{dt.Time.Add(time.Duration(seconds) * time.Second)}
 }
 
+// AddMilliseconds adds the specified number of milliseconds.
+func (dt DateTime) AddMilliseconds(milliseconds int) DateTime {
+	return DateTime{dt.Time.Add(time.Duration(milliseconds) * time.Millisecond)}
+}
+
+// AddMicroseconds adds the specified number of microseconds.
+func (dt DateTime) AddMicroseconds(microseconds int) DateTime {
+	return DateTime{dt.Time.Add(time.Duration(microseconds) * time.Microsecond)}
+}
+
+// AddNanoseconds adds the specified number of nanoseconds.
+func (dt DateTime) AddNanoseconds(nanoseconds int) DateTime {
+	return DateTime{dt.Time.Add(time.Duration(nanoseconds))}
+}
+
 // Add adds a time.Duration to the datetime.
 func (dt DateTime) Add(duration time.Duration) DateTime {
 	return DateTime{dt.Time.Add(duration)}
@@ -263,6 +350,16 @@ func (dt DateTime) SubtractMonths(months int) DateTime {
 	return dt.AddMonths(-months)
 }
 
+// SubtractQuarters subtracts the specified number of quarters.
+func (dt DateTime) SubtractQuarters(quarters int) DateTime {
+	return dt.AddQuarters(-quarters)
+}
+
+// SubtractWeeks subtracts the specified number of weeks.
+func (dt DateTime) SubtractWeeks(weeks int) DateTime {
+	return dt.AddWeeks(-weeks)
+}
+
 // SubtractDays subtracts the specified number of days.
 func (dt DateTime) SubtractDays(days int) DateTime {
 	return dt.AddDays(-days)
@@ -283,6 +380,21 @@ func (dt DateTime) SubtractSeconds(seconds int) DateTime {
 	return dt.AddSeconds(-seconds)
 }
 
+// SubtractMilliseconds subtracts the specified number of milliseconds.
+func (dt DateTime) SubtractMilliseconds(milliseconds int) DateTime {
+	return dt.AddMilliseconds(-milliseconds)
+}
+
+// SubtractMicroseconds subtracts the specified number of microseconds.
+func (dt DateTime) SubtractMicroseconds(microseconds int) DateTime {
+	return dt.AddMicroseconds(-microseconds)
+}
+
+// SubtractNanoseconds subtracts the specified number of nanoseconds.
+func (dt DateTime) SubtractNanoseconds(nanoseconds int) DateTime {
+	return dt.AddNanoseconds(-nanoseconds)
+}
+
 // Subtract subtracts a time.Duration from the datetime.
 func (dt DateTime) Subtract(duration time.Duration) DateTime {
 	return DateTime{dt.Time.Add(-duration)}
@@ -341,6 +453,34 @@ func (dt DateTime) SetSecond(second int) DateTime {
 	return DateTime{time.Date(dt.Year(), dt.Month(), dt.Day(), dt.Hour(), dt.Minute(), second, dt.Nanosecond(), dt.Location())}
 }
 
+// SetNanosecond returns a new DateTime with the nanosecond set to the specified value.
+// Values outside 0-999999999 overflow into the seconds, as with time.Date.
+func (dt DateTime) SetNanosecond(nanosecond int) DateTime {
+	return DateTime{time.Date(dt.Year(), dt.Month(), dt.Day(), dt.Hour(), dt.Minute(), dt.Second(), nanosecond, dt.Location())}
+}
+
+// TruncateToMillisecond returns dt with the sub-millisecond part cleared, matching
+// systems that store millisecond precision (JavaScript, many JSON APIs).
+//
+// Example:
+//
+//	dt := chronogo.Date(2024, 1, 15, 10, 30, 0, 123456789, time.UTC)
+//	dt.TruncateToMillisecond().Nanosecond() // 123000000
+func (dt DateTime) TruncateToMillisecond() DateTime {
+	return dt.SetNanosecond(dt.Nanosecond() / 1e6 * 1e6)
+}
+
+// TruncateToMicrosecond returns dt with the sub-microsecond part cleared, matching
+// databases that store microsecond precision (e.g. PostgreSQL timestamptz).
+//
+// Example:
+//
+//	dt := chronogo.Date(2024, 1, 15, 10, 30, 0, 123456789, time.UTC)
+//	dt.TruncateToMicrosecond().Nanosecond() // 123456000
+func (dt DateTime) TruncateToMicrosecond() DateTime {
+	return dt.SetNanosecond(dt.Nanosecond() / 1e3 * 1e3)
+}
+
 // On is a convenience method that sets the date components (year, month, day) in one call.
 // This is a simpler alternative to using Set().Year().Month().Day() for date modifications.
 // The time components (hour, minute, second, nanosecond) remain unchanged.
@@ -427,6 +567,79 @@ func (dt DateTime) ToISO8601String() string {
 	return dt.Time.Format("2006-01-02T15:04:05Z07:00")
 }
 
+// ISOPrecision selects how many fractional-second digits ISO 8601 output includes.
+type ISOPrecision int
+
+const (
+	// ISOPrecisionSeconds omits fractional seconds (2024-06-15T14:30:00Z).
+	ISOPrecisionSeconds ISOPrecision = iota
+	// ISOPrecisionMillis writes exactly 3 fractional digits.
+	ISOPrecisionMillis
+	// ISOPrecisionMicros writes exactly 6 fractional digits.
+	ISOPrecisionMicros
+	// ISOPrecisionNanos writes exactly 9 fractional digits.
+	ISOPrecisionNanos
+	// ISOPrecisionAuto writes as many digits as needed, omitting trailing zeros.
+	ISOPrecisionAuto
+)
+
+// ISOFormatOptions controls the ISO 8601 variant written by ToISO8601StringWith.
+type ISOFormatOptions struct {
+	// Precision selects the fractional-second digits. Extra digits are truncated, not rounded.
+	Precision ISOPrecision
+	// Basic selects the basic format without separators (20240615T143000Z).
+	Basic bool
+}
+
+// ToISO8601StringWith returns the datetime in the ISO 8601 variant described by opts.
+//
+// Example:
+//
+//	dt.ToISO8601StringWith(chronogo.ISOFormatOptions{Precision: chronogo.ISOPrecisionMillis})
+//	// "2024-06-15T14:30:00.123+02:00"
+//	dt.ToISO8601StringWith(chronogo.ISOFormatOptions{Basic: true})
+//	// "20240615T143000+0200"
+func (dt DateTime) ToISO8601StringWith(opts ISOFormatOptions) string {
+	var fraction string
+	switch opts.Precision {
+	case ISOPrecisionMillis:
+		fraction = ".000"
+	case ISOPrecisionMicros:
+		fraction = ".000000"
+	case ISOPrecisionNanos:
+		fraction = ".000000000"
+	case ISOPrecisionAuto:
+		fraction = ".999999999"
+	}
+	if opts.Basic {
+		return dt.Time.Format("20060102T150405" + fraction + "Z0700")
+	}
+	return dt.Time.Format("2006-01-02T15:04:05" + fraction + "Z07:00")
+}
+
+// ToISO8601BasicString returns the datetime in ISO 8601 basic format (e.g. "20240615T143000Z").
+func (dt DateTime) ToISO8601BasicString() string {
+	return dt.ToISO8601StringWith(ISOFormatOptions{Basic: true})
+}
+
+// ToISO8601DateString returns the calendar date in ISO 8601 extended format (e.g. "2024-06-15").
+func (dt DateTime) ToISO8601DateString() string {
+	return dt.Time.Format("2006-01-02")
+}
+
+// ToISOOrdinalString returns the ISO 8601 ordinal date (e.g. "2024-167").
+func (dt DateTime) ToISOOrdinalString() string {
+	return fmt.Sprintf("%04d-%03d", dt.Year(), dt.YearDay())
+}
+
+// ToISOWeekDateString returns the ISO 8601 week date (e.g. "2024-W24-6").
+// The year is the ISO week-numbering year, which can differ from the calendar year.
+func (dt DateTime) ToISOWeekDateString() string {
+	year, week := dt.ISOWeek()
+	weekday := (int(dt.Weekday())+6)%7 + 1
+	return fmt.Sprintf("%04d-W%02d-%d", year, week, weekday)
+}
+
 // String returns the default string representation (ISO 8601 format).
 func (dt DateTime) String() string {
 	return dt.ToISO8601String()
@@ -448,16 +661,16 @@ func (dt DateTime) Unwrap() time.Time {
 }
 
 // Truncate returns dt truncated to the start of the given unit.
-// For calendar units (day/week/month/quarter/year) this aligns to the logical
+// For calendar units (day/week/month/quarter/half-year/year/decade/century) this aligns to the logical
 // start boundary in the current location (e.g., StartOfDay, Monday StartOfWeek).
 func (dt DateTime) Truncate(unit Unit) DateTime {
 	switch unit {
 	case UnitSecond:
-		return DateTime{time.Date(dt.Year(), dt.Month(), dt.Day(), dt.Hour(), dt.Minute(), dt.Second(), 0, dt.Location())}
+		return dt.truncateClock(time.Duration(dt.Nanosecond()))
 	case UnitMinute:
-		return DateTime{time.Date(dt.Year(), dt.Month(), dt.Day(), dt.Hour(), dt.Minute(), 0, 0, dt.Location())}
+		return dt.truncateClock(time.Duration(dt.Second())*time.Second + time.Duration(dt.Nanosecond()))
 	case UnitHour:
-		return DateTime{time.Date(dt.Year(), dt.Month(), dt.Day(), dt.Hour(), 0, 0, 0, dt.Location())}
+		return dt.truncateClock(time.Duration(dt.Minute())*time.Minute + time.Duration(dt.Second())*time.Second + time.Duration(dt.Nanosecond()))
 	case UnitDay:
 		return dt.StartOfDay()
 	case UnitWeek:
@@ -466,8 +679,133 @@ func (dt DateTime) Truncate(unit Unit) DateTime {
 		return dt.StartOfMonth()
 	case UnitQuarter:
 		return dt.StartOfQuarter()
+	case UnitHalfYear:
+		return dt.StartOfHalf()
 	case UnitYear:
 		return dt.StartOfYear()
+	case UnitDecade:
+		return dt.StartOfDecade()
+	case UnitCentury:
+		return dt.StartOfCentury()
+	default:
+		return dt
+	}
+}
+
+// truncateClock moves dt back by the wall-clock remainder below a sub-day unit.
+func (dt DateTime) truncateClock(remainder time.Duration) DateTime {
+	return dt.shiftClock(-remainder)
+}
+
+// shiftClock moves dt's wall-clock reading by delta. Staying in dt's own offset keeps a
+// time in a repeated (fall-back) hour in that hour; if that does not show the expected
+// clock reading, the wall-clock time is resolved in dt's location, moving past a DST
+// gap to the transition.
+func (dt DateTime) shiftClock(delta time.Duration) DateTime {
+	t := dt.Time.Add(delta)
+	wall := time.Date(dt.Year(), dt.Month(), dt.Day(), dt.Hour(), dt.Minute(), dt.Second(), dt.Nanosecond(), time.UTC).Add(delta)
+	if sameWallClock(t, wall) {
+		return DateTime{t}
+	}
+	return DateWithGapPolicy(wall.Year(), wall.Month(), wall.Day(), wall.Hour(), wall.Minute(), wall.Second(), wall.Nanosecond(), dt.Location(), GapShiftForward)
+}
+
+// FloorToDuration returns dt rounded down to a multiple of d on the local wall clock,
+// counted from midnight: FloorToDuration(15*time.Minute) turns 10:37 into 10:30. d
+// should divide a day evenly; the grid restarts at each local midnight. On DST days
+// the grid follows the clock, and a result inside a skipped hour moves past the
+// transition. dt is returned unchanged if d is not positive.
+//
+// Example:
+//
+//	dt.FloorToDuration(15 * time.Minute) // 10:37:12 -> 10:30:00
+func (dt DateTime) FloorToDuration(d time.Duration) DateTime {
+	if d <= 0 {
+		return dt
+	}
+	return dt.truncateClock(clockOf(dt) % d)
+}
+
+// CeilToDuration returns dt rounded up to a multiple of d on the local wall clock,
+// counted from midnight, leaving times already on the grid unchanged. See
+// FloorToDuration for how DST days are handled.
+//
+// Example:
+//
+//	dt.CeilToDuration(5 * time.Minute) // 10:37:12 -> 10:40:00
+func (dt DateTime) CeilToDuration(d time.Duration) DateTime {
+	if d <= 0 {
+		return dt
+	}
+	remainder := clockOf(dt) % d
+	if remainder == 0 {
+		return dt
+	}
+	return dt.shiftClock(d - remainder)
+}
+
+// RoundToDuration returns dt rounded to the nearest multiple of d on the local wall
+// clock, counted from midnight, for snapping times to quarter hours or 5-minute slots.
+// Ties round up, as with Round. See FloorToDuration for how DST days are handled.
+//
+// Example:
+//
+//	dt.RoundToDuration(15 * time.Minute) // 10:37:30 -> 10:45:00, 10:37:29 -> 10:30:00
+func (dt DateTime) RoundToDuration(d time.Duration) DateTime {
+	if d <= 0 {
+		return dt
+	}
+	if clockOf(dt)%d*2 < d {
+		return dt.FloorToDuration(d)
+	}
+	return dt.CeilToDuration(d)
+}
+
+// midnight returns the first instant of the given calendar day in loc. Where a DST
+// transition skips midnight (as in America/Santiago) that is the transition itself.
+func midnight(year int, month time.Month, day int, loc *time.Location) time.Time {
+	return DateWithGapPolicy(year, month, day, 0, 0, 0, 0, loc, GapShiftForward).Time
+}
+
+// StartOf returns the start of the given unit. It is an alias for Truncate.
+//
+// Example:
+//
+//	dt.StartOf(chronogo.UnitMonth) // Same as dt.StartOfMonth()
+func (dt DateTime) StartOf(unit Unit) DateTime {
+	return dt.Truncate(unit)
+}
+
+// EndOf returns the last nanosecond of the given unit.
+// Returns dt unchanged for unknown units.
+//
+// Example:
+//
+//	dt.EndOf(chronogo.UnitWeek) // Same as dt.EndOfWeek()
+func (dt DateTime) EndOf(unit Unit) DateTime {
+	switch unit {
+	case UnitSecond:
+		return dt.Truncate(UnitSecond).Add(time.Second - time.Nanosecond)
+	case UnitMinute:
+		return dt.Truncate(UnitMinute).Add(time.Minute - time.Nanosecond)
+	case UnitHour:
+		return dt.Truncate(UnitHour).Add(time.Hour - time.Nanosecond)
+	case UnitDay:
+		return dt.EndOfDay()
+	case UnitWeek:
+		return dt.EndOfWeek()
+	case UnitMonth:
+		return dt.EndOfMonth()
+	case UnitQuarter:
+		return dt.EndOfQuarter()
+	case UnitHalfYear:
+		return dt.EndOfHalf()
+	case UnitYear:
+		return dt.EndOfYear()
+	case UnitDecade:
+		return dt.EndOfDecade()
+	case UnitCentury:
+		return dt.EndOfCentury()
 	default:
 		return dt
 	}
@@ -475,7 +813,7 @@ func (dt DateTime) Truncate(unit Unit) DateTime {
 
 // Round returns dt rounded to the nearest boundary of the given unit.
 // Ties are rounded up to the next boundary.
-// Calendar-aware for day/week/month/quarter/year using local timezone boundaries.
+// Calendar-aware for day/week/month/quarter/half-year/year/decade/century using local timezone boundaries.
 func (dt DateTime) Round(unit Unit) DateTime {
 	start := dt.Truncate(unit)
 
@@ -495,11 +833,21 @@ func (dt DateTime) Round(unit Unit) DateTime {
 		next = start.AddMonths(1)
 	case UnitQuarter:
 		next = start.AddMonths(3)
+	case UnitHalfYear:
+		next = start.AddMonths(6)
 	case UnitYear:
 		next = start.AddYears(1)
+	case UnitDecade:
+		next = start.AddYears(10)
+	case UnitCentury:
+		next = start.AddYears(100)
 	default:
 		return dt
 	}
+	if unit >= UnitDay {
+		// The start may sit after a skipped midnight; realign the next boundary
+		next = next.StartOfDay()
+	}
 
 	// Use duration between boundaries to decide rounding
 	toStart := dt.Sub(start)
@@ -540,9 +888,36 @@ func (dt DateTime) Between(a, b DateTime, inclusive bool) bool {
 	return dt.After(min) && dt.Before(max)
 }
 
+// marshalPrecision holds the ISOPrecision used by MarshalText and MarshalJSON.
+var marshalPrecision atomic.Int32
+
+// SetMarshalPrecision sets the fractional-second precision used by MarshalJSON and
+// MarshalText. The default, ISOPrecisionSeconds, drops sub-second data; use
+// ISOPrecisionAuto to keep every significant digit so values round-trip exactly.
+// The original UTC offset is always preserved. Safe for concurrent use.
+//
+// Example:
+//
+//	chronogo.SetMarshalPrecision(chronogo.ISOPrecisionAuto)
+//	json.Marshal(dt) // "2024-06-15T14:30:00.123456789+02:00"
+func SetMarshalPrecision(precision ISOPrecision) {
+	marshalPrecision.Store(int32(precision))
+}
+
+// GetMarshalPrecision returns the precision used by MarshalJSON and MarshalText.
+func GetMarshalPrecision() ISOPrecision {
+	return ISOPrecision(marshalPrecision.Load())
+}
+
+// marshalString returns the ISO 8601 representation used for serialization.
+func (dt DateTime) marshalString() string {
+	return dt.ToISO8601StringWith(ISOFormatOptions{Precision: GetMarshalPrecision()})
+}
+
 // MarshalText implements encoding.TextMarshaler.
+// The fractional-second precision is controlled by SetMarshalPrecision.
 func (dt DateTime) MarshalText() ([]byte, error) {
-	return []byte(dt.ToISO8601String()), nil
+	return []byte(dt.marshalString()), nil
 }
 
 // UnmarshalText implements encoding.TextUnmarshaler.
@@ -560,14 +935,45 @@ func (dt *DateTime) UnmarshalText(data []byte) error {
 	return nil
 }
 
+// marshalZeroAsNull makes MarshalJSON write null for the zero DateTime.
+var marshalZeroAsNull atomic.Bool
+
+// SetMarshalZeroAsNull controls how MarshalJSON writes the zero DateTime: as null
+// when enabled, instead of "0001-01-01T00:00:00Z" (the default). UnmarshalJSON reads
+// null back as the zero DateTime either way. Safe for concurrent use.
+//
+// Example:
+//
+//	chronogo.SetMarshalZeroAsNull(true)
+//	json.Marshal(struct{ Deleted chronogo.DateTime }{}) // {"Deleted":null}
+func SetMarshalZeroAsNull(enabled bool) {
+	marshalZeroAsNull.Store(enabled)
+}
+
 // MarshalJSON implements json.Marshaler.
+// The fractional-second precision is controlled by SetMarshalPrecision, and
+// SetMarshalZeroAsNull makes the zero DateTime marshal as null.
 func (dt DateTime) MarshalJSON() ([]byte, error) {
+	if dt.IsZero() && marshalZeroAsNull.Load() {
+		return []byte("null"), nil
+	}
 	// Quote the ISO 8601 string
-	return []byte(fmt.Sprintf("\"%s\"", dt.ToISO8601String())), nil
+	return []byte(fmt.Sprintf("\"%s\"", dt.marshalString())), nil
 }
 
 // UnmarshalJSON implements json.Unmarshaler.
+// It accepts anything Parse does, including natural language, unless
+// SetStrictJSON is enabled, in which case only RFC 3339 strings are accepted (see
+// ParseJSONStrict). Use StrictDateTime to make a single field strict.
 func (dt *DateTime) UnmarshalJSON(data []byte) error {
+	if strictJSON.Load() {
+		parsed, err := ParseJSONStrict(data)
+		if err != nil {
+			return err
+		}
+		*dt = parsed
+		return nil
+	}
 	s := strings.TrimSpace(string(data))
 	if s == "null" || s == "" {
 		*dt = DateTime{}
@@ -619,18 +1025,21 @@ func (dt *DateTime) Scan(value any) error {
 }
 
 // StartOfDay returns a new DateTime set to the beginning of the day (00:00:00).
+// On days where a DST transition skips midnight, it returns the first instant of the day.
 func (dt DateTime) StartOfDay() DateTime {
-	return DateTime{time.Date(dt.Year(), dt.Month(), dt.Day(), 0, 0, 0, 0, dt.Location())}
+	return DateTime{midnight(dt.Year(), dt.Month(), dt.Day(), dt.Location())}
 }
 
 // EndOfDay returns a new DateTime set to the end of the day (23:59:59.999999999).
+// It is the last instant before the next day starts, so a repeated late-evening
+// hour on a fall-back day is included.
 func (dt DateTime) EndOfDay() DateTime {
-	return DateTime{time.Date(dt.Year(), dt.Month(), dt.Day(), 23, 59, 59, 999999999, dt.Location())}
+	return DateTime{midnight(dt.Year(), dt.Month(), dt.Day()+1, dt.Location()).Add(-time.Nanosecond)}
 }
 
 // StartOfMonth returns a new DateTime set to the beginning of the month (first day at 00:00:00).
 func (dt DateTime) StartOfMonth() DateTime {
-	return DateTime{time.Date(dt.Year(), dt.Month(), 1, 0, 0, 0, 0, dt.Location())}
+	return DateTime{midnight(dt.Year(), dt.Month(), 1, dt.Location())}
 }
 
 // EndOfMonth returns a new DateTime set to the end of the month (last day at 23:59:59.999999999).
@@ -654,7 +1063,7 @@ func (dt DateTime) EndOfWeek() DateTime {
 
 // StartOfYear returns a new DateTime set to the beginning of the year (January 1st at 00:00:00).
 func (dt DateTime) StartOfYear() DateTime {
-	return DateTime{time.Date(dt.Year(), time.January, 1, 0, 0, 0, 0, dt.Location())}
+	return DateTime{midnight(dt.Year(), time.January, 1, dt.Location())}
 }
 
 // EndOfYear returns a new DateTime set to the end of the year (December 31st at 23:59:59.999999999).
@@ -683,7 +1092,7 @@ func (dt DateTime) Quarter() int {
 func (dt DateTime) StartOfQuarter() DateTime {
 	quarter := dt.Quarter()
 	month := time.Month((quarter-1)*3 + 1)
-	return DateTime{time.Date(dt.Year(), month, 1, 0, 0, 0, 0, dt.Location())}
+	return DateTime{midnight(dt.Year(), month, 1, dt.Location())}
 }
 
 // EndOfQuarter returns a new DateTime set to the end of the quarter.
@@ -691,6 +1100,148 @@ func (dt DateTime) EndOfQuarter() DateTime {
 	return dt.StartOfQuarter().AddMonths(3).AddDays(-1).EndOfDay()
 }
 
+// Half returns the half of the year (1 for January-June, 2 for July-December), as
+// used for semesters and H1/H2 reporting.
+func (dt DateTime) Half() int {
+	if dt.Month() <= time.June {
+		return 1
+	}
+	return 2
+}
+
+// StartOfHalf returns a new DateTime set to the beginning of the half year
+// (January 1st or July 1st).
+func (dt DateTime) StartOfHalf() DateTime {
+	month := time.Month((dt.Half()-1)*6 + 1)
+	return DateTime{midnight(dt.Year(), month, 1, dt.Location())}
+}
+
+// EndOfHalf returns a new DateTime set to the end of the half year
+// (June 30th or December 31st at 23:59:59.999999999).
+func (dt DateTime) EndOfHalf() DateTime {
+	return dt.StartOfHalf().AddMonths(6).AddDays(-1).EndOfDay()
+}
+
+// StartOfDecade returns a new DateTime set to the beginning of the decade
+// (January 1st of the year divisible by 10, e.g. 2020-01-01 for 2024).
+func (dt DateTime) StartOfDecade() DateTime {
+	year := dt.Year() - floorMod(dt.Year(), 10)
+	return DateTime{midnight(year, time.January, 1, dt.Location())}
+}
+
+// EndOfDecade returns a new DateTime set to the end of the decade
+// (e.g. 2029-12-31 23:59:59.999999999 for 2024).
+func (dt DateTime) EndOfDecade() DateTime {
+	return dt.StartOfDecade().AddYears(9).EndOfYear()
+}
+
+// StartOfCentury returns a new DateTime set to the beginning of the century.
+// Centuries start in years ending in 01, so 2024 belongs to the century
+// starting 2001-01-01 and 2000 to the one starting 1901-01-01.
+func (dt DateTime) StartOfCentury() DateTime {
+	year := dt.Year() - floorMod(dt.Year()-1, 100)
+	return DateTime{midnight(year, time.January, 1, dt.Location())}
+}
+
+// EndOfCentury returns a new DateTime set to the end of the century
+// (e.g. 2100-12-31 23:59:59.999999999 for 2024).
+func (dt DateTime) EndOfCentury() DateTime {
+	return dt.StartOfCentury().AddYears(99).EndOfYear()
+}
+
+// floorMod returns a modulo b with the sign of b, so negative years
+// still align to earlier boundaries.
+func floorMod(a, b int) int {
+	m := a % b
+	if m < 0 {
+		m += b
+	}
+	return m
+}
+
+// FromOrdinal creates a DateTime at midnight on the given day of the year (1-366).
+// Returns an error wrapping ErrOutOfRange when dayOfYear does not exist in year.
+//
+// Example:
+//
+//	dt, err := chronogo.FromOrdinal(2024, 123, time.UTC) // 2024-05-02
+func FromOrdinal(year, dayOfYear int, loc *time.Location) (DateTime, error) {
+	if dayOfYear < 1 || dayOfYear > daysInYear(year) {
+		return DateTime{}, &ChronoError{
+			Op:         "FromOrdinal",
+			Input:      fmt.Sprintf("%04d-%03d", year, dayOfYear),
+			Err:        fmt.Errorf("%w: day of year %d for %d", ErrOutOfRange, dayOfYear, year),
+			Suggestion: fmt.Sprintf("Use a day of year between 1 and %d", daysInYear(year)),
+		}
+	}
+	return DateTime{time.Date(year, time.January, dayOfYear, 0, 0, 0, 0, loc)}, nil
+}
+
+// FromISOWeek creates a DateTime at midnight on the given weekday of an ISO 8601 week.
+// The year is the ISO week-numbering year, which can differ from the calendar year
+// around New Year. Returns an error wrapping ErrOutOfRange when the week does not
+// exist in that year.
+//
+// Example:
+//
+//	dt, err := chronogo.FromISOWeek(2024, 25, time.Wednesday, time.UTC) // 2024-06-19
+func FromISOWeek(year, week int, weekday time.Weekday, loc *time.Location) (DateTime, error) {
+	if weekday < time.Sunday || weekday > time.Saturday {
+		return DateTime{}, &ChronoError{
+			Op:         "FromISOWeek",
+			Input:      fmt.Sprintf("%04d-W%02d", year, week),
+			Err:        fmt.Errorf("%w: weekday %d", ErrOutOfRange, weekday),
+			Suggestion: "Use a time.Weekday constant such as time.Monday",
+		}
+	}
+	weeks := isoWeeksInYear(year)
+	if week < 1 || week > weeks {
+		return DateTime{}, &ChronoError{
+			Op:         "FromISOWeek",
+			Input:      fmt.Sprintf("%04d-W%02d", year, week),
+			Err:        fmt.Errorf("%w: week %d for %d", ErrOutOfRange, week, year),
+			Suggestion: fmt.Sprintf("Use a week between 1 and %d", weeks),
+		}
+	}
+	isoDay := (int(weekday)+6)%7 + 1
+	return DateTime{isoWeekToDate(year, week, isoDay, loc)}, nil
+}
+
+// OrdinalDate returns the year and day of the year (1-366), the inverse of FromOrdinal.
+func (dt DateTime) OrdinalDate() (year, dayOfYear int) {
+	return dt.Year(), dt.YearDay()
+}
+
+// ISOWeekDate returns the ISO 8601 week-numbering year, week and weekday,
+// the inverse of FromISOWeek.
+func (dt DateTime) ISOWeekDate() (year, week int, weekday time.Weekday) {
+	year, week = dt.Time.ISOWeek()
+	return year, week, dt.Weekday()
+}
+
+// ISOWeekday returns the ISO 8601 day of the week, from 1 for Monday to 7 for
+// Sunday. Go's Weekday numbers Sunday as 0.
+func (dt DateTime) ISOWeekday() int {
+	if dt.Weekday() == time.Sunday {
+		return 7
+	}
+	return int(dt.Weekday())
+}
+
+// daysInYear returns 366 for leap years and 365 otherwise.
+func daysInYear(year int) int {
+	if IsLeap(year) {
+		return 366
+	}
+	return 365
+}
+
+// isoWeeksInYear returns the number of ISO 8601 weeks (52 or 53) in an ISO week-numbering year.
+func isoWeeksInYear(year int) int {
+	_, week := time.Date(year, time.December, 28, 0, 0, 0, 0, time.UTC).ISOWeek()
+	return week
+}
+
 // ISOWeek returns the ISO 8601 year and week number.
 // Week 1 is the first week with at least 4 days in the new year.
 func (dt DateTime) ISOWeek() (year, week int) {
@@ -760,21 +1311,118 @@ func (dt DateTime) WeekOfMonthWithStart(start time.Weekday) int {
 	return ((offset + dt.Day() - 1) / 7) + 1
 }
 
+// WeekRule selects how WeekOfYear numbers the weeks of a year.
+type WeekRule int
+
+const (
+	// WeekRuleISO follows ISO 8601: weeks start on Monday and week 1 is the first week
+	// with at least four days in the new year. Early January days may fall in week 52
+	// or 53 of the previous year, and late December days in week 1 of the next.
+	WeekRuleISO WeekRule = iota
+	// WeekRuleUS numbers Sunday-start weeks with week 1 containing January 1, as in
+	// US calendars and Excel's WEEKNUM. Weeks never cross into another year, so a
+	// year has 53 or 54 weeks.
+	WeekRuleUS
+	// WeekRuleFirstFullWeek numbers Monday-start weeks with week 1 the first week lying
+	// entirely in the new year. Days before it belong to the last week of the
+	// previous year.
+	WeekRuleFirstFullWeek
+)
+
+// WeekOfYear returns the week number of the datetime's date under rule, so reports
+// can match regional expectations. ISOWeekNumber is equivalent to WeekRuleISO.
+//
+// Example:
+//
+//	dt := chronogo.Date(2022, time.January, 1, 0, 0, 0, 0, time.UTC) // Saturday
+//	dt.WeekOfYear(chronogo.WeekRuleISO)           // 52 (of 2021)
+//	dt.WeekOfYear(chronogo.WeekRuleUS)            // 1
+//	dt.WeekOfYear(chronogo.WeekRuleFirstFullWeek) // 52 (of 2021)
+func (dt DateTime) WeekOfYear(rule WeekRule) int {
+	switch rule {
+	case WeekRuleUS:
+		jan1 := time.Date(dt.Year(), time.January, 1, 0, 0, 0, 0, time.UTC)
+		return (int(jan1.Weekday())+dt.YearDay()-1)/7 + 1
+	case WeekRuleFirstFullWeek:
+		return firstFullWeekOfYear(dt.Year(), dt.YearDay())
+	default:
+		return dt.ISOWeekNumber()
+	}
+}
+
+// firstFullWeekOfYear numbers Monday-start weeks counting from the first one that
+// lies entirely in year.
+func firstFullWeekOfYear(year, yearDay int) int {
+	jan1 := time.Date(year, time.January, 1, 0, 0, 0, 0, time.UTC)
+	firstMonday := 1 + (8-int(jan1.Weekday()))%7
+	if yearDay < firstMonday {
+		return firstFullWeekOfYear(year-1, daysInYear(year-1))
+	}
+	return (yearDay-firstMonday)/7 + 1
+}
+
 // DaysInMonth returns the number of days in the datetime's month.
 func (dt DateTime) DaysInMonth() int {
-	year, month, _ := dt.Date()
-	// Create the first day of the next month and subtract one day
-	firstOfNextMonth := time.Date(year, month+1, 1, 0, 0, 0, 0, dt.Location())
-	lastOfCurrentMonth := firstOfNextMonth.AddDate(0, 0, -1)
-	return lastOfCurrentMonth.Day()
+	return DaysInMonthOf(dt.Year(), dt.Month())
 }
 
 // DaysInYear returns the number of days in the datetime's year (365 or 366 for leap years).
 func (dt DateTime) DaysInYear() int {
-	if dt.IsLeapYear() {
-		return 366
+	return daysInYear(dt.Year())
+}
+
+// IsLeap reports whether year is a leap year in the proleptic Gregorian calendar.
+//
+// Example:
+//
+//	chronogo.IsLeap(2024) // true
+//	chronogo.IsLeap(1900) // false
+func IsLeap(year int) bool {
+	return year%4 == 0 && (year%100 != 0 || year%400 == 0)
+}
+
+// DaysInMonthOf returns the number of days in the given month, or 0 if month is
+// not between January and December.
+//
+// Example:
+//
+//	chronogo.DaysInMonthOf(2024, time.February) // 29
+func DaysInMonthOf(year int, month time.Month) int {
+	switch month {
+	case time.February:
+		if IsLeap(year) {
+			return 29
+		}
+		return 28
+	case time.April, time.June, time.September, time.November:
+		return 30
+	case time.January, time.March, time.May, time.July, time.August, time.October, time.December:
+		return 31
+	default:
+		return 0
 	}
-	return 365
+}
+
+// IsValidDate reports whether year, month and day form a real calendar date, without
+// the normalization time.Date applies (which turns February 30 into March 1).
+//
+// Example:
+//
+//	chronogo.IsValidDate(2023, 2, 30) // false
+//	chronogo.IsValidDate(2024, 2, 29) // true
+func IsValidDate(year int, month time.Month, day int) bool {
+	return day >= 1 && day <= DaysInMonthOf(year, month)
+}
+
+// IsValidTime reports whether hour, minute and second form a valid clock time
+// (00:00:00 through 23:59:59). Leap seconds are not accepted.
+//
+// Example:
+//
+//	chronogo.IsValidTime(23, 59, 59) // true
+//	chronogo.IsValidTime(24, 0, 0)   // false
+func IsValidTime(hour, minute, second int) bool {
+	return hour >= 0 && hour < 24 && minute >= 0 && minute < 60 && second >= 0 && second < 60
 }
 
 // FromUnixMilli creates a DateTime from a Unix timestamp in milliseconds in the specified location.
@@ -792,69 +1440,40 @@ func FromUnixNano(ns int64, loc *time.Location) DateTime {
 	return DateTime{time.Unix(0, ns).In(loc)}
 }
 
-// DST optimization cache entry
-type dstCacheEntry struct {
-	standardOffset int
-	lastYear       int
+// FromUnixUTC creates a DateTime in UTC from a Unix timestamp in seconds.
+func FromUnixUTC(sec int64) DateTime {
+	return DateTime{time.Unix(sec, 0).UTC()}
 }
 
-var dstCache sync.Map // map[*time.Location]*dstCacheEntry
-
-// IsDSTOptimized returns whether the datetime is in daylight saving time using optimized caching
-func (dt DateTime) IsDSTOptimized() bool {
-	loc := dt.Location()
-	year := dt.Year()
-
-	// Fast path for UTC - never DST
-	if loc == time.UTC {
-		return false
-	}
-
-	// Check cache
-	if entry, ok := dstCache.Load(loc); ok {
-		cacheEntry := entry.(*dstCacheEntry)
-		if cacheEntry.lastYear == year {
-			_, currentOffset := dt.Zone()
-			return currentOffset != cacheEntry.standardOffset
-		}
-	}
-
-	// Cache miss or stale year - calculate and cache
-	standardOffset := getStandardOffsetOptimized(loc, year)
-	dstCache.Store(loc, &dstCacheEntry{
-		standardOffset: standardOffset,
-		lastYear:       year,
-	})
-
-	_, currentOffset := dt.Zone()
-	return currentOffset != standardOffset
+// FromUnixMilliUTC creates a DateTime in UTC from a Unix timestamp in milliseconds.
+func FromUnixMilliUTC(ms int64) DateTime {
+	return DateTime{time.UnixMilli(ms).UTC()}
 }
 
-// getStandardOffsetOptimized calculates standard offset with optimizations
-func getStandardOffsetOptimized(loc *time.Location, year int) int {
-	// For most locations, the standard time is the non-DST time, which typically occurs in winter
-	// We'll use January (definitely winter) to get the standard offset
-	winterTime := time.Date(year, 1, 15, 12, 0, 0, 0, loc)
-	_, winterOffset := winterTime.Zone()
-
-	// Also check December to be sure
-	decemberTime := time.Date(year, 12, 15, 12, 0, 0, 0, loc)
-	_, decemberOffset := decemberTime.Zone()
-
-	// In the northern hemisphere, winter time is standard time
-	// In the southern hemisphere, it's more complex, but January/December should be consistent
-	if winterOffset == decemberOffset {
-		return winterOffset
+// FromUnixAuto creates a DateTime in UTC from a Unix timestamp whose unit is guessed
+// from its magnitude, as Parse does for timestamp strings: values up to 11 digits
+// are seconds, up to 14 milliseconds, up to 17 microseconds, and larger values
+// nanoseconds. Seconds therefore cover dates until the year 5138, and millisecond
+// values from March 1973 onwards are recognized.
+//
+// Example:
+//
+//	chronogo.FromUnixAuto(1705314600)          // 2024-01-15 10:30:00 UTC
+//	chronogo.FromUnixAuto(1705314600000)       // same instant, from milliseconds
+//	chronogo.FromUnixAuto(1705314600000000000) // same instant, from nanoseconds
+func FromUnixAuto(n int64) DateTime {
+	abs := n
+	if abs < 0 {
+		abs = -abs
 	}
-
-	// If they're different, take the smaller offset (standard time is usually less than DST)
-	if winterOffset < decemberOffset {
-		return winterOffset
+	switch {
+	case abs < 1e11:
+		return FromUnixUTC(n)
+	case abs < 1e14:
+		return FromUnixMilliUTC(n)
+	case abs < 1e17:
+		return DateTime{time.UnixMicro(n).UTC()}
+	default:
+		return DateTime{time.Unix(0, n).UTC()}
 	}
-	return decemberOffset
-}
-
-// ClearDSTCache clears the DST cache (useful for testing or memory management)
-func ClearDSTCache() {
-	dstCache = sync.Map{}
 }
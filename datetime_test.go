@@ -1,6 +1,7 @@
 package chronogo
 
 import (
+	"errors"
 	"testing"
 	"time"
 )
@@ -62,6 +63,27 @@ func TestTomorrowYesterday(t *testing.T) {
 	}
 }
 
+func TestSinceUntil(t *testing.T) {
+	defer ClearTestNow()
+	SetTestNow(Date(2024, time.June, 15, 12, 0, 0, 0, time.UTC))
+
+	past := Date(2024, time.June, 15, 10, 30, 0, 0, time.UTC)
+	if got := Since(past); got.Duration != 90*time.Minute {
+		t.Errorf("Since() = %v, want 1h30m", got)
+	}
+	if got := Until(past); got.Duration != -90*time.Minute {
+		t.Errorf("Until() = %v, want -1h30m", got)
+	}
+
+	future := Date(2024, time.June, 17, 12, 0, 0, 0, time.UTC)
+	if got := Until(future); got.Duration != 48*time.Hour {
+		t.Errorf("Until() = %v, want 48h", got)
+	}
+	if got := Until(future).HumanString(); got != "2 days" {
+		t.Errorf("Until().HumanString() = %q, want %q", got, "2 days")
+	}
+}
+
 func TestDate(t *testing.T) {
 	dt := Date(2023, time.December, 25, 15, 30, 45, 123456789, time.UTC)
 
@@ -206,6 +228,46 @@ func TestJSONTextSQLInterfaces(t *testing.T) {
 	}
 }
 
+func TestMarshalPrecision(t *testing.T) {
+	original := GetMarshalPrecision()
+	defer SetMarshalPrecision(original)
+
+	input := "2024-06-15T14:30:00.123456789+02:00"
+	dt, err := Parse(input)
+	if err != nil {
+		t.Fatalf("Parse error: %v", err)
+	}
+
+	SetMarshalPrecision(ISOPrecisionSeconds)
+	if b, _ := dt.MarshalJSON(); string(b) != `"2024-06-15T14:30:00+02:00"` {
+		t.Errorf("seconds precision MarshalJSON = %s", b)
+	}
+
+	SetMarshalPrecision(ISOPrecisionMillis)
+	if b, _ := dt.MarshalText(); string(b) != "2024-06-15T14:30:00.123+02:00" {
+		t.Errorf("millis precision MarshalText = %s", b)
+	}
+
+	SetMarshalPrecision(ISOPrecisionAuto)
+	b, err := dt.MarshalJSON()
+	if err != nil {
+		t.Fatalf("MarshalJSON error: %v", err)
+	}
+	if string(b) != `"`+input+`"` {
+		t.Errorf("auto precision MarshalJSON = %s, want %q", b, input)
+	}
+	var back DateTime
+	if err := back.UnmarshalJSON(b); err != nil {
+		t.Fatalf("UnmarshalJSON error: %v", err)
+	}
+	if !back.Equal(dt) || back.OffsetSeconds() != dt.OffsetSeconds() {
+		t.Errorf("round trip = %v, want %v with the same offset", back, dt)
+	}
+	if GetMarshalPrecision() != ISOPrecisionAuto {
+		t.Errorf("GetMarshalPrecision() = %v, want ISOPrecisionAuto", GetMarshalPrecision())
+	}
+}
+
 func TestIsLeapYear(t *testing.T) {
 	tests := []struct {
 		year     int
@@ -248,6 +310,25 @@ func TestIsPastFuture(t *testing.T) {
 	}
 }
 
+func TestIsPastFutureReference(t *testing.T) {
+	ref := Date(2000, time.January, 1, 0, 0, 0, 0, time.UTC)
+	before, after := ref.AddSeconds(-1), ref.AddSeconds(1)
+
+	if !before.IsPastAt(ref) || before.IsFutureAt(ref) || !after.IsFutureAt(ref) || after.IsPastAt(ref) {
+		t.Error("IsPastAt/IsFutureAt should compare against the explicit reference")
+	}
+	if ref.IsPastAt(ref) || ref.IsFutureAt(ref) {
+		t.Error("the reference itself is neither past nor future")
+	}
+
+	// The test clock is honored when no reference is given
+	WithTestNow(ref, func() {
+		if !before.IsPast() || !after.IsFuture() {
+			t.Error("IsPast/IsFuture should use the test clock")
+		}
+	})
+}
+
 func TestArithmetic(t *testing.T) {
 	dt := Date(2023, time.January, 15, 12, 30, 45, 0, time.UTC)
 
@@ -334,6 +415,31 @@ func TestSetters(t *testing.T) {
 	if newDt.Second() != 30 {
 		t.Errorf("SetSecond should set second to 30")
 	}
+
+	// Test SetNanosecond
+	newDt = dt.SetNanosecond(123456789)
+	if newDt.Nanosecond() != 123456789 || newDt.Second() != 45 {
+		t.Errorf("SetNanosecond should set nanosecond to 123456789, got %v", newDt)
+	}
+	if got := dt.Set().Nanosecond(500).Build(); got.Nanosecond() != 500 {
+		t.Errorf("Set().Nanosecond(500) = %v", got)
+	}
+}
+
+func TestSubSecondTruncation(t *testing.T) {
+	dt := Date(2024, time.January, 15, 10, 30, 0, 123456789, time.UTC)
+	if got := dt.TruncateToMillisecond(); got.Nanosecond() != 123000000 || got.Second() != 0 {
+		t.Errorf("TruncateToMillisecond() = %v", got)
+	}
+	if got := dt.TruncateToMicrosecond(); got.Nanosecond() != 123456000 {
+		t.Errorf("TruncateToMicrosecond() = %v", got)
+	}
+
+	// A value read back from a microsecond-precision store compares equal after truncation
+	stored := FromUnixMicro(dt.UnixMicro(), time.UTC)
+	if dt.Equal(stored) || !dt.TruncateToMicrosecond().Equal(stored) {
+		t.Errorf("TruncateToMicrosecond() = %v, stored = %v", dt.TruncateToMicrosecond(), stored)
+	}
 }
 
 func TestComparisons(t *testing.T) {
@@ -872,6 +978,72 @@ func TestEndOfQuarter(t *testing.T) {
 	}
 }
 
+func TestHalfYear(t *testing.T) {
+	tests := []struct {
+		input      DateTime
+		half       int
+		start, end DateTime
+	}{
+		{Date(2024, time.March, 15, 12, 0, 0, 0, time.UTC), 1, Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC), Date(2024, time.June, 30, 23, 59, 59, 999999999, time.UTC)},
+		{Date(2024, time.June, 30, 23, 0, 0, 0, time.UTC), 1, Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC), Date(2024, time.June, 30, 23, 59, 59, 999999999, time.UTC)},
+		{Date(2024, time.July, 1, 0, 0, 0, 0, time.UTC), 2, Date(2024, time.July, 1, 0, 0, 0, 0, time.UTC), Date(2024, time.December, 31, 23, 59, 59, 999999999, time.UTC)},
+	}
+	for _, tt := range tests {
+		if got := tt.input.Half(); got != tt.half {
+			t.Errorf("Half() for %v = %d, want %d", tt.input, got, tt.half)
+		}
+		if got := tt.input.StartOf(UnitHalfYear); !got.Equal(tt.start) || !tt.input.StartOfHalf().Equal(tt.start) {
+			t.Errorf("StartOfHalf() for %v = %v, want %v", tt.input, got, tt.start)
+		}
+		if got := tt.input.EndOf(UnitHalfYear); !got.Equal(tt.end) || !tt.input.EndOfHalf().Equal(tt.end) {
+			t.Errorf("EndOfHalf() for %v = %v, want %v", tt.input, got, tt.end)
+		}
+	}
+
+	may := Date(2024, time.May, 1, 0, 0, 0, 0, time.UTC)
+	if got := may.Round(UnitHalfYear); !got.Equal(Date(2024, time.July, 1, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("Round(UnitHalfYear) = %v", got)
+	}
+	if !may.IsSame(UnitHalfYear, Date(2024, time.January, 2, 0, 0, 0, 0, time.UTC)) || may.IsSameHalf(Date(2024, time.July, 1, 0, 0, 0, 0, time.UTC)) {
+		t.Error("IsSame(UnitHalfYear) mismatch")
+	}
+	year := Period{Start: Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC), End: Date(2024, time.December, 31, 0, 0, 0, 0, time.UTC)}
+	if n := len(year.Buckets(UnitHalfYear)); n != 2 {
+		t.Errorf("Buckets(UnitHalfYear) = %d buckets, want 2", n)
+	}
+}
+
+func TestISOWeekday(t *testing.T) {
+	monday := Date(2024, time.January, 15, 12, 0, 0, 0, time.UTC)
+	for i := 0; i < 7; i++ {
+		if got := monday.AddDays(i).ISOWeekday(); got != i+1 {
+			t.Errorf("%s ISOWeekday() = %d, want %d", monday.AddDays(i).Weekday(), got, i+1)
+		}
+	}
+}
+
+func TestWeekOfYear(t *testing.T) {
+	tests := []struct {
+		date               DateTime
+		iso, us, firstFull int
+	}{
+		{Date(2022, time.January, 1, 0, 0, 0, 0, time.UTC), 52, 1, 52},   // Saturday
+		{Date(2022, time.January, 2, 0, 0, 0, 0, time.UTC), 52, 2, 52},   // Sunday
+		{Date(2022, time.January, 3, 0, 0, 0, 0, time.UTC), 1, 2, 1},     // first Monday
+		{Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC), 1, 1, 1},     // Monday
+		{Date(2024, time.December, 31, 0, 0, 0, 0, time.UTC), 1, 53, 53}, // Tuesday
+		{Date(2023, time.January, 1, 0, 0, 0, 0, time.UTC), 52, 1, 52},   // Sunday
+		{Date(2021, time.January, 3, 0, 0, 0, 0, time.UTC), 53, 2, 52},   // Sunday
+	}
+	for _, tt := range tests {
+		iso, us, full := tt.date.WeekOfYear(WeekRuleISO), tt.date.WeekOfYear(WeekRuleUS), tt.date.WeekOfYear(WeekRuleFirstFullWeek)
+		if iso != tt.iso || us != tt.us || full != tt.firstFull {
+			t.Errorf("%s: WeekOfYear = ISO %d, US %d, first full %d; want %d, %d, %d",
+				tt.date.ToDateString(), iso, us, full, tt.iso, tt.us, tt.firstFull)
+		}
+	}
+}
+
 func TestISOWeek(t *testing.T) {
 	dt := Date(2023, time.December, 25, 12, 0, 0, 0, time.UTC)
 	year, week := dt.ISOWeek()
@@ -992,6 +1164,95 @@ func TestRound(t *testing.T) {
 	}
 }
 
+func TestRoundToDuration(t *testing.T) {
+	dt := Date(2024, time.June, 15, 10, 37, 12, 500, time.UTC)
+	at := func(h, m, s int) DateTime { return Date(2024, time.June, 15, h, m, s, 0, time.UTC) }
+
+	tests := []struct {
+		name string
+		got  DateTime
+		want DateTime
+	}{
+		{"floor quarter hour", dt.FloorToDuration(15 * time.Minute), at(10, 30, 0)},
+		{"ceil 5 minutes", dt.CeilToDuration(5 * time.Minute), at(10, 40, 0)},
+		{"round quarter hour down", at(10, 37, 29).RoundToDuration(15 * time.Minute), at(10, 30, 0)},
+		{"round quarter hour tie up", at(10, 37, 30).RoundToDuration(15 * time.Minute), at(10, 45, 0)},
+		{"ceil on the grid", at(10, 45, 0).CeilToDuration(15 * time.Minute), at(10, 45, 0)},
+		{"ceil to next midnight", at(23, 50, 0).CeilToDuration(15 * time.Minute), Date(2024, time.June, 16, 0, 0, 0, 0, time.UTC)},
+		{"non-positive duration", dt.RoundToDuration(0), dt},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if !tt.got.Equal(tt.want) {
+				t.Errorf("got %v, want %v", tt.got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRoundToDurationDST(t *testing.T) {
+	ny, _ := time.LoadLocation("America/New_York")
+
+	// Spring forward: 01:50 rounds to 02:00, which is skipped, so it lands on 03:00
+	got := Date(2024, time.March, 10, 1, 50, 0, 0, ny).RoundToDuration(30 * time.Minute)
+	if got.Hour() != 3 || got.Minute() != 0 {
+		t.Errorf("RoundToDuration() into a DST gap = %v, want 03:00", got)
+	}
+	// After the gap the grid follows the wall clock
+	if got := Date(2024, time.March, 10, 3, 10, 0, 0, ny).FloorToDuration(15 * time.Minute); got.Hour() != 3 || got.Minute() != 0 {
+		t.Errorf("FloorToDuration() after a DST gap = %v, want 03:00", got)
+	}
+
+	// Fall back: the second 01:20 (EST) stays in the repeated hour
+	second := Date(2024, time.November, 3, 6, 20, 0, 0, time.UTC).In(ny)
+	got = second.CeilToDuration(15 * time.Minute)
+	if !got.Equal(Date(2024, time.November, 3, 6, 30, 0, 0, time.UTC)) {
+		t.Errorf("CeilToDuration() in a repeated hour = %v, want 01:30 EST", got)
+	}
+}
+
+func TestStartOfEndOfUnit(t *testing.T) {
+	loc := time.UTC
+	dt := Date(2024, time.June, 15, 13, 27, 59, 987654321, loc)
+
+	tests := []struct {
+		unit       Unit
+		start, end DateTime
+	}{
+		{UnitSecond, Date(2024, time.June, 15, 13, 27, 59, 0, loc), Date(2024, time.June, 15, 13, 27, 59, 999999999, loc)},
+		{UnitMinute, Date(2024, time.June, 15, 13, 27, 0, 0, loc), Date(2024, time.June, 15, 13, 27, 59, 999999999, loc)},
+		{UnitHour, Date(2024, time.June, 15, 13, 0, 0, 0, loc), Date(2024, time.June, 15, 13, 59, 59, 999999999, loc)},
+		{UnitDay, Date(2024, time.June, 15, 0, 0, 0, 0, loc), Date(2024, time.June, 15, 23, 59, 59, 999999999, loc)},
+		{UnitWeek, Date(2024, time.June, 10, 0, 0, 0, 0, loc), Date(2024, time.June, 16, 23, 59, 59, 999999999, loc)},
+		{UnitMonth, Date(2024, time.June, 1, 0, 0, 0, 0, loc), Date(2024, time.June, 30, 23, 59, 59, 999999999, loc)},
+		{UnitQuarter, Date(2024, time.April, 1, 0, 0, 0, 0, loc), Date(2024, time.June, 30, 23, 59, 59, 999999999, loc)},
+		{UnitYear, Date(2024, time.January, 1, 0, 0, 0, 0, loc), Date(2024, time.December, 31, 23, 59, 59, 999999999, loc)},
+		{UnitDecade, Date(2020, time.January, 1, 0, 0, 0, 0, loc), Date(2029, time.December, 31, 23, 59, 59, 999999999, loc)},
+		{UnitCentury, Date(2001, time.January, 1, 0, 0, 0, 0, loc), Date(2100, time.December, 31, 23, 59, 59, 999999999, loc)},
+	}
+	for _, tt := range tests {
+		if got := dt.StartOf(tt.unit); !got.Equal(tt.start) {
+			t.Errorf("StartOf(%d) = %v, want %v", tt.unit, got, tt.start)
+		}
+		if got := dt.EndOf(tt.unit); !got.Equal(tt.end) {
+			t.Errorf("EndOf(%d) = %v, want %v", tt.unit, got, tt.end)
+		}
+	}
+
+	if got := Date(2000, time.July, 1, 0, 0, 0, 0, loc).StartOfCentury(); !got.Equal(Date(1901, time.January, 1, 0, 0, 0, 0, loc)) {
+		t.Errorf("StartOfCentury(2000) = %v, want 1901-01-01", got)
+	}
+	if got := Date(2024, time.March, 1, 0, 0, 0, 0, loc).Round(UnitDecade); !got.Equal(Date(2020, time.January, 1, 0, 0, 0, 0, loc)) {
+		t.Errorf("Round decade = %v, want 2020-01-01", got)
+	}
+	if !dt.IsSame(UnitDecade, Date(2029, time.December, 31, 0, 0, 0, 0, loc)) || dt.IsSameDecade(Date(2030, time.January, 1, 0, 0, 0, 0, loc)) {
+		t.Error("IsSame decade boundaries incorrect")
+	}
+	if !dt.IsSame(UnitCentury, Date(2100, time.June, 1, 0, 0, 0, 0, loc)) || dt.IsSameCentury(Date(2000, time.June, 1, 0, 0, 0, 0, loc)) {
+		t.Error("IsSame century boundaries incorrect")
+	}
+}
+
 func TestClampAndBetween(t *testing.T) {
 	loc := time.UTC
 	min := Date(2023, time.January, 1, 0, 0, 0, 0, loc)
@@ -1148,6 +1409,37 @@ func TestFromUnixVariants(t *testing.T) {
 	if dt.UnixNano() != nano {
 		t.Errorf("FromUnixNano roundtrip failed: expected %d, got %d", nano, dt.UnixNano())
 	}
+
+	// Test UTC constructors
+	dt = FromUnixUTC(1703520645)
+	if dt.Location() != time.UTC || dt.Unix() != 1703520645 {
+		t.Errorf("FromUnixUTC failed: got %v", dt)
+	}
+	dt = FromUnixMilliUTC(milli)
+	if dt.Location() != time.UTC || dt.UnixMilli() != milli {
+		t.Errorf("FromUnixMilliUTC failed: got %v", dt)
+	}
+
+	// Test FromUnixAuto unit detection
+	want := FromUnixNano(nano, time.UTC)
+	autoTests := []struct {
+		input int64
+		want  DateTime
+	}{
+		{1703520645, want.Truncate(UnitSecond)},
+		{milli, want.TruncateToMillisecond()},
+		{micro, want.TruncateToMicrosecond()},
+		{nano, want},
+		{0, FromUnixUTC(0)},
+		{-86400, Date(1969, time.December, 31, 0, 0, 0, 0, time.UTC)},
+		{99999999999, FromUnixUTC(99999999999)},
+		{100000000000, FromUnixMilliUTC(100000000000)},
+	}
+	for _, tt := range autoTests {
+		if got := FromUnixAuto(tt.input); !got.Equal(tt.want) || got.Location() != time.UTC {
+			t.Errorf("FromUnixAuto(%d) = %v, want %v", tt.input, got, tt.want)
+		}
+	}
 }
 
 func TestUnwrap(t *testing.T) {
@@ -1217,142 +1509,369 @@ func TestSQLDriverInterfaces(t *testing.T) {
 	}
 }
 
-func TestIsDSTOptimized(t *testing.T) {
-	// Clear cache before testing
-	ClearDSTCache()
-
+func TestIsDSTZones(t *testing.T) {
 	tests := []struct {
 		name     string
 		location string
-		datetime string
+		date     string
 		expected bool
 	}{
-		{"New York summer (DST)", "America/New_York", "2023-07-15 15:30:45", true},
-		{"New York winter (no DST)", "America/New_York", "2023-01-15 15:30:45", false},
-		{"London summer (BST)", "Europe/London", "2023-07-15 15:30:45", true},
-		{"London winter (GMT)", "Europe/London", "2023-01-15 15:30:45", false},
-		{"UTC (never DST)", "UTC", "2023-07-15 15:30:45", false},
+		{"Sydney January (summer DST)", "Australia/Sydney", "2024-01-15 12:00:00", true},
+		{"Sydney July (winter standard)", "Australia/Sydney", "2024-07-15 12:00:00", false},
+		{"Santiago January (summer DST)", "America/Santiago", "2024-01-15 12:00:00", true},
+		{"Santiago July (winter standard)", "America/Santiago", "2024-07-15 12:00:00", false},
+		{"Lord Howe January (+11)", "Australia/Lord_Howe", "2024-01-15 12:00:00", true},
+		{"Lord Howe July (+10:30)", "Australia/Lord_Howe", "2024-07-15 12:00:00", false},
+		{"New York 2024 summer", "America/New_York", "2024-07-15 12:00:00", true},
+		{"Tokyo (no DST)", "Asia/Tokyo", "2024-07-15 12:00:00", false},
 	}
 
-	for _, test := range tests {
-		t.Run(test.name, func(t *testing.T) {
-			loc, err := time.LoadLocation(test.location)
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			loc, err := time.LoadLocation(tt.location)
 			if err != nil {
-				t.Skipf("Could not load location %s: %v", test.location, err)
+				t.Skipf("Could not load location %s: %v", tt.location, err)
 			}
-
-			dt, err := ParseInLocation(test.datetime, loc)
+			dt, err := ParseInLocation(tt.date, loc)
 			if err != nil {
-				t.Fatalf("Could not parse datetime %s: %v", test.datetime, err)
+				t.Fatalf("Could not parse datetime %s: %v", tt.date, err)
 			}
-
-			result := dt.IsDSTOptimized()
-			if result != test.expected {
-				t.Errorf("IsDSTOptimized() for %s: expected %v, got %v", test.name, test.expected, result)
+			if got := dt.IsDST(); got != tt.expected {
+				t.Errorf("IsDST() = %v, want %v", got, tt.expected)
 			}
+		})
+	}
 
-			// Test that cached result is the same
-			cachedResult := dt.IsDSTOptimized()
-			if cachedResult != result {
-				t.Errorf("Cached IsDSTOptimized() result differs: expected %v, got %v", result, cachedResult)
-			}
+	if Date(2024, time.July, 1, 12, 0, 0, 0, FixedZone("", 3600)).IsDST() {
+		t.Error("Fixed zones are never in DST")
+	}
+}
 
-			// Compare with original IsDST method
-			originalResult := dt.IsDST()
-			if result != originalResult {
-				t.Errorf("IsDSTOptimized() differs from IsDST(): optimized=%v, original=%v", result, originalResult)
+func TestIsDSTMatchesZoneData(t *testing.T) {
+	// Zones where comparing against a guessed standard offset goes wrong
+	tests := []struct {
+		location string
+		dt       [3]int // year, month, day at noon
+		expected bool
+	}{
+		{"America/Caracas", [3]int{2016, 1, 15}, false}, // -04:30 until May 2016, then -04
+		{"America/Caracas", [3]int{2016, 7, 15}, false},
+		{"Europe/Dublin", [3]int{2024, 1, 15}, true}, // negative DST: GMT in winter
+		{"Europe/Dublin", [3]int{2024, 7, 15}, false},
+		{"Africa/Windhoek", [3]int{2016, 7, 15}, true}, // negative DST until 2017
+		{"Africa/Windhoek", [3]int{2016, 1, 15}, false},
+		{"Africa/Windhoek", [3]int{2024, 7, 15}, false},
+		{"Australia/Sydney", [3]int{2045, 1, 15}, true}, // beyond the transition table
+		{"Asia/Tehran", [3]int{2021, 7, 15}, true},
+		{"Asia/Tehran", [3]int{2024, 7, 15}, false}, // DST abolished in 2022
+	}
+	for _, tt := range tests {
+		loc, err := time.LoadLocation(tt.location)
+		if err != nil {
+			t.Skipf("Could not load location %s: %v", tt.location, err)
+		}
+		dt := Date(tt.dt[0], time.Month(tt.dt[1]), tt.dt[2], 12, 0, 0, 0, loc)
+		if got := dt.IsDST(); got != tt.expected {
+			t.Errorf("IsDST() for %s %v = %v, want %v", tt.location, dt, got, tt.expected)
+		}
+	}
+
+	// Every zone agrees with the time package on the 15th of each month
+	years := []int{1990, 2005, 2016, 2024, 2045, 2100}
+	for _, name := range AvailableTimezones() {
+		loc, err := time.LoadLocation(name)
+		if err != nil {
+			continue
+		}
+		for _, year := range years {
+			for month := time.January; month <= time.December; month++ {
+				want := time.Date(year, month, 15, 12, 0, 0, 0, loc).IsDST()
+				if got := Date(year, month, 15, 12, 0, 0, 0, loc).IsDST(); got != want {
+					t.Errorf("IsDST() for %s %d-%02d-15 = %v, want %v", name, year, month, got, want)
+				}
 			}
-		})
+		}
 	}
 }
 
-func TestIsDSTOptimizedUTCFastPath(t *testing.T) {
-	// Test UTC fast path
-	utcTime := NowUTC()
-	result := utcTime.IsDSTOptimized()
-	if result {
-		t.Error("UTC time should never be in DST")
+func TestIsDSTNoAllocs(t *testing.T) {
+	ny, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Skip("Could not load America/New_York timezone")
+	}
+	dt := Date(2024, time.July, 15, 12, 0, 0, 0, ny)
+	if allocs := testing.AllocsPerRun(100, func() { _ = dt.IsDST() }); allocs != 0 {
+		t.Errorf("IsDST() allocated %v times per call, want 0", allocs)
 	}
 }
 
-func TestIsDSTOptimizedCaching(t *testing.T) {
-	ClearDSTCache()
-
+func TestClearDSTCache(t *testing.T) {
 	loc, err := time.LoadLocation("America/New_York")
 	if err != nil {
 		t.Skip("Could not load America/New_York timezone")
 	}
 
-	// Test caching across different dates in same year
-	summer1 := Date(2023, 7, 15, 12, 0, 0, 0, loc)
-	summer2 := Date(2023, 8, 15, 12, 0, 0, 0, loc)
+	dt := Date(2023, time.July, 15, 12, 0, 0, 0, loc)
+	ClearDSTCache() // a no-op kept for compatibility
+	if !dt.IsDST() {
+		t.Error("IsDST should still work after cache clear")
+	}
+}
 
-	result1 := summer1.IsDSTOptimized()
-	result2 := summer2.IsDSTOptimized()
+func TestFromOrdinal(t *testing.T) {
+	dt, err := FromOrdinal(2024, 123, time.UTC)
+	if err != nil {
+		t.Fatalf("FromOrdinal error: %v", err)
+	}
+	if want := Date(2024, time.May, 2, 0, 0, 0, 0, time.UTC); !dt.Equal(want) {
+		t.Errorf("FromOrdinal(2024, 123) = %v, want %v", dt, want)
+	}
+	if year, day := dt.OrdinalDate(); year != 2024 || day != 123 {
+		t.Errorf("OrdinalDate() = %d, %d, want 2024, 123", year, day)
+	}
+
+	if _, err := FromOrdinal(2024, 366, time.UTC); err != nil {
+		t.Errorf("FromOrdinal(2024, 366) unexpected error: %v", err)
+	}
+	for _, day := range []int{0, 366, -1} {
+		if _, err := FromOrdinal(2023, day, time.UTC); !errors.Is(err, ErrInvalidRange) {
+			t.Errorf("FromOrdinal(2023, %d) error = %v, want ErrInvalidRange", day, err)
+		}
+	}
+}
+
+func TestFromISOWeek(t *testing.T) {
+	dt, err := FromISOWeek(2024, 25, time.Wednesday, time.UTC)
+	if err != nil {
+		t.Fatalf("FromISOWeek error: %v", err)
+	}
+	if want := Date(2024, time.June, 19, 0, 0, 0, 0, time.UTC); !dt.Equal(want) {
+		t.Errorf("FromISOWeek(2024, 25, Wed) = %v, want %v", dt, want)
+	}
+
+	// ISO week-year differs from the calendar year
+	dt, err = FromISOWeek(2020, 53, time.Sunday, time.UTC)
+	if err != nil {
+		t.Fatalf("FromISOWeek(2020, 53) error: %v", err)
+	}
+	if want := Date(2021, time.January, 3, 0, 0, 0, 0, time.UTC); !dt.Equal(want) {
+		t.Errorf("FromISOWeek(2020, 53, Sun) = %v, want %v", dt, want)
+	}
+	if year, week, weekday := dt.ISOWeekDate(); year != 2020 || week != 53 || weekday != time.Sunday {
+		t.Errorf("ISOWeekDate() = %d, %d, %v, want 2020, 53, Sunday", year, week, weekday)
+	}
 
-	if result1 != result2 {
-		t.Error("DST results should be same for dates in same year with same DST status")
+	for _, week := range []int{0, 53} {
+		if _, err := FromISOWeek(2023, week, time.Monday, time.UTC); !errors.Is(err, ErrInvalidRange) {
+			t.Errorf("FromISOWeek(2023, %d) error = %v, want ErrInvalidRange", week, err)
+		}
+	}
+	if _, err := FromISOWeek(2024, 1, time.Weekday(7), time.UTC); err == nil {
+		t.Error("FromISOWeek with invalid weekday expected error")
 	}
+}
 
-	// Test different years
-	summer2024 := Date(2024, 7, 15, 12, 0, 0, 0, loc)
-	result2024 := summer2024.IsDSTOptimized()
+func TestAddQuarters(t *testing.T) {
+	dt := Date(2024, time.February, 10, 9, 0, 0, 0, time.UTC)
 
-	// Should still work correctly (caching per year)
-	if !result2024 {
-		t.Error("Summer 2024 should be in DST in New York")
+	if got := dt.AddQuarters(2); !got.Equal(Date(2024, time.August, 10, 9, 0, 0, 0, time.UTC)) {
+		t.Errorf("AddQuarters(2) = %v", got)
+	}
+	if got := dt.SubtractQuarters(1); !got.Equal(Date(2023, time.November, 10, 9, 0, 0, 0, time.UTC)) {
+		t.Errorf("SubtractQuarters(1) = %v", got)
+	}
+	if got := dt.AddQuarters(4).Quarter(); got != dt.Quarter() {
+		t.Errorf("AddQuarters(4) quarter = %d, want %d", got, dt.Quarter())
+	}
+	if got := dt.AddFluent().Quarters(1).Days(1).To(dt); !got.Equal(Date(2024, time.May, 11, 9, 0, 0, 0, time.UTC)) {
+		t.Errorf("fluent Quarters(1).Days(1) = %v", got)
 	}
 }
 
-func TestClearDSTCache(t *testing.T) {
-	// Add some entries to cache
-	loc, err := time.LoadLocation("America/New_York")
+func TestAddWeeks(t *testing.T) {
+	dt := Date(2024, time.February, 26, 9, 0, 0, 0, time.UTC)
+
+	if got := dt.AddWeeks(1); !got.Equal(Date(2024, time.March, 4, 9, 0, 0, 0, time.UTC)) {
+		t.Errorf("AddWeeks(1) = %v", got)
+	}
+	if got := dt.SubtractWeeks(9); !got.Equal(Date(2023, time.December, 25, 9, 0, 0, 0, time.UTC)) {
+		t.Errorf("SubtractWeeks(9) = %v", got)
+	}
+	if got := dt.AddFluent().Weeks(3).To(dt); !got.Equal(dt.AddWeeks(3)) {
+		t.Errorf("fluent Weeks(3) = %v, want %v", got, dt.AddWeeks(3))
+	}
+
+	// Weeks are calendar based, so wall-clock time is kept across DST changes
+	ny, err := time.LoadLocation("America/New_York")
 	if err != nil {
-		t.Skip("Could not load America/New_York timezone")
+		t.Skip("America/New_York timezone not available")
 	}
+	before := Date(2024, time.March, 5, 9, 0, 0, 0, ny)
+	if got := before.AddWeeks(1); got.Hour() != 9 || got.Day() != 12 {
+		t.Errorf("AddWeeks across DST = %v, want 2024-03-12 09:00", got)
+	}
+}
 
-	dt := Date(2023, 7, 15, 12, 0, 0, 0, loc)
-	_ = dt.IsDSTOptimized() // This should populate the cache
+func TestAddSubSecondUnits(t *testing.T) {
+	dt := Date(2024, time.June, 15, 12, 0, 0, 0, time.UTC)
 
-	// Clear cache
-	ClearDSTCache()
+	if got := dt.AddMilliseconds(1500); !got.Equal(Date(2024, time.June, 15, 12, 0, 1, 500000000, time.UTC)) {
+		t.Errorf("AddMilliseconds(1500) = %v", got)
+	}
+	if got := dt.AddMicroseconds(250); got.Nanosecond() != 250000 {
+		t.Errorf("AddMicroseconds(250) nanosecond = %d, want 250000", got.Nanosecond())
+	}
+	if got := dt.AddNanoseconds(7); got.Nanosecond() != 7 {
+		t.Errorf("AddNanoseconds(7) nanosecond = %d, want 7", got.Nanosecond())
+	}
+	if got := dt.SubtractMilliseconds(1); !got.Equal(Date(2024, time.June, 15, 11, 59, 59, 999000000, time.UTC)) {
+		t.Errorf("SubtractMilliseconds(1) = %v", got)
+	}
+	if got := dt.SubtractMicroseconds(1); !got.Equal(Date(2024, time.June, 15, 11, 59, 59, 999999000, time.UTC)) {
+		t.Errorf("SubtractMicroseconds(1) = %v", got)
+	}
+	if got := dt.SubtractNanoseconds(1); !got.Equal(Date(2024, time.June, 15, 11, 59, 59, 999999999, time.UTC)) {
+		t.Errorf("SubtractNanoseconds(1) = %v", got)
+	}
 
-	// This should work without issues after clearing
-	result := dt.IsDSTOptimized()
-	if !result {
-		t.Error("IsDSTOptimized should still work after cache clear")
+	fluent := dt.AddFluent().Milliseconds(2).Microseconds(3).Nanoseconds(4).To(dt)
+	if want := dt.AddMilliseconds(2).AddMicroseconds(3).AddNanoseconds(4); !fluent.Equal(want) {
+		t.Errorf("fluent sub-second units = %v, want %v", fluent, want)
 	}
 }
 
-func TestGetStandardOffsetOptimized(t *testing.T) {
+func TestISO8601Variants(t *testing.T) {
+	loc := time.FixedZone("", 2*3600)
+	dt := Date(2024, time.June, 15, 14, 30, 0, 123456789, loc)
+
 	tests := []struct {
-		location string
-		year     int
+		opts     ISOFormatOptions
+		expected string
 	}{
-		{"America/New_York", 2023},
-		{"Europe/London", 2023},
-		{"UTC", 2023},
-		{"America/New_York", 2024},
+		{ISOFormatOptions{}, "2024-06-15T14:30:00+02:00"},
+		{ISOFormatOptions{Precision: ISOPrecisionMillis}, "2024-06-15T14:30:00.123+02:00"},
+		{ISOFormatOptions{Precision: ISOPrecisionMicros}, "2024-06-15T14:30:00.123456+02:00"},
+		{ISOFormatOptions{Precision: ISOPrecisionNanos}, "2024-06-15T14:30:00.123456789+02:00"},
+		{ISOFormatOptions{Precision: ISOPrecisionAuto}, "2024-06-15T14:30:00.123456789+02:00"},
+		{ISOFormatOptions{Basic: true}, "20240615T143000+0200"},
+		{ISOFormatOptions{Basic: true, Precision: ISOPrecisionMillis}, "20240615T143000.123+0200"},
+	}
+	for _, tt := range tests {
+		if got := dt.ToISO8601StringWith(tt.opts); got != tt.expected {
+			t.Errorf("ToISO8601StringWith(%+v) = %q, want %q", tt.opts, got, tt.expected)
+		}
 	}
 
-	for _, test := range tests {
-		t.Run(test.location, func(t *testing.T) {
-			loc, err := time.LoadLocation(test.location)
-			if err != nil {
-				t.Skipf("Could not load location %s: %v", test.location, err)
-			}
+	utc := Date(2024, time.June, 15, 14, 30, 0, 500000000, time.UTC)
+	if got := utc.ToISO8601StringWith(ISOFormatOptions{Precision: ISOPrecisionAuto}); got != "2024-06-15T14:30:00.5Z" {
+		t.Errorf("auto precision = %q", got)
+	}
+	if got := utc.ToISO8601StringWith(ISOFormatOptions{Precision: ISOPrecisionMillis}); got != "2024-06-15T14:30:00.500Z" {
+		t.Errorf("millis precision = %q", got)
+	}
+	if got := utc.ToISO8601BasicString(); got != "20240615T143000Z" {
+		t.Errorf("ToISO8601BasicString() = %q", got)
+	}
+	if got := utc.ToISO8601DateString(); got != "2024-06-15" {
+		t.Errorf("ToISO8601DateString() = %q", got)
+	}
+	if got := utc.ToISOOrdinalString(); got != "2024-167" {
+		t.Errorf("ToISOOrdinalString() = %q", got)
+	}
+	if got := utc.ToISOWeekDateString(); got != "2024-W24-6" {
+		t.Errorf("ToISOWeekDateString() = %q", got)
+	}
+	if got := Date(2024, time.December, 30, 0, 0, 0, 0, time.UTC).ToISOWeekDateString(); got != "2025-W01-1" {
+		t.Errorf("ToISOWeekDateString() across years = %q", got)
+	}
 
-			offset := getStandardOffsetOptimized(loc, test.year)
+	// Ordinal and week-date outputs parse back to the same day
+	for _, s := range []string{utc.ToISOOrdinalString(), utc.ToISOWeekDateString()} {
+		parsed, err := Parse(s)
+		if err != nil {
+			t.Fatalf("Parse(%q) error: %v", s, err)
+		}
+		if !parsed.IsSameDay(utc) {
+			t.Errorf("Parse(%q) = %v, want 2024-06-15", s, parsed)
+		}
+	}
+}
 
-			// Basic sanity check - offset should be reasonable
-			if offset < -43200 || offset > 43200 { // -12 to +12 hours in seconds
-				t.Errorf("getStandardOffsetOptimized returned unreasonable offset: %d", offset)
-			}
+func TestCalendarValidators(t *testing.T) {
+	for year, want := range map[int]bool{2024: true, 2023: false, 2000: true, 1900: false, 2100: false} {
+		if IsLeap(year) != want {
+			t.Errorf("IsLeap(%d) = %v, want %v", year, !want, want)
+		}
+	}
 
-			// For UTC, offset should be 0
-			if test.location == "UTC" && offset != 0 {
-				t.Errorf("UTC standard offset should be 0, got %d", offset)
-			}
-		})
+	if got := DaysInMonthOf(2024, time.February); got != 29 {
+		t.Errorf("DaysInMonthOf(2024, February) = %d, want 29", got)
+	}
+	if got := DaysInMonthOf(2023, time.February); got != 28 {
+		t.Errorf("DaysInMonthOf(2023, February) = %d, want 28", got)
+	}
+	if got := DaysInMonthOf(2023, 13); got != 0 {
+		t.Errorf("DaysInMonthOf(2023, 13) = %d, want 0", got)
+	}
+
+	dates := []struct {
+		year  int
+		month time.Month
+		day   int
+		valid bool
+	}{
+		{2023, 2, 28, true},
+		{2023, 2, 29, false},
+		{2023, 2, 30, false},
+		{2024, 2, 29, true},
+		{2024, 4, 31, false},
+		{2024, 12, 31, true},
+		{2024, 0, 1, false},
+		{2024, 1, 0, false},
+	}
+	for _, d := range dates {
+		if IsValidDate(d.year, d.month, d.day) != d.valid {
+			t.Errorf("IsValidDate(%d, %d, %d) = %v, want %v", d.year, d.month, d.day, !d.valid, d.valid)
+		}
+	}
+
+	if !IsValidTime(0, 0, 0) || !IsValidTime(23, 59, 59) {
+		t.Error("Expected boundary times to be valid")
+	}
+	if IsValidTime(24, 0, 0) || IsValidTime(12, 60, 0) || IsValidTime(12, 0, 60) || IsValidTime(-1, 0, 0) {
+		t.Error("Expected out-of-range times to be invalid")
+	}
+}
+
+func TestDayBoundsDSTEdges(t *testing.T) {
+	santiago, err := time.LoadLocation("America/Santiago")
+	if err != nil {
+		t.Skip("Could not load America/Santiago timezone")
+	}
+
+	// 2024-09-08: clocks jump from 00:00 -04 to 01:00 -03, so the day starts at 01:00
+	dt := DateTime{time.Date(2024, time.September, 8, 4, 30, 0, 0, time.UTC).In(santiago)}
+	if got := dt.StartOfDay(); got.Day() != 8 || got.Hour() != 1 {
+		t.Errorf("StartOfDay() = %v, want 2024-09-08 01:00 -03", got)
+	}
+	if got := dt.Round(UnitDay); got.Day() != 8 || got.Hour() != 1 {
+		t.Errorf("Round(UnitDay) = %v, want 2024-09-08 01:00 -03", got)
+	}
+
+	// 2024-04-06: 23:00-24:00 repeats; the second 23:30 (-04) is still on the 6th
+	dt = DateTime{time.Date(2024, time.April, 7, 3, 30, 0, 0, time.UTC).In(santiago)}
+	if end := dt.EndOfDay(); end.Before(dt) || end.Day() != 6 {
+		t.Errorf("EndOfDay() = %v, want after %v on the same day", end, dt)
+	}
+	if got := dt.Truncate(UnitHour); !got.Equal(dt.Add(-30 * time.Minute)) {
+		t.Errorf("Truncate(UnitHour) = %v, want 23:00 -04", got)
+	}
+
+	// Pacific/Chatham skips 02:45-03:45; truncating 03:50 lands on the transition
+	if chatham, err := time.LoadLocation("Pacific/Chatham"); err == nil {
+		dt := Date(2024, time.September, 29, 3, 50, 0, 0, chatham)
+		if got := dt.Truncate(UnitHour); got.Hour() != 3 || got.Minute() != 45 {
+			t.Errorf("Chatham Truncate(UnitHour) = %v, want 03:45", got)
+		}
 	}
 }
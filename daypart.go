@@ -0,0 +1,132 @@
+package chronogo
+
+import "fmt"
+
+// DayPart is a coarse part of the day, used for greetings ("Good morning") or
+// batching notifications.
+type DayPart int
+
+const (
+	// DayPartEarlyMorning runs from midnight until morning (the small hours, "madrugada").
+	DayPartEarlyMorning DayPart = iota
+	// DayPartMorning runs from the morning boundary until the afternoon.
+	DayPartMorning
+	// DayPartAfternoon runs from the afternoon boundary until the evening.
+	DayPartAfternoon
+	// DayPartEvening runs from the evening boundary until night.
+	DayPartEvening
+	// DayPartNight runs from the night boundary until midnight.
+	DayPartNight
+)
+
+// String returns the English name of the day part.
+func (p DayPart) String() string {
+	switch p {
+	case DayPartEarlyMorning:
+		return "early morning"
+	case DayPartMorning:
+		return "morning"
+	case DayPartAfternoon:
+		return "afternoon"
+	case DayPartEvening:
+		return "evening"
+	case DayPartNight:
+		return "night"
+	default:
+		return fmt.Sprintf("DayPart(%d)", int(p))
+	}
+}
+
+// DayPartBoundaries holds the hours (0-24) at which each day part starts.
+// Hours must increase from Morning to Night; the early morning starts at midnight.
+type DayPartBoundaries struct {
+	Morning   int
+	Afternoon int
+	Evening   int
+	Night     int
+}
+
+// DefaultDayPartBoundaries are the boundaries used by DayPart: morning from 05:00,
+// afternoon from 12:00, evening from 17:00 and night from 21:00.
+var DefaultDayPartBoundaries = DayPartBoundaries{Morning: 5, Afternoon: 12, Evening: 17, Night: 21}
+
+// DayPart returns the part of the day dt's wall-clock time falls in, using
+// DefaultDayPartBoundaries.
+//
+// Example:
+//
+//	switch chronogo.Now().DayPart() {
+//	case chronogo.DayPartMorning:
+//	    greeting = "Good morning"
+//	case chronogo.DayPartAfternoon:
+//	    greeting = "Good afternoon"
+//	default:
+//	    greeting = "Good evening"
+//	}
+func (dt DateTime) DayPart() DayPart {
+	return dt.DayPartWith(DefaultDayPartBoundaries)
+}
+
+// DayPartWith returns the part of the day using custom boundaries.
+//
+// Example:
+//
+//	late := chronogo.DayPartBoundaries{Morning: 6, Afternoon: 13, Evening: 19, Night: 23}
+//	dt.DayPartWith(late)
+func (dt DateTime) DayPartWith(b DayPartBoundaries) DayPart {
+	hour := dt.Hour()
+	switch {
+	case hour >= b.Night:
+		return DayPartNight
+	case hour >= b.Evening:
+		return DayPartEvening
+	case hour >= b.Afternoon:
+		return DayPartAfternoon
+	case hour >= b.Morning:
+		return DayPartMorning
+	default:
+		return DayPartEarlyMorning
+	}
+}
+
+// IsMorning reports whether dt falls in the morning (05:00-11:59 by default).
+func (dt DateTime) IsMorning() bool {
+	return dt.DayPart() == DayPartMorning
+}
+
+// IsAfternoon reports whether dt falls in the afternoon (12:00-16:59 by default).
+func (dt DateTime) IsAfternoon() bool {
+	return dt.DayPart() == DayPartAfternoon
+}
+
+// IsEvening reports whether dt falls in the evening (17:00-20:59 by default).
+func (dt DateTime) IsEvening() bool {
+	return dt.DayPart() == DayPartEvening
+}
+
+// IsNight reports whether dt falls at night, which spans midnight: both DayPartNight
+// and DayPartEarlyMorning (21:00-04:59 by default).
+func (dt DateTime) IsNight() bool {
+	part := dt.DayPart()
+	return part == DayPartNight || part == DayPartEarlyMorning
+}
+
+// GetDayPartName returns the localized name of dt's day part, e.g. "madrugada" at
+// 03:00 in es-ES. Locales without day-part names fall back to English.
+func (dt DateTime) GetDayPartName(localeCode string) (string, error) {
+	locale, err := GetLocale(localeCode)
+	if err != nil {
+		return "", err
+	}
+	part := dt.DayPart()
+	if int(part) < len(locale.DayParts) {
+		return locale.DayParts[part], nil
+	}
+	return part.String(), nil
+}
+
+// GetDayPartNameDefault returns the localized day-part name using the default locale.
+func (dt DateTime) GetDayPartNameDefault() string {
+	name, _ := dt.GetDayPartName(GetDefaultLocale())
+	return name
+}
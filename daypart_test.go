@@ -0,0 +1,79 @@
+package chronogo
+
+import (
+	"testing"
+	"time"
+)
+
+func TestDayPart(t *testing.T) {
+	tests := []struct {
+		hour int
+		want DayPart
+	}{
+		{0, DayPartEarlyMorning},
+		{4, DayPartEarlyMorning},
+		{5, DayPartMorning},
+		{11, DayPartMorning},
+		{12, DayPartAfternoon},
+		{16, DayPartAfternoon},
+		{17, DayPartEvening},
+		{20, DayPartEvening},
+		{21, DayPartNight},
+		{23, DayPartNight},
+	}
+	for _, tt := range tests {
+		dt := UTC(2024, time.June, 15, tt.hour, 30, 0, 0)
+		if got := dt.DayPart(); got != tt.want {
+			t.Errorf("DayPart(%02d:30) = %v, want %v", tt.hour, got, tt.want)
+		}
+	}
+
+	morning := UTC(2024, time.June, 15, 8, 0, 0, 0)
+	if !morning.IsMorning() || morning.IsAfternoon() || morning.IsEvening() || morning.IsNight() {
+		t.Error("Expected 08:00 to be morning only")
+	}
+	if !UTC(2024, time.June, 15, 14, 0, 0, 0).IsAfternoon() || !UTC(2024, time.June, 15, 18, 0, 0, 0).IsEvening() {
+		t.Error("Expected 14:00 afternoon and 18:00 evening")
+	}
+	if !UTC(2024, time.June, 15, 22, 0, 0, 0).IsNight() || !UTC(2024, time.June, 15, 2, 0, 0, 0).IsNight() {
+		t.Error("Expected night to span midnight")
+	}
+
+	late := DayPartBoundaries{Morning: 6, Afternoon: 13, Evening: 19, Night: 23}
+	if got := UTC(2024, time.June, 15, 5, 30, 0, 0).DayPartWith(late); got != DayPartEarlyMorning {
+		t.Errorf("DayPartWith(late) at 05:30 = %v, want early morning", got)
+	}
+	if got := UTC(2024, time.June, 15, 22, 0, 0, 0).DayPartWith(late); got != DayPartEvening {
+		t.Errorf("DayPartWith(late) at 22:00 = %v, want evening", got)
+	}
+	if DayPartAfternoon.String() != "afternoon" {
+		t.Errorf("String() = %q", DayPartAfternoon.String())
+	}
+}
+
+func TestGetDayPartName(t *testing.T) {
+	dt := UTC(2024, time.June, 15, 3, 0, 0, 0)
+	tests := map[string]string{
+		"en-US": "early morning",
+		"es-ES": "madrugada",
+		"pt-BR": "madrugada",
+		"fr-FR": "petit matin",
+	}
+	for code, want := range tests {
+		got, err := dt.GetDayPartName(code)
+		if err != nil || got != want {
+			t.Errorf("GetDayPartName(%s) = %q, %v; want %q", code, got, err, want)
+		}
+	}
+	if got := UTC(2024, time.June, 15, 15, 0, 0, 0).GetDayPartNameDefault(); got != "afternoon" {
+		t.Errorf("GetDayPartNameDefault() = %q, want afternoon", got)
+	}
+	if _, err := dt.GetDayPartName("xx-XX"); err == nil {
+		t.Error("Expected error for unknown locale")
+	}
+
+	RegisterLocale(&Locale{Code: "test-DP", Name: "Test"})
+	if got, _ := dt.GetDayPartName("test-DP"); got != "early morning" {
+		t.Errorf("GetDayPartName without names = %q, want English fallback", got)
+	}
+}
@@ -3,17 +3,68 @@ package chronogo
 import (
 	"fmt"
 	"math"
+	"sync"
 	"time"
 )
 
 // Diff represents the difference between two DateTime instances.
 // It provides both precise (Duration-based) and calendar-aware (Period-based) difference methods.
 // This type unifies the functionality of time.Duration and Period into a single, convenient API.
+//
+// Calendar components (Years, Months) are computed lazily on first access and cached,
+// so repeated accessor calls on the same Diff, or on copies of it, are O(1).
 type Diff struct {
 	start    DateTime
 	end      DateTime
 	duration time.Duration
 	period   Period
+	calendar *diffCalendar
+	daysMode DaysMode
+}
+
+// DaysMode selects what Diff.Days (and Diff.Weeks, which derives from it) counts.
+type DaysMode int
+
+const (
+	// DaysElapsed counts full 24-hour periods of elapsed time, like WholeDays. It is
+	// the default.
+	DaysElapsed DaysMode = iota
+	// DaysCalendar counts calendar dates crossed, ignoring the time of day, like
+	// CalendarDays.
+	DaysCalendar
+)
+
+// diffCalendar memoizes the calendar-aware components of a Diff. It is shared by
+// copies of the Diff and filled in once, safely for concurrent use.
+type diffCalendar struct {
+	once   sync.Once
+	years  int
+	months int
+}
+
+// newDiff creates a Diff from start to end with an empty calendar cache.
+func newDiff(start, end DateTime) Diff {
+	return Diff{
+		start:    start,
+		end:      end,
+		duration: end.Sub(start),
+		period:   NewPeriod(start, end),
+		calendar: &diffCalendar{},
+	}
+}
+
+// calendarComponents returns the full years and total full months, computing them on
+// first use.
+func (d Diff) calendarComponents() (years, months int) {
+	if d.calendar == nil {
+		// Zero Diff or one built without newDiff: nothing to cache into
+		return d.period.Years(), d.period.Months()
+	}
+	d.calendar.once.Do(func() {
+		d.calendar.years = d.period.Years()
+		d.calendar.months = d.period.Months()
+	})
+	return d.calendar.years, d.calendar.months
 }
 
 // Diff returns a Diff object representing the difference between two DateTimes.
@@ -36,12 +87,60 @@ type Diff struct {
 //	// Human-readable
 //	fmt.Println(diff.ForHumans())
 func (dt DateTime) Diff(other DateTime) Diff {
-	return Diff{
-		start:    other,
-		end:      dt,
-		duration: dt.Sub(other),
-		period:   NewPeriod(other, dt),
+	return newDiff(other, dt)
+}
+
+// DiffWith returns the difference between two DateTimes like Diff, with Days and
+// Weeks counting as mode selects.
+//
+// Example:
+//
+//	start := chronogo.Date(2024, 3, 1, 22, 0, 0, 0, time.UTC)
+//	end := chronogo.Date(2024, 3, 3, 8, 0, 0, 0, time.UTC)
+//	end.Diff(start).Days()                             // 1 (34 hours)
+//	end.DiffWith(start, chronogo.DaysCalendar).Days() // 2 (March 1 to March 3)
+func (dt DateTime) DiffWith(other DateTime, mode DaysMode) Diff {
+	return dt.Diff(other).WithDaysMode(mode)
+}
+
+// DiffInCalendarDays returns the number of midnights crossed going from other to dt,
+// both taken in loc (dt's location when loc is omitted): the "days until" count a due
+// date or countdown shows. 23:59 to 00:01 the next day is 1, 00:01 to 23:59 the same
+// day is 0, and DST changes do not matter. The result is negative when dt is before
+// other.
+//
+// Example:
+//
+//	due := chronogo.Date(2024, 6, 20, 9, 0, 0, 0, time.UTC)
+//	due.DiffInCalendarDays(chronogo.Date(2024, 6, 17, 23, 30, 0, 0, time.UTC)) // 3
+func (dt DateTime) DiffInCalendarDays(other DateTime, loc ...*time.Location) int {
+	zone := dt.Location()
+	if len(loc) > 0 && loc[0] != nil {
+		zone = loc[0]
 	}
+	return calendarDaysBetween(other.In(zone), dt.In(zone))
+}
+
+// NightsBetween returns the number of nights between a check-in and a check-out in
+// hotel terms: the calendar dates crossed in loc (checkin's location when nil),
+// whatever the times of day. A night across a DST change is still one night, where
+// elapsed time would give 0.96 or 1.04 days. It returns 0 when checkout is not on a
+// later date than checkin.
+//
+// Example:
+//
+//	in := chronogo.Date(2024, 3, 9, 15, 0, 0, 0, ny)   // 3pm check-in
+//	out := chronogo.Date(2024, 3, 12, 11, 0, 0, 0, ny) // 11am check-out, after spring forward
+//	chronogo.NightsBetween(in, out, ny)                // 3
+func NightsBetween(checkin, checkout DateTime, loc *time.Location) int {
+	if loc == nil {
+		loc = checkin.Location()
+	}
+	nights := calendarDaysBetween(checkin.In(loc), checkout.In(loc))
+	if nights < 0 {
+		return 0
+	}
+	return nights
 }
 
 // DiffAbs returns the absolute difference between two DateTimes.
@@ -92,48 +191,85 @@ func (d Diff) IsZero() bool {
 // Abs returns a new Diff with positive duration.
 func (d Diff) Abs() Diff {
 	if d.IsNegative() {
-		return Diff{
-			start:    d.end,
-			end:      d.start,
-			duration: -d.duration,
-			period:   d.period.Abs(),
-		}
+		return d.Invert()
 	}
 	return d
 }
 
 // Invert returns a new Diff with start and end swapped.
 func (d Diff) Invert() Diff {
-	return Diff{
-		start:    d.end,
-		end:      d.start,
-		duration: -d.duration,
-		period:   Period{Start: d.end, End: d.start},
-	}
+	return newDiff(d.end, d.start).WithDaysMode(d.daysMode)
+}
+
+// WithDaysMode returns a copy of the Diff whose Days and Weeks count as mode selects.
+func (d Diff) WithDaysMode(mode DaysMode) Diff {
+	d.daysMode = mode
+	return d
+}
+
+// DaysMode returns what Days counts for this Diff.
+func (d Diff) DaysMode() DaysMode {
+	return d.daysMode
 }
 
 // Years returns the number of full calendar years in the difference.
 // This is calendar-aware and accounts for leap years and varying month lengths.
 func (d Diff) Years() int {
-	return d.period.Years()
+	years, _ := d.calendarComponents()
+	return years
 }
 
 // Months returns the total number of full calendar months in the difference.
 // This is calendar-aware and accounts for varying month lengths.
 func (d Diff) Months() int {
-	return d.period.Months()
+	_, months := d.calendarComponents()
+	return months
+}
+
+// Quarters returns the total number of full calendar quarters (3 months) in the difference.
+func (d Diff) Quarters() int {
+	return d.Months() / 3
 }
 
-// Weeks returns the number of full weeks in the difference.
+// Weeks returns the number of full weeks in the difference, counted from Days.
 func (d Diff) Weeks() int {
 	return d.Days() / 7
 }
 
-// Days returns the number of full 24-hour days in the difference.
+// Days returns the number of days in the difference: full 24-hour days (WholeDays)
+// by default, or calendar days (CalendarDays) for a Diff created with DiffWith or
+// WithDaysMode and DaysCalendar. Months and Years are always calendar-based.
 func (d Diff) Days() int {
+	if d.daysMode == DaysCalendar {
+		return d.CalendarDays()
+	}
+	return d.WholeDays()
+}
+
+// WholeDays returns the number of full 24-hour periods of elapsed time in the
+// difference, truncated toward zero. Across a DST change a calendar day can be 23 or
+// 25 hours, so this can differ from CalendarDays even at the same time of day.
+//
+// Example:
+//
+//	end := chronogo.Date(2024, 3, 3, 8, 0, 0, 0, time.UTC)
+//	end.Diff(chronogo.Date(2024, 3, 1, 22, 0, 0, 0, time.UTC)).WholeDays() // 1
+func (d Diff) WholeDays() int {
 	return d.period.Days()
 }
 
+// CalendarDays returns the number of calendar dates between start and end, ignoring
+// the time of day, so 23:59 to 00:01 the next day is one day. End is taken in
+// start's location. The result is negative when end is before start.
+//
+// Example:
+//
+//	end := chronogo.Date(2024, 3, 2, 0, 1, 0, 0, time.UTC)
+//	end.Diff(chronogo.Date(2024, 3, 1, 23, 59, 0, 0, time.UTC)).CalendarDays() // 1
+func (d Diff) CalendarDays() int {
+	return calendarDaysBetween(d.start, d.end.In(d.start.Location()))
+}
+
 // Hours returns the number of full hours in the difference.
 func (d Diff) Hours() int {
 	return d.period.Hours()
@@ -161,6 +297,12 @@ func (d Diff) InMonths() float64 {
 	return d.InDays() / 30.44
 }
 
+// InQuarters returns the total difference expressed as quarters (with fractional part).
+// This uses the same approximation as InMonths.
+func (d Diff) InQuarters() float64 {
+	return d.InMonths() / 3
+}
+
 // InWeeks returns the total difference expressed as weeks (with fractional part).
 func (d Diff) InWeeks() float64 {
 	return d.InDays() / 7.0
@@ -227,7 +369,7 @@ func (d Diff) String() string {
 
 	years := abs.Years()
 	months := abs.Months() % 12
-	days := abs.Days() % 30 // Approximate
+	days := abs.WholeDays() % 30 // Approximate
 	hours := abs.Hours() % 24
 	minutes := abs.Minutes() % 60
 	seconds := abs.Seconds() % 60
@@ -315,7 +457,7 @@ func (d Diff) CompactString() string {
 
 	years := abs.Years()
 	months := abs.Months() % 12
-	days := abs.Days() % 30
+	days := abs.WholeDays() % 30
 	hours := abs.Hours() % 24
 	minutes := abs.Minutes() % 60
 	seconds := abs.Seconds() % 60
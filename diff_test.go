@@ -3,6 +3,7 @@ package chronogo
 import (
 	"encoding/json"
 	"strings"
+	"sync"
 	"testing"
 	"time"
 )
@@ -499,3 +500,151 @@ func BenchmarkDiffMethods(b *testing.B) {
 		}
 	})
 }
+
+func TestDiffQuarters(t *testing.T) {
+	start := Date(2023, time.January, 15, 0, 0, 0, 0, time.UTC)
+	end := Date(2024, time.March, 10, 0, 0, 0, 0, time.UTC)
+
+	diff := end.Diff(start)
+	if got := diff.Quarters(); got != 4 {
+		t.Errorf("Quarters() = %d, want 4", got)
+	}
+	if got := diff.InQuarters(); got < 4.55 || got > 4.65 {
+		t.Errorf("InQuarters() = %f, want ~4.6", got)
+	}
+}
+
+func TestDiffCalendarCache(t *testing.T) {
+	start := Date(2019, time.March, 15, 10, 0, 0, 0, time.UTC)
+	end := Date(2024, time.August, 3, 14, 30, 0, 0, time.UTC)
+	d := end.Diff(start)
+	p := NewPeriod(start, end)
+
+	// Concurrent first access fills the shared cache once
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if d.Years() != p.Years() || d.Months() != p.Months() {
+				t.Errorf("cached components = %d/%d, want %d/%d", d.Years(), d.Months(), p.Years(), p.Months())
+			}
+		}()
+	}
+	wg.Wait()
+
+	copied := d
+	if copied.Years() != 5 || copied.Months() != 64 {
+		t.Errorf("copy Years/Months = %d/%d, want 5/64", copied.Years(), copied.Months())
+	}
+	if inv := d.Invert(); inv.Years() != -5 || inv.Months() != -64 {
+		t.Errorf("Invert Years/Months = %d/%d, want -5/-64", inv.Years(), inv.Months())
+	}
+	if abs := d.Invert().Abs(); abs.Years() != 5 || abs.Months() != 64 {
+		t.Errorf("Abs Years/Months = %d/%d, want 5/64", abs.Years(), abs.Months())
+	}
+	if (Diff{}).Years() != 0 || (Diff{}).Months() != 0 {
+		t.Error("Expected zero Diff to have zero components")
+	}
+}
+
+func TestDiffDaysModes(t *testing.T) {
+	ny, _ := time.LoadLocation("America/New_York")
+	tests := []struct {
+		name          string
+		start, end    DateTime
+		whole, calDay int
+	}{
+		{"across midnight", UTC(2024, time.March, 1, 23, 59, 0, 0), UTC(2024, time.March, 2, 0, 1, 0, 0), 0, 1},
+		{"34 hours", UTC(2024, time.March, 1, 22, 0, 0, 0), UTC(2024, time.March, 3, 8, 0, 0, 0), 1, 2},
+		{"backwards", UTC(2024, time.March, 3, 8, 0, 0, 0), UTC(2024, time.March, 1, 22, 0, 0, 0), -1, -2},
+		{"spring forward", Date(2024, time.March, 9, 12, 0, 0, 0, ny), Date(2024, time.March, 10, 12, 0, 0, 0, ny), 0, 1},
+		{"end in other zone", Date(2024, time.March, 1, 20, 0, 0, 0, ny), UTC(2024, time.March, 2, 6, 0, 0, 0), 0, 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := tt.end.Diff(tt.start)
+			if got := d.WholeDays(); got != tt.whole {
+				t.Errorf("WholeDays() = %d, want %d", got, tt.whole)
+			}
+			if got := d.CalendarDays(); got != tt.calDay {
+				t.Errorf("CalendarDays() = %d, want %d", got, tt.calDay)
+			}
+			if got := d.Days(); got != tt.whole {
+				t.Errorf("Days() = %d, want WholeDays %d", got, tt.whole)
+			}
+			if got := tt.end.DiffWith(tt.start, DaysCalendar).Days(); got != tt.calDay {
+				t.Errorf("DiffWith(DaysCalendar).Days() = %d, want %d", got, tt.calDay)
+			}
+		})
+	}
+
+	d := UTC(2024, time.March, 15, 1, 0, 0, 0).DiffWith(UTC(2024, time.March, 1, 23, 0, 0, 0), DaysCalendar)
+	if d.Weeks() != 2 || d.WithDaysMode(DaysElapsed).Weeks() != 1 {
+		t.Errorf("Weeks() = %d / %d, want 2 / 1", d.Weeks(), d.WithDaysMode(DaysElapsed).Weeks())
+	}
+	if inv := d.Invert(); inv.DaysMode() != DaysCalendar || inv.Days() != -14 {
+		t.Errorf("Invert() mode = %v, Days() = %d", inv.DaysMode(), inv.Days())
+	}
+}
+
+func TestDiffInCalendarDays(t *testing.T) {
+	ny, _ := time.LoadLocation("America/New_York")
+	tokyo, _ := time.LoadLocation("Asia/Tokyo")
+	tests := []struct {
+		name      string
+		dt, other DateTime
+		loc       *time.Location
+		want      int
+	}{
+		{"just past midnight", UTC(2024, time.June, 18, 0, 1, 0, 0), UTC(2024, time.June, 17, 23, 59, 0, 0), nil, 1},
+		{"same day", UTC(2024, time.June, 17, 23, 59, 0, 0), UTC(2024, time.June, 17, 0, 1, 0, 0), nil, 0},
+		{"due date", UTC(2024, time.June, 20, 9, 0, 0, 0), UTC(2024, time.June, 17, 23, 30, 0, 0), nil, 3},
+		{"overdue", UTC(2024, time.June, 15, 9, 0, 0, 0), UTC(2024, time.June, 17, 8, 0, 0, 0), nil, -2},
+		{"across spring forward", Date(2024, time.March, 11, 0, 0, 0, 0, ny), Date(2024, time.March, 9, 23, 0, 0, 0, ny), nil, 2},
+		{"explicit zone", UTC(2024, time.June, 17, 16, 0, 0, 0), UTC(2024, time.June, 17, 14, 0, 0, 0), tokyo, 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got int
+			if tt.loc != nil {
+				got = tt.dt.DiffInCalendarDays(tt.other, tt.loc)
+			} else {
+				got = tt.dt.DiffInCalendarDays(tt.other)
+			}
+			if got != tt.want {
+				t.Errorf("DiffInCalendarDays() = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNightsBetween(t *testing.T) {
+	ny, _ := time.LoadLocation("America/New_York")
+	tests := []struct {
+		name         string
+		checkin, out DateTime
+		loc          *time.Location
+		want         int
+	}{
+		{"three nights", Date(2024, time.June, 9, 15, 0, 0, 0, ny), Date(2024, time.June, 12, 11, 0, 0, 0, ny), ny, 3},
+		{"across spring forward", Date(2024, time.March, 9, 15, 0, 0, 0, ny), Date(2024, time.March, 10, 11, 0, 0, 0, ny), ny, 1},
+		{"across fall back", Date(2024, time.November, 2, 23, 0, 0, 0, ny), Date(2024, time.November, 3, 1, 0, 0, 0, ny), ny, 1},
+		{"day use", Date(2024, time.June, 9, 9, 0, 0, 0, ny), Date(2024, time.June, 9, 17, 0, 0, 0, ny), ny, 0},
+		{"checkout before checkin", Date(2024, time.June, 12, 11, 0, 0, 0, ny), Date(2024, time.June, 9, 15, 0, 0, 0, ny), ny, 0},
+		{"hotel zone", UTC(2024, time.June, 9, 20, 0, 0, 0), UTC(2024, time.June, 10, 3, 0, 0, 0), ny, 0},
+		{"nil zone uses checkin", UTC(2024, time.June, 9, 20, 0, 0, 0), UTC(2024, time.June, 10, 3, 0, 0, 0), nil, 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := NightsBetween(tt.checkin, tt.out, tt.loc); got != tt.want {
+				t.Errorf("NightsBetween() = %d, want %d", got, tt.want)
+			}
+		})
+	}
+
+	stay := NewPeriod(Date(2024, time.March, 8, 16, 0, 0, 0, ny), Date(2024, time.March, 11, 10, 0, 0, 0, ny))
+	if got := stay.Nights(); got != 3 {
+		t.Errorf("Period.Nights() = %d, want 3", got)
+	}
+}
@@ -0,0 +1,164 @@
+package chronogo
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Compact formats used in military and aviation logs. A date-time group (DTG) such as
+// "151430Z JUN 24" gives the day, time, time zone letter, month and year; a METAR or
+// TAF report time such as "151430Z" gives only the day and UTC time. Each has a
+// dedicated formatter and parser below. ISO 8601 basic format ("20240615T143000Z")
+// is written by ToISO8601BasicString and read by Parse.
+
+// dtgMonths are the month abbreviations used in date-time groups.
+var dtgMonths = [12]string{"JAN", "FEB", "MAR", "APR", "MAY", "JUN", "JUL", "AUG", "SEP", "OCT", "NOV", "DEC"}
+
+var (
+	dtgPattern   = regexp.MustCompile(`^(\d{2})(\d{2})(\d{2})([A-IK-Z])\s*([A-Z]{3})\s*(\d{2}|\d{4})$`)
+	metarPattern = regexp.MustCompile(`^(\d{2})(\d{2})(\d{2})Z$`)
+)
+
+// ToDTGString returns the datetime as a military date-time group in UTC ("Zulu"),
+// such as "151430Z JUN 24".
+//
+// Example:
+//
+//	chronogo.Date(2024, time.June, 15, 16, 30, 0, 0, berlin).ToDTGString() // "151430Z JUN 24"
+func (dt DateTime) ToDTGString() string {
+	u := dt.Time.UTC()
+	return fmt.Sprintf("%02d%02d%02dZ %s %02d", u.Day(), u.Hour(), u.Minute(), dtgMonths[u.Month()-1], u.Year()%100)
+}
+
+// ParseDTG parses a military date-time group such as "151430Z JUN 24". The spaces
+// are optional ("151430ZJUN24"), the year may have four digits, letter case is
+// ignored, and the zone letter may be any military time zone: Z is UTC, A-I and K-M
+// are UTC+1 to UTC+12, and N-Y are UTC-1 to UTC-12. The local-time letter J is not
+// supported. The result is in that zone's fixed offset (UTC for Z).
+//
+// Example:
+//
+//	dt, err := chronogo.ParseDTG("151430Z JUN 24") // 2024-06-15 14:30 UTC
+func ParseDTG(value string) (DateTime, error) {
+	m := dtgPattern.FindStringSubmatch(strings.ToUpper(strings.TrimSpace(value)))
+	if m == nil {
+		return DateTime{}, ParseError(value, fmt.Errorf("%w: expected a date-time group like \"151430Z JUN 24\"", ErrInvalidFormat))
+	}
+
+	month := 0
+	for i, name := range dtgMonths {
+		if name == m[5] {
+			month = i + 1
+		}
+	}
+	if month == 0 {
+		return DateTime{}, ParseError(value, fmt.Errorf("%w: unknown month %q", ErrInvalidFormat, m[5]))
+	}
+	year, _ := strconv.Atoi(m[6])
+	if len(m[6]) == 2 {
+		year += 2000
+	}
+	day, _ := strconv.Atoi(m[1])
+	hour, _ := strconv.Atoi(m[2])
+	minute, _ := strconv.Atoi(m[3])
+	if day < 1 || day > DaysInMonthOf(year, time.Month(month)) || hour > 23 || minute > 59 {
+		return DateTime{}, ParseError(value, fmt.Errorf("%w: %w: day or time", ErrInvalidFormat, ErrOutOfRange))
+	}
+
+	loc := militaryZone(m[4][0])
+	return Date(year, time.Month(month), day, hour, minute, 0, 0, loc), nil
+}
+
+// militaryZone returns the fixed location of a military time zone letter (not J).
+func militaryZone(letter byte) *time.Location {
+	var hours int
+	switch {
+	case letter == 'Z':
+		return time.UTC
+	case letter >= 'A' && letter <= 'I':
+		hours = int(letter-'A') + 1
+	case letter >= 'K' && letter <= 'M':
+		hours = int(letter-'K') + 10
+	default: // N-Y
+		hours = -(int(letter-'N') + 1)
+	}
+	return time.FixedZone(string(letter), hours*3600)
+}
+
+// ToMETARString returns the day of month and UTC time in the form used by METAR and
+// TAF weather reports, such as "151430Z".
+func (dt DateTime) ToMETARString() string {
+	u := dt.Time.UTC()
+	return fmt.Sprintf("%02d%02d%02dZ", u.Day(), u.Hour(), u.Minute())
+}
+
+// ParseMETARTime parses a METAR or TAF report time such as "151430Z". The report
+// omits the month and year, so they are taken from the occurrence of that day and
+// time closest to reference (typically the time the report was received, or Now()).
+// The result is in UTC.
+//
+// Example:
+//
+//	received := chronogo.UTC(2024, time.July, 1, 0, 10, 0, 0)
+//	chronogo.ParseMETARTime("302350Z", received) // 2024-06-30 23:50 UTC
+func ParseMETARTime(value string, reference DateTime) (DateTime, error) {
+	m := metarPattern.FindStringSubmatch(strings.ToUpper(strings.TrimSpace(value)))
+	if m == nil {
+		return DateTime{}, ParseError(value, fmt.Errorf("%w: expected a report time like \"151430Z\"", ErrInvalidFormat))
+	}
+	day, _ := strconv.Atoi(m[1])
+	hour, _ := strconv.Atoi(m[2])
+	minute, _ := strconv.Atoi(m[3])
+	if day < 1 || day > 31 || hour > 23 || minute > 59 {
+		return DateTime{}, ParseError(value, fmt.Errorf("%w: %w: day or time", ErrInvalidFormat, ErrOutOfRange))
+	}
+
+	// Try the reference month and its neighbors, keeping the closest valid date
+	ref := reference.Time.UTC()
+	var best time.Time
+	var bestDistance time.Duration = -1
+	for offset := -1; offset <= 1; offset++ {
+		first := time.Date(ref.Year(), ref.Month()+time.Month(offset), 1, 0, 0, 0, 0, time.UTC)
+		if day > DaysInMonthOf(first.Year(), first.Month()) {
+			continue
+		}
+		candidate := time.Date(first.Year(), first.Month(), day, hour, minute, 0, 0, time.UTC)
+		distance := candidate.Sub(ref)
+		if distance < 0 {
+			distance = -distance
+		}
+		if bestDistance < 0 || distance < bestDistance {
+			best, bestDistance = candidate, distance
+		}
+	}
+	if bestDistance < 0 {
+		return DateTime{}, ParseError(value, fmt.Errorf("%w: no month near the reference has day %d", ErrInvalidFormat, day))
+	}
+	return DateTime{best}, nil
+}
+
+// isoBasicLayouts are the ISO 8601 basic-format date-times accepted by Parse.
+var isoBasicLayouts = []string{
+	"20060102T150405.999999999Z0700",
+	"20060102T150405Z07",
+	"20060102T1504Z0700",
+	"20060102T150405",
+	"20060102T1504",
+}
+
+// parseISOBasic parses ISO 8601 basic format without separators, such as
+// "20240615T143000Z", "20240615T1430+0200" or "20240615T143000.5Z".
+func parseISOBasic(value string, loc *time.Location) (DateTime, bool) {
+	if len(value) < 13 || value[8] != 'T' || !isNumericOnly(value[:8]) {
+		return DateTime{}, false
+	}
+	for _, layout := range isoBasicLayouts {
+		if t, err := time.ParseInLocation(layout, value, loc); err == nil {
+			return DateTime{t}, true
+		}
+	}
+	return DateTime{}, false
+}
@@ -0,0 +1,80 @@
+package chronogo
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestDTG(t *testing.T) {
+	berlin, _ := time.LoadLocation("Europe/Berlin")
+	dt := Date(2024, time.June, 15, 16, 30, 0, 0, berlin)
+	if got := dt.ToDTGString(); got != "151430Z JUN 24" {
+		t.Errorf("ToDTGString() = %q", got)
+	}
+
+	want := UTC(2024, time.June, 15, 14, 30, 0, 0)
+	for _, input := range []string{"151430Z JUN 24", "151430zjun24", "151430Z JUN 2024", "151630B JUN 24", "151030Q JUN 24"} {
+		got, err := ParseDTG(input)
+		if err != nil || !got.Equal(want) {
+			t.Errorf("ParseDTG(%q) = %v, %v; want %v", input, got, err, want)
+		}
+	}
+
+	for _, input := range []string{"151430J JUN 24", "311430Z JUN 24", "152430Z JUN 24", "151430Z JUX 24", "151430Z"} {
+		if _, err := ParseDTG(input); !errors.Is(err, ErrInvalidFormat) {
+			t.Errorf("ParseDTG(%q) error = %v, want ErrInvalidFormat", input, err)
+		}
+	}
+}
+
+func TestMETARTime(t *testing.T) {
+	if got := UTC(2024, time.June, 15, 14, 30, 0, 0).ToMETARString(); got != "151430Z" {
+		t.Errorf("ToMETARString() = %q", got)
+	}
+
+	tests := []struct {
+		input     string
+		reference DateTime
+		want      DateTime
+	}{
+		{"151430Z", UTC(2024, time.June, 15, 14, 35, 0, 0), UTC(2024, time.June, 15, 14, 30, 0, 0)},
+		{"302350Z", UTC(2024, time.July, 1, 0, 10, 0, 0), UTC(2024, time.June, 30, 23, 50, 0, 0)},
+		{"010005Z", UTC(2024, time.December, 31, 23, 55, 0, 0), UTC(2025, time.January, 1, 0, 5, 0, 0)},
+		{"311200Z", UTC(2024, time.March, 1, 6, 0, 0, 0), UTC(2024, time.March, 31, 12, 0, 0, 0)},
+	}
+	for _, tt := range tests {
+		got, err := ParseMETARTime(tt.input, tt.reference)
+		if err != nil || !got.Equal(tt.want) {
+			t.Errorf("ParseMETARTime(%q) = %v, %v; want %v", tt.input, got, err, tt.want)
+		}
+	}
+
+	if _, err := ParseMETARTime("151430", Now()); !errors.Is(err, ErrInvalidFormat) {
+		t.Errorf("ParseMETARTime without Z error = %v", err)
+	}
+}
+
+func TestParseISOBasic(t *testing.T) {
+	tests := []struct {
+		input string
+		want  DateTime
+	}{
+		{"20240615T143000Z", UTC(2024, time.June, 15, 14, 30, 0, 0)},
+		{"20240615T1430Z", UTC(2024, time.June, 15, 14, 30, 0, 0)},
+		{"20240615T163000+0200", UTC(2024, time.June, 15, 14, 30, 0, 0)},
+		{"20240615T143000.5Z", UTC(2024, time.June, 15, 14, 30, 0, 500000000)},
+		{"20240615T143000", UTC(2024, time.June, 15, 14, 30, 0, 0)},
+	}
+	for _, tt := range tests {
+		got, err := Parse(tt.input)
+		if err != nil || !got.Equal(tt.want) {
+			t.Errorf("Parse(%q) = %v, %v; want %v", tt.input, got, err, tt.want)
+		}
+	}
+
+	dt := UTC(2024, time.June, 15, 14, 30, 0, 0)
+	if back, err := Parse(dt.ToISO8601BasicString()); err != nil || !back.Equal(dt) {
+		t.Errorf("round trip = %v, %v", back, err)
+	}
+}
@@ -2,10 +2,13 @@ package chronogo
 
 import (
 	"errors"
+	"fmt"
 	"math"
 	"regexp"
 	"strconv"
+	"strings"
 	"time"
+	"unicode"
 )
 
 // ParseISODuration parses an ISO 8601 duration string (e.g., "P1Y2M3DT4H5M6S", "PT15M", "P2W").
@@ -71,3 +74,173 @@ func ParseISODuration(s string) (ChronoDuration, error) {
 	d = time.Duration(math.Round(float64(d)))
 	return ChronoDuration{d}, nil
 }
+
+// humanDurationUnits maps common English unit spellings and abbreviations to their length.
+// Localized unit names are resolved from the registered locales' TimeUnits tables.
+var humanDurationUnits = map[string]time.Duration{
+	"ns": time.Nanosecond, "nanosecond": time.Nanosecond, "nanoseconds": time.Nanosecond,
+	"us": time.Microsecond, "µs": time.Microsecond, "microsecond": time.Microsecond, "microseconds": time.Microsecond,
+	"ms": time.Millisecond, "msec": time.Millisecond, "millisecond": time.Millisecond, "milliseconds": time.Millisecond,
+	"s": time.Second, "sec": time.Second, "secs": time.Second,
+	"m": time.Minute, "min": time.Minute, "mins": time.Minute,
+	"h": time.Hour, "hr": time.Hour, "hrs": time.Hour,
+	"d": 24 * time.Hour,
+	"w": 7 * 24 * time.Hour, "wk": 7 * 24 * time.Hour, "wks": 7 * 24 * time.Hour,
+	"mo": monthApprox, "mos": monthApprox,
+	"y": yearApprox, "yr": yearApprox, "yrs": yearApprox,
+}
+
+// humanDurationConjunctions are filler words allowed between components ("1 hour and 30 minutes").
+var humanDurationConjunctions = map[string]bool{
+	"and": true, "y": true, "et": true, "und": true, "e": true, "和": true, "と": true,
+}
+
+// localeUnitLengths maps the locale TimeUnits keys to their length.
+var localeUnitLengths = map[string]time.Duration{
+	"second": time.Second,
+	"minute": time.Minute,
+	"hour":   time.Hour,
+	"day":    24 * time.Hour,
+	"week":   7 * 24 * time.Hour,
+	"month":  monthApprox,
+	"year":   yearApprox,
+}
+
+const (
+	// monthApprox and yearApprox match the factors used by ChronoDuration.Months and Years.
+	monthApprox = time.Duration(30.44 * 24 * float64(time.Hour))
+	yearApprox  = time.Duration(365.25 * 24 * float64(time.Hour))
+)
+
+// ParseHumanDuration parses a human-written duration such as "1h 30m", "2 days",
+// "1.5 weeks", "90 min" or "1 hour and 30 minutes".
+//
+// Components may be written with or without spaces and are summed. Besides English
+// names and abbreviations, unit names from every registered locale are accepted
+// (e.g. "2 días", "3 Stunden", "2天"). Months and years are approximated using the
+// same factors as ChronoDuration (30.44 and 365.25 days). A leading minus sign
+// negates the whole duration. Totals beyond the range of time.Duration (about 292
+// years) return an error wrapping ErrOutOfRange.
+//
+// Examples:
+//
+//	d, _ := chronogo.ParseHumanDuration("1h 30m")     // 1h30m0s
+//	d, _ = chronogo.ParseHumanDuration("1.5 weeks")   // 252h0m0s
+//	d, _ = chronogo.ParseHumanDuration("2 jours")     // 48h0m0s
+func ParseHumanDuration(s string) (ChronoDuration, error) {
+	input := strings.TrimSpace(s)
+	if input == "" {
+		return ChronoDuration{}, humanDurationError(s, ErrEmptyString)
+	}
+
+	sign := 1.0
+	if input[0] == '-' || input[0] == '+' {
+		if input[0] == '-' {
+			sign = -1.0
+		}
+		input = strings.TrimSpace(input[1:])
+	}
+
+	runes := []rune(input)
+	total := 0.0
+	components := 0
+
+	for i := 0; i < len(runes); {
+		r := runes[i]
+		switch {
+		case unicode.IsSpace(r) || r == ',':
+			i++
+			continue
+		case unicode.IsLetter(r):
+			// Only conjunctions may appear where a number is expected
+			j := i
+			for j < len(runes) && unicode.IsLetter(runes[j]) {
+				j++
+			}
+			if !humanDurationConjunctions[strings.ToLower(string(runes[i:j]))] {
+				return ChronoDuration{}, humanDurationError(s, fmt.Errorf("unexpected %q", string(runes[i:j])))
+			}
+			i = j
+			continue
+		case !unicode.IsDigit(r) && r != '.':
+			return ChronoDuration{}, humanDurationError(s, fmt.Errorf("unexpected %q", string(r)))
+		}
+
+		j := i
+		for j < len(runes) && (unicode.IsDigit(runes[j]) || runes[j] == '.') {
+			j++
+		}
+		value, err := strconv.ParseFloat(string(runes[i:j]), 64)
+		if err != nil {
+			return ChronoDuration{}, humanDurationError(s, fmt.Errorf("invalid number %q", string(runes[i:j])))
+		}
+
+		for j < len(runes) && unicode.IsSpace(runes[j]) {
+			j++
+		}
+		k := j
+		for k < len(runes) && (unicode.IsLetter(runes[k]) || runes[k] == 'µ') {
+			k++
+		}
+		if k == j {
+			return ChronoDuration{}, humanDurationError(s, fmt.Errorf("missing unit after %q", string(runes[i:j])))
+		}
+
+		unit, ok := lookupHumanDurationUnit(string(runes[j:k]))
+		if !ok {
+			return ChronoDuration{}, humanDurationError(s, fmt.Errorf("unknown unit %q", string(runes[j:k])))
+		}
+
+		total += value * float64(unit)
+		components++
+		i = k
+	}
+
+	if components == 0 {
+		return ChronoDuration{}, humanDurationError(s, errors.New("no duration components"))
+	}
+
+	// time.Duration holds about ±292 years; converting anything larger wraps around
+	nanos := math.Round(sign * total)
+	if nanos >= math.MaxInt64 || nanos < math.MinInt64 {
+		return ChronoDuration{}, ParseError(s, fmt.Errorf("%w: %w: longer than about 292 years", ErrInvalidDuration, ErrOutOfRange))
+	}
+	return ChronoDuration{time.Duration(nanos)}, nil
+}
+
+// lookupHumanDurationUnit resolves a unit word using the English table first,
+// then the TimeUnits of every registered locale.
+func lookupHumanDurationUnit(word string) (time.Duration, bool) {
+	lower := strings.ToLower(word)
+	if d, ok := humanDurationUnits[lower]; ok {
+		return d, true
+	}
+
+	for _, code := range GetAvailableLocales() {
+		locale, err := GetLocale(code)
+		if err != nil {
+			continue
+		}
+		for key, length := range localeUnitLengths {
+			names, ok := locale.TimeUnits[key]
+			if !ok {
+				continue
+			}
+			if strings.EqualFold(names.Singular, word) || strings.EqualFold(names.Plural, word) {
+				return length, true
+			}
+		}
+	}
+
+	return 0, false
+}
+
+// humanDurationError builds the error returned by ParseHumanDuration.
+func humanDurationError(input string, err error) *ChronoError {
+	return &ChronoError{
+		Op:         "ParseHumanDuration",
+		Input:      input,
+		Err:        fmt.Errorf("%w: %v", ErrInvalidDuration, err),
+		Suggestion: "Use number and unit pairs like \"1h 30m\", \"2 days\" or \"90 min\"",
+	}
+}
@@ -8,6 +8,18 @@ import (
 
 // EnhancedBusinessDayCalculator wraps goholiday's optimized BusinessDayCalculator
 // to provide high-performance business day calculations with extensive holiday support.
+//
+// It is the recommended high-level business-day API: one value carries the country's
+// holidays and the weekend days (see SetCustomWeekends), and covers day arithmetic,
+// settlement dates and month, quarter and year boundaries. The DateTime methods such
+// as IsBusinessDay and AddBusinessDays remain for one-off checks with a HolidayChecker.
+//
+// Example:
+//
+//	calc := chronogo.NewEnhancedBusinessDayCalculator("US")
+//	settle := calc.SettlementDate(trade, 2)            // T+2
+//	payday := calc.LastBusinessDayOfMonth(chronogo.Today())
+//	third := calc.NthBusinessDayOfMonth(chronogo.Today(), 3)
 type EnhancedBusinessDayCalculator struct {
 	calculator *goholiday.BusinessDayCalculator
 	country    *goholiday.Country
@@ -64,6 +76,87 @@ func (ebc *EnhancedBusinessDayCalculator) IsEndOfMonth(dt DateTime) bool {
 	return ebc.calculator.IsEndOfMonth(dt.Time)
 }
 
+// IsStartOfMonth checks if a date is the first business day of the month.
+func (ebc *EnhancedBusinessDayCalculator) IsStartOfMonth(dt DateTime) bool {
+	return ebc.IsBusinessDay(dt) && ebc.PreviousBusinessDay(dt).Month() != dt.Month()
+}
+
+// SettlementDate returns the settlement date of a trade made on tradeDate under a
+// T+days convention, skipping weekends and holidays: SettlementDate(trade, 2) is
+// T+2. With days of 0 it returns tradeDate, or the next business day if tradeDate
+// is not one. The time of day is kept.
+//
+// Example:
+//
+//	trade := chronogo.Date(2024, time.July, 3, 0, 0, 0, 0, time.UTC) // Wednesday
+//	calc.SettlementDate(trade, 2) // 2024-07-08: skips July 4 and the weekend
+func (ebc *EnhancedBusinessDayCalculator) SettlementDate(tradeDate DateTime, days int) DateTime {
+	if days <= 0 {
+		if ebc.IsBusinessDay(tradeDate) {
+			return tradeDate
+		}
+		return ebc.NextBusinessDay(tradeDate)
+	}
+	return ebc.AddBusinessDays(tradeDate, days)
+}
+
+// NthBusinessDayOfMonth returns the nth business day of the month containing dt, at
+// midnight in dt's location. Negative n counts from the end of the month, so -1 is the
+// last business day. It returns the zero DateTime if the month has fewer than |n|
+// business days or n is 0.
+//
+// Example:
+//
+//	calc.NthBusinessDayOfMonth(chronogo.Date(2024, time.January, 15, 0, 0, 0, 0, time.UTC), 1)
+//	// 2024-01-02, since January 1 is a holiday
+func (ebc *EnhancedBusinessDayCalculator) NthBusinessDayOfMonth(dt DateTime, n int) DateTime {
+	if n == 0 {
+		return DateTime{}
+	}
+	step := 1
+	day := dt.StartOfMonth().StartOfDay()
+	if n < 0 {
+		step, n = -1, -n
+		day = Date(dt.Year(), dt.Month(), dt.DaysInMonth(), 0, 0, 0, 0, dt.Location())
+	}
+	for ; day.Month() == dt.Month(); day = day.AddDays(step) {
+		if ebc.IsBusinessDay(day) {
+			n--
+			if n == 0 {
+				return day
+			}
+		}
+	}
+	return DateTime{}
+}
+
+// LastBusinessDayOfMonth returns the last business day of the month containing dt, at
+// midnight in dt's location.
+func (ebc *EnhancedBusinessDayCalculator) LastBusinessDayOfMonth(dt DateTime) DateTime {
+	return ebc.lastBusinessDayOnOrBefore(dt.EndOfMonth())
+}
+
+// LastBusinessDayOfQuarter returns the last business day of the quarter containing dt,
+// at midnight in dt's location.
+func (ebc *EnhancedBusinessDayCalculator) LastBusinessDayOfQuarter(dt DateTime) DateTime {
+	return ebc.lastBusinessDayOnOrBefore(dt.EndOfQuarter())
+}
+
+// LastBusinessDayOfYear returns the last business day of the year containing dt, at
+// midnight in dt's location.
+func (ebc *EnhancedBusinessDayCalculator) LastBusinessDayOfYear(dt DateTime) DateTime {
+	return ebc.lastBusinessDayOnOrBefore(dt.EndOfYear())
+}
+
+// lastBusinessDayOnOrBefore walks back from dt's calendar day to a business day.
+func (ebc *EnhancedBusinessDayCalculator) lastBusinessDayOnOrBefore(dt DateTime) DateTime {
+	day := dt.StartOfDay()
+	for !ebc.IsBusinessDay(day) {
+		day = day.AddDays(-1)
+	}
+	return day
+}
+
 // Convenience methods for DateTime that use enhanced calculator
 func (dt DateTime) WithEnhancedBusinessDays(countryCode string) *EnhancedBusinessDayCalculator {
 	return NewEnhancedBusinessDayCalculator(countryCode)
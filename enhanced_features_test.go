@@ -201,3 +201,55 @@ func TestDateTimeConvenienceMethods(t *testing.T) {
 		t.Errorf("Expected at least 2 upcoming holidays, got %d", len(upcoming))
 	}
 }
+
+func TestEnhancedBusinessDayCalculatorSettlement(t *testing.T) {
+	calc := NewEnhancedBusinessDayCalculator("US")
+
+	trade := Date(2024, time.July, 3, 15, 0, 0, 0, time.UTC) // Wednesday before July 4
+	if got := calc.SettlementDate(trade, 2); !got.Equal(Date(2024, time.July, 8, 15, 0, 0, 0, time.UTC)) {
+		t.Errorf("SettlementDate(T+2) = %v, want Monday July 8", got)
+	}
+	if got := calc.SettlementDate(trade, 0); !got.Equal(trade) {
+		t.Errorf("SettlementDate(T+0) on a business day = %v, want the trade date", got)
+	}
+	saturday := Date(2024, time.July, 6, 0, 0, 0, 0, time.UTC)
+	if got := calc.SettlementDate(saturday, 0); !got.Equal(Date(2024, time.July, 8, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("SettlementDate(T+0) on a weekend = %v, want Monday", got)
+	}
+}
+
+func TestEnhancedBusinessDayCalculatorMonthBoundaries(t *testing.T) {
+	calc := NewEnhancedBusinessDayCalculator("US")
+	jan := Date(2024, time.January, 15, 10, 0, 0, 0, time.UTC)
+	feb := Date(2024, time.February, 10, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name string
+		got  DateTime
+		want DateTime
+	}{
+		{"first business day skips New Year", calc.NthBusinessDayOfMonth(jan, 1), Date(2024, time.January, 2, 0, 0, 0, 0, time.UTC)},
+		{"third business day", calc.NthBusinessDayOfMonth(jan, 3), Date(2024, time.January, 4, 0, 0, 0, 0, time.UTC)},
+		{"last business day via -1", calc.NthBusinessDayOfMonth(jan, -1), Date(2024, time.January, 31, 0, 0, 0, 0, time.UTC)},
+		{"20th business day of February", calc.NthBusinessDayOfMonth(feb, 20), Date(2024, time.February, 29, 0, 0, 0, 0, time.UTC)},
+		{"month too short", calc.NthBusinessDayOfMonth(feb, 21), DateTime{}},
+		{"n of zero", calc.NthBusinessDayOfMonth(feb, 0), DateTime{}},
+		{"last of month ending on a weekend", calc.LastBusinessDayOfMonth(Date(2024, time.June, 5, 0, 0, 0, 0, time.UTC)), Date(2024, time.June, 28, 0, 0, 0, 0, time.UTC)},
+		{"last of quarter", calc.LastBusinessDayOfQuarter(Date(2024, time.February, 5, 0, 0, 0, 0, time.UTC)), Date(2024, time.March, 29, 0, 0, 0, 0, time.UTC)},
+		{"last of year", calc.LastBusinessDayOfYear(jan), Date(2024, time.December, 31, 0, 0, 0, 0, time.UTC)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if !tt.got.Equal(tt.want) {
+				t.Errorf("got %v, want %v", tt.got, tt.want)
+			}
+		})
+	}
+
+	if !calc.IsStartOfMonth(Date(2024, time.January, 2, 0, 0, 0, 0, time.UTC)) {
+		t.Error("January 2 2024 should be the first business day of the month")
+	}
+	if calc.IsStartOfMonth(Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC)) || calc.IsStartOfMonth(Date(2024, time.January, 3, 0, 0, 0, 0, time.UTC)) {
+		t.Error("only the first business day should be the start of the month")
+	}
+}
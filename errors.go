@@ -63,13 +63,63 @@ func (e *ChronoError) Is(target error) bool {
 	return errors.Is(e.Err, target)
 }
 
-// Common error variables for easier error checking
+// FormatMismatchError reports where a value stopped matching a token format in
+// FromFormatTokens. It matches ErrInvalidFormat and unwraps to the time package's
+// parse error.
+type FormatMismatchError struct {
+	Value    string // the value being parsed
+	Position int    // character offset in Value where matching failed
+	Expected string // token or quoted literal expected at Position, e.g. "MM" or "/"
+	Reason   string // extra detail such as "month out of range", if any
+	Err      error  // underlying error
+}
+
+// Error implements the error interface.
+func (e *FormatMismatchError) Error() string {
+	msg := fmt.Sprintf("%s: %q at position %d: expected %s", ErrInvalidFormat, e.Value, e.Position, e.Expected)
+	if e.Reason != "" {
+		msg += " (" + e.Reason + ")"
+	}
+	return msg
+}
+
+// Unwrap returns ErrInvalidFormat and the underlying error.
+func (e *FormatMismatchError) Unwrap() []error {
+	return []error{ErrInvalidFormat, e.Err}
+}
+
+// Common error variables for easier error checking. Errors returned by chronogo
+// wrap one of these, usually inside a *ChronoError that carries the input and a
+// suggestion, so callers can test them with errors.Is and extract the details with
+// errors.As instead of matching on message text.
+//
+// Example:
+//
+//	_, err := chronogo.LoadLocation("Mars/Olympus_Mons")
+//	if errors.Is(err, chronogo.ErrUnknownTimezone) {
+//	    var ce *chronogo.ChronoError
+//	    if errors.As(err, &ce) {
+//	        fmt.Println(ce.Suggestion)
+//	    }
+//	}
 var (
 	ErrInvalidFormat    = errors.New("invalid datetime format")
 	ErrInvalidTimezone  = errors.New("invalid timezone")
 	ErrInvalidDuration  = errors.New("invalid duration")
 	ErrInvalidRange     = errors.New("invalid range")
 	ErrInvalidOperation = errors.New("invalid operation")
+
+	// ErrUnknownTimezone reports a zone name missing from the time zone database.
+	// It also matches ErrInvalidTimezone.
+	ErrUnknownTimezone = fmt.Errorf("%w: unknown time zone", ErrInvalidTimezone)
+	// ErrOutOfRange reports a date or time component outside its valid range, such
+	// as day 32, week 54 or hour 24. It also matches ErrInvalidRange.
+	ErrOutOfRange = fmt.Errorf("%w: value out of range", ErrInvalidRange)
+	// ErrNonexistentTime reports a wall-clock time skipped by a DST transition.
+	ErrNonexistentTime = errors.New("nonexistent local time")
+	// ErrAmbiguousTime reports a wall-clock time that occurs twice because of a DST
+	// transition.
+	ErrAmbiguousTime = errors.New("ambiguous local time")
 )
 
 // ParseError creates a ChronoError for parsing operations.
@@ -265,7 +315,7 @@ func (dt DateTime) Validate() error {
 		return &ChronoError{
 			Op:         "Validate",
 			Path:       fmt.Sprintf("year=%d", year),
-			Err:        errors.New("year out of reasonable range"),
+			Err:        fmt.Errorf("%w: year %d", ErrOutOfRange, year),
 			Suggestion: "Use years between 1 and 9999",
 		}
 	}
@@ -418,3 +418,70 @@ func TestMustFromFormat(t *testing.T) {
 	}()
 	MustFromFormat("invalid-date", "invalid-format")
 }
+
+func TestErrorTaxonomy(t *testing.T) {
+	ny, _ := time.LoadLocation("America/New_York")
+
+	_, err := LoadLocation("Mars/Olympus_Mons")
+	if !errors.Is(err, ErrUnknownTimezone) || !errors.Is(err, ErrInvalidTimezone) {
+		t.Errorf("LoadLocation error = %v, want ErrUnknownTimezone", err)
+	}
+	var ce *ChronoError
+	if !errors.As(err, &ce) || ce.Path != "Mars/Olympus_Mons" {
+		t.Errorf("errors.As(LoadLocation error) = %v", ce)
+	}
+	if !strings.Contains(err.Error(), "unknown time zone Mars/Olympus_Mons") {
+		t.Errorf("LoadLocation error = %v, want the time package error wrapped", err)
+	}
+
+	// A malformed name is invalid rather than unknown, and keeps the original error
+	_, stdErr := time.LoadLocation("../etc/zone")
+	_, err = LoadLocation("../etc/zone")
+	if !errors.Is(err, ErrInvalidTimezone) || errors.Is(err, ErrUnknownTimezone) || !errors.Is(err, stdErr) {
+		t.Errorf("LoadLocation(malformed) error = %v, want ErrInvalidTimezone wrapping %v", err, stdErr)
+	}
+
+	_, err = FromOrdinal(2023, 366, time.UTC)
+	if !errors.Is(err, ErrOutOfRange) || !errors.Is(err, ErrInvalidRange) {
+		t.Errorf("FromOrdinal error = %v, want ErrOutOfRange", err)
+	}
+	if _, err := ParseDTG("311430Z JUN 24"); !errors.Is(err, ErrOutOfRange) || !errors.Is(err, ErrInvalidFormat) {
+		t.Errorf("ParseDTG error = %v, want ErrOutOfRange and ErrInvalidFormat", err)
+	}
+
+	if _, err := DateStrict(2024, time.March, 10, 2, 30, 0, 0, ny); !errors.Is(err, ErrNonexistentTime) {
+		t.Errorf("DateStrict in gap error = %v, want ErrNonexistentTime", err)
+	}
+	if _, err := DateStrict(2024, time.November, 3, 1, 30, 0, 0, ny); !errors.Is(err, ErrAmbiguousTime) {
+		t.Errorf("DateStrict in overlap error = %v, want ErrAmbiguousTime", err)
+	}
+	dt, err := DateStrict(2024, time.November, 3, 3, 30, 0, 0, ny)
+	if err != nil || dt.Hour() != 3 {
+		t.Errorf("DateStrict(03:30) = %v, %v", dt, err)
+	}
+}
+
+func TestIsAmbiguous(t *testing.T) {
+	ny, _ := time.LoadLocation("America/New_York")
+	syd, _ := time.LoadLocation("Australia/Sydney")
+	tests := []struct {
+		name      string
+		day, hour int
+		month     time.Month
+		loc       *time.Location
+		want      bool
+	}{
+		{"NY fall back", 3, 1, time.November, ny, true},
+		{"NY after overlap", 3, 2, time.November, ny, false},
+		{"NY spring gap", 10, 2, time.March, ny, false},
+		{"Sydney fall back", 7, 2, time.April, syd, true},
+		{"UTC", 3, 1, time.November, time.UTC, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := IsAmbiguous(2024, tt.month, tt.day, tt.hour, 30, 0, 0, tt.loc); got != tt.want {
+				t.Errorf("IsAmbiguous() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -1,6 +1,9 @@
 package chronogo
 
 import (
+	"fmt"
+	"strconv"
+	"strings"
 	"time"
 )
 
@@ -42,6 +45,12 @@ func (fd *FluentDuration) Months(months int) *FluentDuration {
 	return fd
 }
 
+// Quarters adds the specified number of quarters (3 months each) to the duration.
+func (fd *FluentDuration) Quarters(quarters int) *FluentDuration {
+	fd.months += quarters * 3
+	return fd
+}
+
 // Weeks adds the specified number of weeks to the duration.
 func (fd *FluentDuration) Weeks(weeks int) *FluentDuration {
 	fd.duration += time.Duration(weeks) * 7 * 24 * time.Hour
@@ -142,6 +151,12 @@ func (fdt *FluentDateTime) Second(second int) *FluentDateTime {
 	return fdt
 }
 
+// Nanosecond sets the nanosecond component.
+func (fdt *FluentDateTime) Nanosecond(nanosecond int) *FluentDateTime {
+	fdt.base = fdt.base.SetNanosecond(nanosecond)
+	return fdt
+}
+
 // Timezone sets the timezone.
 func (fdt *FluentDateTime) Timezone(loc *time.Location) *FluentDateTime {
 	fdt.base = fdt.base.In(loc)
@@ -243,3 +258,92 @@ func (cd ChronoDuration) Abs() ChronoDuration {
 	}
 	return cd
 }
+
+// Round returns the duration rounded to the nearest multiple of unit (e.g. time.Second).
+// Halfway values are rounded away from zero. If unit <= 0 the duration is returned unchanged.
+func (cd ChronoDuration) Round(unit time.Duration) ChronoDuration {
+	return ChronoDuration{cd.Duration.Round(unit)}
+}
+
+// Truncate returns the duration truncated toward zero to a multiple of unit (e.g. time.Minute).
+// If unit <= 0 the duration is returned unchanged.
+func (cd ChronoDuration) Truncate(unit time.Duration) ChronoDuration {
+	return ChronoDuration{cd.Duration.Truncate(unit)}
+}
+
+// FormatClock returns the duration in clock style "HH:MM:SS" (e.g. "01:30:05").
+// Hours are not wrapped at 24, sub-second precision is truncated and negative
+// durations are prefixed with "-".
+func (cd ChronoDuration) FormatClock() string {
+	sign, hours, minutes, seconds, _ := cd.clockComponents()
+	return fmt.Sprintf("%s%02d:%02d:%02d", sign, hours, minutes, seconds)
+}
+
+// FormatClockMillis returns the duration in clock style with milliseconds
+// "HH:MM:SS.mmm" (e.g. "00:01:30.500"), as used by timers and subtitle formats.
+func (cd ChronoDuration) FormatClockMillis() string {
+	sign, hours, minutes, seconds, nanos := cd.clockComponents()
+	return fmt.Sprintf("%s%02d:%02d:%02d.%03d", sign, hours, minutes, seconds, nanos/int64(time.Millisecond))
+}
+
+// clockComponents splits the absolute duration into hours, minutes, seconds and nanoseconds.
+func (cd ChronoDuration) clockComponents() (sign string, hours, minutes, seconds, nanos int64) {
+	d := cd.Duration
+	if d < 0 {
+		sign = "-"
+		d = -d
+	}
+	hours = int64(d / time.Hour)
+	d -= time.Duration(hours) * time.Hour
+	minutes = int64(d / time.Minute)
+	d -= time.Duration(minutes) * time.Minute
+	seconds = int64(d / time.Second)
+	d -= time.Duration(seconds) * time.Second
+	return sign, hours, minutes, seconds, int64(d)
+}
+
+// FormatISO returns the duration as an ISO 8601 duration string (e.g. "PT1H30M5S").
+// Whole days are emitted with the D designator ("P1DT2H"); years and months are
+// never emitted because a ChronoDuration has no calendar context. Fractional
+// seconds are kept ("PT1.5S") and negative durations are prefixed with "-".
+// The output round-trips through ParseISODuration.
+func (cd ChronoDuration) FormatISO() string {
+	d := cd.Duration
+	if d == 0 {
+		return "PT0S"
+	}
+
+	var b strings.Builder
+	if d < 0 {
+		b.WriteByte('-')
+		d = -d
+	}
+	b.WriteByte('P')
+
+	days := d / (24 * time.Hour)
+	d -= days * 24 * time.Hour
+	if days > 0 {
+		fmt.Fprintf(&b, "%dD", days)
+	}
+	if d == 0 {
+		return b.String()
+	}
+
+	b.WriteByte('T')
+	hours := d / time.Hour
+	d -= hours * time.Hour
+	minutes := d / time.Minute
+	d -= minutes * time.Minute
+	if hours > 0 {
+		fmt.Fprintf(&b, "%dH", hours)
+	}
+	if minutes > 0 {
+		fmt.Fprintf(&b, "%dM", minutes)
+	}
+	if d > 0 {
+		secs := strconv.FormatFloat(d.Seconds(), 'f', -1, 64)
+		b.WriteString(secs)
+		b.WriteByte('S')
+	}
+	return b.String()
+}
@@ -1,6 +1,7 @@
 package chronogo
 
 import (
+	"errors"
 	"strings"
 	"testing"
 	"time"
@@ -157,6 +158,59 @@ func TestParseISODuration(t *testing.T) {
 	}
 }
 
+func TestParseHumanDuration(t *testing.T) {
+	tests := []struct {
+		in     string
+		expect time.Duration
+	}{
+		{"1h 30m", 90 * time.Minute},
+		{"1h30m", 90 * time.Minute},
+		{"2 days", 48 * time.Hour},
+		{"1.5 weeks", 252 * time.Hour},
+		{"90 min", 90 * time.Minute},
+		{"1 hour and 30 minutes", 90 * time.Minute},
+		{"250ms", 250 * time.Millisecond},
+		{"-45s", -45 * time.Second},
+		{"1 day, 2 hrs", 26 * time.Hour},
+		{"2 días y 3 horas", 51 * time.Hour},
+		{"3 Stunden", 3 * time.Hour},
+		{"2 jours", 48 * time.Hour},
+		{"2天3小时", 51 * time.Hour},
+		{"5分", 5 * time.Minute},
+	}
+	for _, tt := range tests {
+		d, err := ParseHumanDuration(tt.in)
+		if err != nil {
+			t.Errorf("ParseHumanDuration(%q) error: %v", tt.in, err)
+			continue
+		}
+		if d.Duration != tt.expect {
+			t.Errorf("ParseHumanDuration(%q) = %v, want %v", tt.in, d.Duration, tt.expect)
+		}
+	}
+
+	for _, in := range []string{"", "abc", "5", "3 fortnights", "1h ~ 2m"} {
+		_, err := ParseHumanDuration(in)
+		if err == nil {
+			t.Errorf("ParseHumanDuration(%q) expected error", in)
+			continue
+		}
+		if !errors.Is(err, ErrInvalidDuration) {
+			t.Errorf("ParseHumanDuration(%q) error should wrap ErrInvalidDuration, got %v", in, err)
+		}
+	}
+
+	for _, in := range []string{"300 years", "-300 years", "999999999999 hours", "200 years 100 years"} {
+		d, err := ParseHumanDuration(in)
+		if !errors.Is(err, ErrOutOfRange) {
+			t.Errorf("ParseHumanDuration(%q) = %v, %v, want ErrOutOfRange", in, d, err)
+		}
+	}
+	if d, err := ParseHumanDuration("290 years"); err != nil || d.Duration <= 0 {
+		t.Errorf("ParseHumanDuration(290 years) = %v, %v", d, err)
+	}
+}
+
 func TestChronoDurationFromComponents(t *testing.T) {
 	d := NewDurationFromComponents(2, 30, 45)
 	expected := 2*time.Hour + 30*time.Minute + 45*time.Second
@@ -300,3 +354,52 @@ func TestChronoDurationMissingMethods(t *testing.T) {
 		t.Errorf("HumanString() = %s, expected to contain 'day'", human)
 	}
 }
+
+func TestChronoDurationFormatting(t *testing.T) {
+	d := NewDuration(time.Hour + 30*time.Minute + 5*time.Second + 250*time.Millisecond)
+
+	if got := d.FormatClock(); got != "01:30:05" {
+		t.Errorf("FormatClock() = %q, want %q", got, "01:30:05")
+	}
+	if got := d.FormatClockMillis(); got != "01:30:05.250" {
+		t.Errorf("FormatClockMillis() = %q, want %q", got, "01:30:05.250")
+	}
+	if got := NewDuration(-26 * time.Hour).FormatClock(); got != "-26:00:00" {
+		t.Errorf("FormatClock() negative = %q, want %q", got, "-26:00:00")
+	}
+
+	isoTests := []struct {
+		in     time.Duration
+		expect string
+	}{
+		{0, "PT0S"},
+		{time.Hour + 30*time.Minute + 5*time.Second, "PT1H30M5S"},
+		{26 * time.Hour, "P1DT2H"},
+		{48 * time.Hour, "P2D"},
+		{1500 * time.Millisecond, "PT1.5S"},
+		{-45 * time.Minute, "-PT45M"},
+	}
+	for _, tt := range isoTests {
+		got := NewDuration(tt.in).FormatISO()
+		if got != tt.expect {
+			t.Errorf("FormatISO(%v) = %q, want %q", tt.in, got, tt.expect)
+		}
+		back, err := ParseISODuration(got)
+		if err != nil || back.Duration != tt.in {
+			t.Errorf("ParseISODuration(%q) = %v, %v; want %v", got, back.Duration, err, tt.in)
+		}
+	}
+
+	if got := d.Round(time.Second).Duration; got != time.Hour+30*time.Minute+5*time.Second {
+		t.Errorf("Round(time.Second) = %v", got)
+	}
+	if got := d.Round(time.Minute).Duration; got != time.Hour+30*time.Minute {
+		t.Errorf("Round(time.Minute) = %v", got)
+	}
+	if got := NewDuration(90 * time.Second).Round(time.Minute).Duration; got != 2*time.Minute {
+		t.Errorf("Round(time.Minute) on 90s = %v, want 2m", got)
+	}
+	if got := d.Truncate(time.Hour).Duration; got != time.Hour {
+		t.Errorf("Truncate(time.Hour) = %v, want 1h", got)
+	}
+}
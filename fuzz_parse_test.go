@@ -3,7 +3,10 @@
 
 package chronogo
 
-import "testing"
+import (
+	"testing"
+	"time"
+)
 
 // FuzzParse fuzzes the Parse function to ensure it doesn't panic on random inputs.
 func FuzzParse(f *testing.F) {
@@ -26,3 +29,67 @@ func FuzzParse(f *testing.F) {
 		_, _ = Parse(s)
 	})
 }
+
+// FuzzParseStrict fuzzes ParseStrict, which only accepts technical formats.
+func FuzzParseStrict(f *testing.F) {
+	seeds := []string{
+		"2023-12-25T15:30:45Z",
+		"2023-12-25T15:30:45.123456789+05:30",
+		"2024-W01-1",
+		"2024-W00-1",
+		"2024-366",
+		"2024-999999999999",
+		"1640995200",
+		"99999999999999999999",
+		"\xff\xfe",
+		"",
+	}
+	for _, s := range seeds {
+		f.Add(s)
+	}
+	f.Fuzz(func(t *testing.T, s string) {
+		_, _ = ParseStrict(s)
+	})
+}
+
+// FuzzParseInterval fuzzes ISO 8601 interval parsing.
+func FuzzParseInterval(f *testing.F) {
+	seeds := []string{
+		"2024-01-01/2024-12-31",
+		"2024-01-01T00:00:00Z/P1Y2M3DT4H5M6S",
+		"P1D/2024-01-01",
+		"P99999999999999999999Y/2024-01-01",
+		"2024-01-01/P0D",
+		"/",
+		"P/P",
+		"",
+	}
+	for _, s := range seeds {
+		f.Add(s)
+	}
+	f.Fuzz(func(t *testing.T, s string) {
+		_, _ = parseInterval(s, time.UTC)
+	})
+}
+
+// FuzzFromFormatTokens fuzzes token-format conversion and parsing, including the
+// ISO week-date parser.
+func FuzzFromFormatTokens(f *testing.F) {
+	seeds := []struct{ value, format string }{
+		{"2024-01-15 14:30:00", "YYYY-MM-DD HH:mm:ss"},
+		{"2024-W03-1", "GGGG-[W]WW-E"},
+		{"2024-W00-1", "GGGG-[W]WW-E"},
+		{"2024-W99-7", "GGGG-[W]WW-E"},
+		{"January 15th", "MMMM Do"},
+		{"x", "[unterminated"},
+		{"\xff", "\xff"},
+		{"", ""},
+	}
+	for _, s := range seeds {
+		f.Add(s.value, s.format)
+	}
+	f.Fuzz(func(t *testing.T, value, format string) {
+		_ = convertTokenFormat(format)
+		_, _ = FromFormatTokens(value, format)
+	})
+}
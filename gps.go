@@ -0,0 +1,50 @@
+package chronogo
+
+import (
+	"math"
+	"time"
+)
+
+// GPS time counts SI seconds from the GPS epoch, 1980-01-06 00:00:00 UTC, without
+// leap seconds, and is usually expressed as a week number and seconds into the week.
+// It runs a constant 19 seconds behind TAI, so it drifts ahead of UTC by one second
+// per leap second: 18 seconds since 2017.
+
+// gpsEpoch is the GPS epoch, when GPS time equalled UTC.
+var gpsEpoch = time.Date(1980, time.January, 6, 0, 0, 0, 0, time.UTC)
+
+const (
+	gpsTAIOffset = 19 * time.Second
+	gpsWeek      = 7 * 24 * time.Hour
+)
+
+// FromGPSTime converts a GPS week number and seconds into the week to a UTC DateTime,
+// applying the GPS−UTC leap second offset. Week numbers are full (not modulo 1024);
+// seconds may exceed a week and are carried over.
+//
+// Example:
+//
+//	chronogo.FromGPSTime(2296, 86418) // 2024-01-08 00:00:00 UTC
+func FromGPSTime(week int, seconds float64) DateTime {
+	whole, frac := math.Modf(seconds)
+	gps := gpsEpoch.Add(time.Duration(week)*gpsWeek +
+		time.Duration(whole)*time.Second + time.Duration(math.Round(frac*1e9)))
+	return FromTAI(DateTime{gps.Add(gpsTAIOffset)})
+}
+
+// ToGPSTime returns the GPS week number and seconds into the week of dt. Instants
+// before the GPS epoch give negative week numbers.
+//
+// Example:
+//
+//	week, sec := chronogo.Date(2024, 1, 8, 0, 0, 0, 0, time.UTC).ToGPSTime() // 2296, 86418
+func (dt DateTime) ToGPSTime() (week int, seconds float64) {
+	elapsed := dt.ToTAI().Time.Add(-gpsTAIOffset).Sub(gpsEpoch)
+	w := elapsed / gpsWeek
+	rem := elapsed % gpsWeek
+	if rem < 0 {
+		w--
+		rem += gpsWeek
+	}
+	return int(w), rem.Seconds()
+}
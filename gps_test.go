@@ -0,0 +1,40 @@
+package chronogo
+
+import (
+	"math"
+	"testing"
+	"time"
+)
+
+func TestGPSTime(t *testing.T) {
+	tests := []struct {
+		dt      DateTime
+		week    int
+		seconds float64
+	}{
+		{Date(1980, time.January, 6, 0, 0, 0, 0, time.UTC), 0, 0},
+		// GPS−UTC was 13 seconds in 1999 and is 18 seconds since 2017
+		{Date(1999, time.August, 22, 0, 0, 0, 0, time.UTC), 1024, 13},
+		{Date(2024, time.January, 8, 0, 0, 0, 0, time.UTC), 2296, 86418},
+		{Date(2024, time.January, 13, 23, 59, 50, 500000000, time.UTC), 2297, 8.5},
+		{Date(1980, time.January, 5, 0, 0, 0, 0, time.UTC), -1, 6 * 86400},
+	}
+	for _, tt := range tests {
+		week, seconds := tt.dt.ToGPSTime()
+		if week != tt.week || math.Abs(seconds-tt.seconds) > 1e-9 {
+			t.Errorf("ToGPSTime(%v) = %d, %v; want %d, %v", tt.dt, week, seconds, tt.week, tt.seconds)
+		}
+		if got := FromGPSTime(tt.week, tt.seconds); !got.Equal(tt.dt) {
+			t.Errorf("FromGPSTime(%d, %v) = %v, want %v", tt.week, tt.seconds, got, tt.dt)
+		}
+	}
+
+	// Seconds beyond a week carry into the next one
+	if got := FromGPSTime(2295, 7*86400+86418); !got.Equal(Date(2024, time.January, 8, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("FromGPSTime() with carried seconds = %v", got)
+	}
+	dt := Date(2024, time.March, 5, 18, 30, 15, 123456789, time.UTC)
+	if got := FromGPSTime(dt.ToGPSTime()); !got.Equal(dt) || got.Location() != time.UTC {
+		t.Errorf("FromGPSTime(ToGPSTime()) = %v, want %v", got, dt)
+	}
+}
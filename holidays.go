@@ -0,0 +1,162 @@
+package chronogo
+
+// maxHolidaySearchDays bounds the scan for upcoming holidays. Every supported country
+// has a holiday each year, so two years is enough unless a category filter or a custom
+// checker excludes them all.
+const maxHolidaySearchDays = 2 * 366
+
+// NextHoliday returns the first holiday after the calendar day of after, at midnight
+// in after's location, and its name. It returns the zero DateTime and an empty name
+// if there is no holiday within two years.
+//
+// Example:
+//
+//	date, name := chronogo.NewGoHolidayChecker("US").NextHoliday(chronogo.Today())
+//	fmt.Printf("Next holiday: %s on %s\n", name, date.Format("Jan 2"))
+func (ghc *GoHolidayChecker) NextHoliday(after DateTime) (DateTime, string) {
+	holidays := nextHolidays(ghc, after, 1)
+	if len(holidays) == 0 {
+		return DateTime{}, ""
+	}
+	return holidays[0].Date, holidays[0].Name
+}
+
+// NextNHolidays returns up to n holidays after the calendar day of after, in date
+// order. Fewer are returned if the two-year search window runs out.
+//
+// Example:
+//
+//	for _, h := range checker.NextNHolidays(chronogo.Today(), 3) {
+//	    fmt.Println(h.Date.ToDateString(), h.Name)
+//	}
+func (ghc *GoHolidayChecker) NextNHolidays(after DateTime, n int) []HolidayInfo {
+	return nextHolidays(ghc, after, n)
+}
+
+// NextHoliday returns the first holiday after this date, or false if there is none
+// within two years. If no holiday checker is provided, it uses the default holiday
+// checker.
+func (dt DateTime) NextHoliday(holidayChecker ...HolidayChecker) (*HolidayInfo, bool) {
+	holidays := nextHolidays(holidayCheckerOrDefault(holidayChecker), dt, 1)
+	if len(holidays) == 0 {
+		return nil, false
+	}
+	return &holidays[0], true
+}
+
+// UntilNextHoliday returns the time from dt to the start of the next holiday after
+// dt's calendar day, for "office closed in 3 days" banners. The Diff is zero if there
+// is no holiday within two years. If no holiday checker is provided, it uses the
+// default holiday checker.
+//
+// Example:
+//
+//	days := chronogo.Today().UntilNextHoliday(checker).Days()
+//	fmt.Printf("Office closed in %d days\n", days)
+func (dt DateTime) UntilNextHoliday(holidayChecker ...HolidayChecker) Diff {
+	next, ok := dt.NextHoliday(holidayChecker...)
+	if !ok {
+		return Diff{}
+	}
+	return next.Date.Diff(dt)
+}
+
+// nextHolidays scans forward from the day after after for up to n holidays.
+func nextHolidays(checker HolidayChecker, after DateTime, n int) []HolidayInfo {
+	if n <= 0 {
+		return nil
+	}
+	holidays := make([]HolidayInfo, 0, n)
+	day := after.StartOfDay()
+	for i := 0; i < maxHolidaySearchDays && len(holidays) < n; i++ {
+		day = day.AddDays(1)
+		if info, ok := day.GetHoliday(checker); ok {
+			holidays = append(holidays, *info)
+		}
+	}
+	return holidays
+}
+
+// holidayCheckerOrDefault returns the first checker, or the default checker.
+func holidayCheckerOrDefault(holidayChecker []HolidayChecker) HolidayChecker {
+	if len(holidayChecker) > 0 && holidayChecker[0] != nil {
+		return holidayChecker[0]
+	}
+	return defaultHolidayChecker()
+}
+
+// LongWeekends returns the long weekends of a year: runs of three or more consecutive
+// days off that combine a weekend with at least one adjacent holiday, such as a Friday
+// holiday followed by Saturday and Sunday. Each Period covers whole days in UTC, from
+// the start of the first day to the end of the last, and runs are included when they
+// start in year. If no holiday checker is provided, it uses the default holiday
+// checker.
+//
+// Example:
+//
+//	for _, p := range chronogo.LongWeekends(2024, chronogo.NewGoHolidayChecker("US")) {
+//	    fmt.Println(p.Start.ToDateString(), p.End.ToDateString())
+//	}
+func LongWeekends(year int, holidayChecker ...HolidayChecker) []Period {
+	checker := holidayCheckerOrDefault(holidayChecker)
+
+	var weekends []Period
+	var start DateTime
+	length, hasWeekend, hasHoliday := 0, false, false
+	flush := func(last DateTime) {
+		if length >= 3 && hasWeekend && hasHoliday && start.Year() == year {
+			weekends = append(weekends, Period{Start: start, End: last.EndOfDay()})
+		}
+		length, hasWeekend, hasHoliday = 0, false, false
+	}
+
+	// Scan a little either side of the year so runs crossing New Year are complete
+	first := UTC(year-1, 12, 20, 0, 0, 0, 0)
+	end := UTC(year+1, 1, 15, 0, 0, 0, 0)
+	for day := first; day.Before(end); day = day.AddDays(1) {
+		weekend, holiday := day.IsWeekend(), checker.IsHoliday(day)
+		if !weekend && !holiday {
+			flush(day.AddDays(-1))
+			if day.Year() > year {
+				return weekends
+			}
+			continue
+		}
+		if length == 0 {
+			start = day
+		}
+		length++
+		hasWeekend = hasWeekend || weekend
+		hasHoliday = hasHoliday || holiday
+	}
+	flush(end.AddDays(-1))
+	return weekends
+}
+
+// BridgeDays returns the working days of a year that sit alone between two days off,
+// at least one of them a holiday, such as the Friday after a Thursday holiday. Taking a
+// bridge day off joins the holiday to the weekend. Dates are midnight UTC. If no
+// holiday checker is provided, it uses the default holiday checker.
+//
+// Example:
+//
+//	bridges := chronogo.BridgeDays(2024, chronogo.NewGoHolidayChecker("US"))
+//	// [2024-07-05 2024-11-29]: the Fridays after Independence Day and Thanksgiving
+func BridgeDays(year int, holidayChecker ...HolidayChecker) []DateTime {
+	checker := holidayCheckerOrDefault(holidayChecker)
+
+	var bridges []DateTime
+	for day := UTC(year, 1, 1, 0, 0, 0, 0); day.Year() == year; day = day.AddDays(1) {
+		if !day.IsBusinessDay(checker) {
+			continue
+		}
+		prev, next := day.AddDays(-1), day.AddDays(1)
+		if prev.IsBusinessDay(checker) || next.IsBusinessDay(checker) {
+			continue
+		}
+		if checker.IsHoliday(prev) || checker.IsHoliday(next) {
+			bridges = append(bridges, day)
+		}
+	}
+	return bridges
+}
@@ -0,0 +1,102 @@
+package chronogo
+
+import (
+	"testing"
+	"time"
+)
+
+func TestNextHoliday(t *testing.T) {
+	checker := NewGoHolidayChecker("US")
+
+	// Strictly after the calendar day, even when after is itself a holiday
+	date, name := checker.NextHoliday(Date(2024, time.July, 4, 9, 0, 0, 0, time.UTC))
+	if !date.Equal(Date(2024, time.September, 2, 0, 0, 0, 0, time.UTC)) || name != checker.GetHolidayName(date) {
+		t.Errorf("NextHoliday() = %v, %q, want Labor Day", date, name)
+	}
+
+	holidays := checker.NextNHolidays(Date(2024, time.November, 1, 0, 0, 0, 0, time.UTC), 3)
+	want := []DateTime{
+		Date(2024, time.November, 11, 0, 0, 0, 0, time.UTC),
+		Date(2024, time.November, 28, 0, 0, 0, 0, time.UTC),
+		Date(2024, time.December, 25, 0, 0, 0, 0, time.UTC),
+	}
+	if len(holidays) != len(want) {
+		t.Fatalf("NextNHolidays() returned %d holidays, want %d", len(holidays), len(want))
+	}
+	for i, h := range holidays {
+		if !h.Date.Equal(want[i]) || h.Name == "" {
+			t.Errorf("NextNHolidays()[%d] = %v %q, want %v", i, h.Date, h.Name, want[i])
+		}
+	}
+	if got := checker.NextNHolidays(Date(2024, time.November, 1, 0, 0, 0, 0, time.UTC), 0); got != nil {
+		t.Errorf("NextNHolidays(0) = %v, want nil", got)
+	}
+
+	// A filter that excludes every holiday finds nothing
+	if date, name := checker.WithCategories(CategorySchool).NextHoliday(Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC)); !date.IsZero() || name != "" {
+		t.Errorf("NextHoliday() with no matching holidays = %v, %q", date, name)
+	}
+}
+
+func TestUntilNextHoliday(t *testing.T) {
+	dt := Date(2024, time.December, 20, 12, 0, 0, 0, time.UTC)
+	diff := dt.UntilNextHoliday(NewGoHolidayChecker("US"))
+	if diff.InHours() != 4*24+12 {
+		t.Errorf("UntilNextHoliday() = %v hours, want 108", diff.InHours())
+	}
+	if diff.Days() != 4 {
+		t.Errorf("UntilNextHoliday().Days() = %d, want 4", diff.Days())
+	}
+
+	// Default checker and custom checkers work too
+	if info, ok := dt.NextHoliday(); !ok || !info.Date.Equal(Date(2024, time.December, 25, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("NextHoliday() with default checker = %v, %v", info, ok)
+	}
+	if !dt.UntilNextHoliday(&NullChecker{}).IsZero() {
+		t.Error("UntilNextHoliday() without any holidays should be zero")
+	}
+}
+
+func TestLongWeekends(t *testing.T) {
+	checker := NewGoHolidayChecker("US")
+	weekends := LongWeekends(2024, checker)
+
+	// Monday holidays; New Year's Day 2024 belongs to a run starting in 2023
+	starts := []string{"2024-01-13", "2024-02-17", "2024-05-25", "2024-08-31", "2024-10-12", "2024-11-09"}
+	if len(weekends) != len(starts) {
+		t.Fatalf("LongWeekends(2024) returned %d periods: %v", len(weekends), weekends)
+	}
+	for i, p := range weekends {
+		if p.Start.ToDateString() != starts[i] {
+			t.Errorf("LongWeekends(2024)[%d] starts %s, want %s", i, p.Start.ToDateString(), starts[i])
+		}
+		if !p.End.Equal(p.Start.AddDays(2).EndOfDay()) {
+			t.Errorf("LongWeekends(2024)[%d] ends %v, want the end of the third day", i, p.End)
+		}
+	}
+	if got := LongWeekends(2023, checker); got[len(got)-1].Start.ToDateString() != "2023-12-30" {
+		t.Errorf("a run crossing New Year should belong to the year it starts in, got %v", got[len(got)-1])
+	}
+
+	if got := LongWeekends(2024, &NullChecker{}); len(got) != 0 {
+		t.Errorf("LongWeekends() without holidays = %v", got)
+	}
+}
+
+func TestBridgeDays(t *testing.T) {
+	bridges := BridgeDays(2024, NewGoHolidayChecker("US"))
+	want := []string{"2024-07-05", "2024-11-29"}
+	if len(bridges) != len(want) {
+		t.Fatalf("BridgeDays(2024) = %v, want %v", bridges, want)
+	}
+	for i, d := range bridges {
+		if d.ToDateString() != want[i] {
+			t.Errorf("BridgeDays(2024)[%d] = %s, want %s", i, d.ToDateString(), want[i])
+		}
+	}
+
+	// Weekends alone do not make bridge days
+	if got := BridgeDays(2024, &NullChecker{}); len(got) != 0 {
+		t.Errorf("BridgeDays() without holidays = %v", got)
+	}
+}
@@ -7,7 +7,8 @@ import (
 )
 
 // DiffForHumans returns a human-readable string describing the difference
-// between this DateTime and another DateTime or the current time.
+// between this DateTime and another DateTime or the current time. The current time
+// comes from Now(), so output is reproducible under SetTestNow and FreezeTime.
 // Uses the default locale (set via SetDefaultLocale). Defaults to English.
 //
 // Examples:
@@ -23,7 +24,7 @@ func (dt DateTime) DiffForHumans(other ...DateTime) string {
 	}
 
 	// Use default locale
-	locale, err := GetLocale(defaultLocale)
+	locale, err := GetLocale(GetDefaultLocale())
 	if err != nil {
 		// Fallback to English if default locale fails
 		locale, _ = GetLocale("en-US")
@@ -32,6 +33,48 @@ func (dt DateTime) DiffForHumans(other ...DateTime) string {
 	return dt.humanStringWithLocale(reference, locale)
 }
 
+// HumanOptions controls DiffForHumansWith.
+type HumanOptions struct {
+	// Reference is the instant to compare against. Zero uses the current time.
+	Reference DateTime
+
+	// Locale is the locale code for the output. Empty uses the default locale.
+	Locale string
+
+	// Qualifiers marks values that are not a whole number of units: "about" for a
+	// little over, "over" for up to three quarters more, and "almost" when close to
+	// the next value ("almost 2 years ago" rather than "1 year ago").
+	Qualifiers bool
+}
+
+// DiffForHumansWith returns a human-readable difference like DiffForHumans, with
+// the reference, locale and qualifiers chosen through opts. Unknown locales fall
+// back to English.
+//
+// Example:
+//
+//	base := chronogo.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+//	dt := base.AddMonths(-22)
+//	dt.DiffForHumansWith(chronogo.HumanOptions{Reference: base, Qualifiers: true}) // "almost 2 years ago"
+//	dt.DiffForHumansWith(chronogo.HumanOptions{Reference: base, Qualifiers: true, Locale: "es-ES"}) // "hace casi 2 años"
+func (dt DateTime) DiffForHumansWith(opts HumanOptions) string {
+	reference := opts.Reference
+	if reference.IsZero() {
+		reference = Now()
+	}
+
+	code := opts.Locale
+	if code == "" {
+		code = GetDefaultLocale()
+	}
+	locale, err := GetLocale(code)
+	if err != nil {
+		locale, _ = GetLocale("en-US")
+	}
+
+	return dt.humanString(reference, locale, opts.Qualifiers)
+}
+
 // DiffForHumansNow returns a human-readable string describing the difference
 // between this DateTime and the current time.
 // Uses the default locale.
@@ -46,7 +89,7 @@ func (dt DateTime) DiffForHumansNow() string {
 // differences (e.g., "2 hours ago"), use DiffForHumans() instead.
 func Humanize(duration time.Duration) string {
 	if duration == 0 {
-		locale, _ := GetLocale(defaultLocale)
+		locale, _ := GetLocale(GetDefaultLocale())
 		if locale == nil {
 			locale, _ = GetLocale("en-US")
 		}
@@ -58,7 +101,7 @@ func Humanize(duration time.Duration) string {
 	}
 
 	// Get locale for unit names
-	locale, err := GetLocale(defaultLocale)
+	locale, err := GetLocale(GetDefaultLocale())
 	if err != nil {
 		locale, _ = GetLocale("en-US")
 	}
@@ -123,13 +166,19 @@ func Humanize(duration time.Duration) string {
 	return fmt.Sprintf("%d %s", value, unitName)
 }
 
-// Age returns the age of the DateTime compared to now.
+// Age returns the age of the DateTime compared to Now().
 // Uses the default locale for output.
 //
 // Note: This is a simplified age calculation. For more precise
 // age calculations with years, months, and days, use Diff().
 func (dt DateTime) Age() string {
-	now := Now()
+	return dt.AgeAt(Now())
+}
+
+// AgeAt returns the age of the DateTime at the reference, such as a person's age on
+// a given date. Uses the default locale for output.
+func (dt DateTime) AgeAt(reference DateTime) string {
+	now := reference
 	if dt.After(now) {
 		// TODO: Localize "not yet born"
 		return "not yet born"
@@ -140,7 +189,7 @@ func (dt DateTime) Age() string {
 	duration := now.Sub(dt)
 	years := int(duration.Hours() / 24 / 365.25)
 
-	locale, err := GetLocale(defaultLocale)
+	locale, err := GetLocale(GetDefaultLocale())
 	if err != nil {
 		locale, _ = GetLocale("en-US")
 	}
@@ -185,12 +234,22 @@ func (dt DateTime) TimeFromNow() string {
 	return dt.DiffForHumans()
 }
 
+// TimeFromNowAt is TimeFromNow relative to the reference instead of now.
+func (dt DateTime) TimeFromNowAt(reference DateTime) string {
+	return dt.DiffForHumans(reference)
+}
+
 // TimeAgo returns a human-readable string representing how long ago this DateTime occurred.
 // Uses the default locale.
 func (dt DateTime) TimeAgo() string {
 	return dt.DiffForHumans()
 }
 
+// TimeAgoAt is TimeAgo relative to the reference instead of now.
+func (dt DateTime) TimeAgoAt(reference DateTime) string {
+	return dt.DiffForHumans(reference)
+}
+
 // DiffForHumansComparison returns a human-readable string describing the difference
 // between this DateTime and another using "before/after" format for explicit comparisons.
 // Uses the default locale.
@@ -202,7 +261,7 @@ func (dt DateTime) TimeAgo() string {
 func (dt DateTime) DiffForHumansComparison(other DateTime) string {
 	// Note: Some languages don't distinguish between "ago/in" and "before/after"
 	// They use the same patterns, so we just use the standard human string
-	locale, err := GetLocale(defaultLocale)
+	locale, err := GetLocale(GetDefaultLocale())
 	if err != nil {
 		locale, _ = GetLocale("en-US")
 	}
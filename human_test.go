@@ -42,6 +42,39 @@ func TestDiffForHumans(t *testing.T) {
 	}
 }
 
+func TestDiffForHumansWithQualifiers(t *testing.T) {
+	base := Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC)
+	tests := []struct {
+		dt     DateTime
+		locale string
+		want   string
+	}{
+		{base.AddMonths(-22), "", "almost 2 years ago"},
+		{base.AddDays(-17), "", "over 2 weeks ago"},
+		{base.AddHours(2).AddMinutes(10), "", "in about 2 hours"},
+		{base.AddHours(-3), "", "3 hours ago"}, // exact values stay unqualified
+		{base.AddMonths(-22), "es-ES", "hace casi 2 años"},
+		{base.AddDays(17), "de-DE", "in über 2 Wochen"},
+		{base.AddDays(-17), "zh-Hans", "超过2周前"},
+		{base.AddMonths(-22), "ja-JP", "2年近く前"},
+	}
+	for _, tt := range tests {
+		got := tt.dt.DiffForHumansWith(HumanOptions{Reference: base, Locale: tt.locale, Qualifiers: true})
+		if got != tt.want {
+			t.Errorf("DiffForHumansWith(%v, %q) = %q, want %q", tt.dt, tt.locale, got, tt.want)
+		}
+	}
+
+	// Without qualifiers the output matches DiffForHumans
+	dt := base.AddMonths(-22)
+	if got := dt.DiffForHumansWith(HumanOptions{Reference: base}); got != dt.DiffForHumans(base) {
+		t.Errorf("DiffForHumansWith() = %q, want %q", got, dt.DiffForHumans(base))
+	}
+	if got := dt.DiffForHumansWith(HumanOptions{Reference: base, Locale: "xx-XX", Qualifiers: true}); got != "almost 2 years ago" {
+		t.Errorf("unknown locale = %q, want English fallback", got)
+	}
+}
+
 func TestDiffForHumansComparison(t *testing.T) {
 	// Set to English for consistent testing
 	_ = SetDefaultLocale("en-US")
@@ -148,6 +181,36 @@ func TestTimeAgo(t *testing.T) {
 	}
 }
 
+func TestHumanStringsUseReferenceTime(t *testing.T) {
+	ref := Date(2024, time.June, 15, 12, 0, 0, 0, time.UTC)
+	dt := ref.AddHours(-3)
+
+	// An explicit reference gives the same output as the test clock
+	var frozen [3]string
+	WithTestNow(ref, func() {
+		frozen = [3]string{dt.TimeAgo(), ref.AddHours(3).TimeFromNow(), ref.AddYears(-30).Age()}
+	})
+	explicit := [3]string{dt.TimeAgoAt(ref), ref.AddHours(3).TimeFromNowAt(ref), ref.AddYears(-30).AgeAt(ref)}
+	want := [3]string{"3 hours ago", "in 3 hours", "30 years old"}
+	if frozen != want || explicit != want {
+		t.Errorf("frozen = %q, explicit = %q, want %q", frozen, explicit, want)
+	}
+
+	// Far from the real clock, so only the test clock can make these hold
+	WithTestNow(ref, func() {
+		if got := dt.DiffForHumans(); got != "3 hours ago" {
+			t.Errorf("DiffForHumans() under test clock = %q", got)
+		}
+		got, _ := dt.HumanStringLocalized("es")
+		if want, _ := dt.HumanStringLocalized("es", ref); got != want {
+			t.Errorf("HumanStringLocalized() under test clock = %q, want %q", got, want)
+		}
+	})
+	if got := ref.AddYears(1).AgeAt(ref); got != "not yet born" {
+		t.Errorf("AgeAt(ref) for a later date = %q", got)
+	}
+}
+
 // Test edge cases and boundary conditions
 func TestDiffForHumansEdgeCases(t *testing.T) {
 	dt1 := Date(2023, time.January, 15, 12, 0, 0, 0, time.UTC)
@@ -0,0 +1,546 @@
+package chronogo
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ICSEvent is a minimal iCalendar (RFC 5545) VEVENT: a Period with an optional
+// recurrence rule and a few descriptive properties.
+//
+// Example:
+//
+//	event := chronogo.ICSEvent{
+//		UID:     "standup@example.com",
+//		Summary: "Standup",
+//		Period:  chronogo.NewPeriod(start, start.AddMinutes(15)),
+//		RRule:   &chronogo.RecurrenceRule{Frequency: chronogo.RecurWeekly, ByDay: []time.Weekday{time.Monday, time.Wednesday}},
+//	}
+//	invite := chronogo.ToICS(event)
+type ICSEvent struct {
+	UID         string
+	Summary     string
+	Description string
+	Location    string
+
+	// Period is the first occurrence. For all-day events only the dates are used and
+	// End is the exclusive end date, as in iCalendar.
+	Period Period
+
+	// AllDay writes DTSTART/DTEND as dates (VALUE=DATE) instead of date-times.
+	AllDay bool
+
+	// RRule is the recurrence rule, or nil for a single event.
+	RRule *RecurrenceRule
+
+	// Stamp is written as DTSTAMP. Zero uses the current time.
+	Stamp DateTime
+}
+
+// RecurrenceFrequency is the FREQ of a recurrence rule.
+type RecurrenceFrequency int
+
+const (
+	// RecurDaily repeats every Interval days.
+	RecurDaily RecurrenceFrequency = iota
+	// RecurWeekly repeats every Interval weeks, on ByDay or the start's weekday.
+	RecurWeekly
+	// RecurMonthly repeats every Interval months on the start's day of month.
+	RecurMonthly
+	// RecurYearly repeats every Interval years on the start's month and day.
+	RecurYearly
+)
+
+// String returns the iCalendar FREQ value.
+func (f RecurrenceFrequency) String() string {
+	switch f {
+	case RecurDaily:
+		return "DAILY"
+	case RecurWeekly:
+		return "WEEKLY"
+	case RecurMonthly:
+		return "MONTHLY"
+	case RecurYearly:
+		return "YEARLY"
+	default:
+		return "UNKNOWN"
+	}
+}
+
+// RecurrenceRule is the subset of an iCalendar RRULE supported by chronogo: a
+// frequency with an interval, an optional COUNT or UNTIL, and BYDAY weekdays and a
+// WKST week start for weekly rules.
+type RecurrenceRule struct {
+	Frequency RecurrenceFrequency
+	Interval  int // zero means 1
+	Count     int // zero means no count limit
+	Until     DateTime
+	ByDay     []time.Weekday
+	WeekStart *time.Weekday // nil means Monday, the RFC 5545 default
+}
+
+// String returns the rule in RRULE value form, e.g. "FREQ=WEEKLY;INTERVAL=2;BYDAY=MO,WE".
+func (r RecurrenceRule) String() string {
+	parts := []string{"FREQ=" + r.Frequency.String()}
+	if r.Interval > 1 {
+		parts = append(parts, "INTERVAL="+strconv.Itoa(r.Interval))
+	}
+	if r.Count > 0 {
+		parts = append(parts, "COUNT="+strconv.Itoa(r.Count))
+	}
+	if !r.Until.IsZero() {
+		parts = append(parts, "UNTIL="+r.Until.UTC().Format(icsUTCLayout))
+	}
+	if len(r.ByDay) > 0 {
+		days := make([]string, len(r.ByDay))
+		for i, wd := range r.ByDay {
+			days[i] = icsWeekdays[wd]
+		}
+		parts = append(parts, "BYDAY="+strings.Join(days, ","))
+	}
+	if r.WeekStart != nil {
+		parts = append(parts, "WKST="+icsWeekdays[*r.WeekStart])
+	}
+	return strings.Join(parts, ";")
+}
+
+// Occurrences returns the start times of the rule's occurrences beginning at start,
+// stopping at Count, Until or limit occurrences, whichever comes first. Monthly and
+// yearly occurrences on days a month lacks (the 31st, February 29) are skipped, as
+// RFC 5545 requires. Weekly intervals count weeks beginning on WeekStart, so with
+// INTERVAL=2 a rule starting on a Wednesday also skips the following Monday.
+func (r RecurrenceRule) Occurrences(start DateTime, limit int) []DateTime {
+	interval := r.Interval
+	if interval < 1 {
+		interval = 1
+	}
+	if r.Count > 0 && (limit <= 0 || r.Count < limit) {
+		limit = r.Count
+	}
+	if limit <= 0 {
+		return nil
+	}
+
+	var result []DateTime
+	emit := func(dt DateTime) bool {
+		if !r.Until.IsZero() && dt.After(r.Until) {
+			return false
+		}
+		result = append(result, dt)
+		return len(result) < limit
+	}
+
+	y, m, d := start.Date()
+	h, mi, s := start.Clock()
+	firstWeek := start.startOfWeekOn(r.weekStart())
+	// Stop once periods stop producing occurrences within Until or a sane horizon
+	for i := 0; i < 100000; i++ {
+		switch r.Frequency {
+		case RecurDaily:
+			if !emit(Date(y, m, d+i*interval, h, mi, s, start.Nanosecond(), start.Location())) {
+				return result
+			}
+		case RecurWeekly:
+			weekStart := firstWeek.AddDays(7 * i * interval)
+			for _, dt := range r.weekOccurrences(weekStart, start) {
+				if dt.Before(start) {
+					continue
+				}
+				if !emit(dt) {
+					return result
+				}
+			}
+		case RecurMonthly, RecurYearly:
+			months := i * interval
+			if r.Frequency == RecurYearly {
+				months *= 12
+			}
+			first := Date(y, m+time.Month(months), 1, 0, 0, 0, 0, start.Location())
+			if d > first.DaysInMonth() {
+				continue
+			}
+			if !emit(Date(first.Year(), first.Month(), d, h, mi, s, start.Nanosecond(), start.Location())) {
+				return result
+			}
+		default:
+			return result
+		}
+	}
+	return result
+}
+
+// weekStart returns the first day of the rule's weeks.
+func (r RecurrenceRule) weekStart() time.Weekday {
+	if r.WeekStart == nil {
+		return time.Monday
+	}
+	return *r.WeekStart
+}
+
+// weekOccurrences returns the occurrences in the week starting at weekStart (which
+// begins on the rule's first weekday), at the time of day of start.
+func (r RecurrenceRule) weekOccurrences(weekStart, start DateTime) []DateTime {
+	days := r.ByDay
+	if len(days) == 0 {
+		days = []time.Weekday{start.Weekday()}
+	}
+	var result []DateTime
+	for offset := 0; offset < 7; offset++ {
+		day := weekStart.AddDays(offset)
+		for _, wd := range days {
+			if day.Weekday() == wd {
+				result = append(result, Date(day.Year(), day.Month(), day.Day(), start.Hour(), start.Minute(), start.Second(), start.Nanosecond(), start.Location()))
+			}
+		}
+	}
+	return result
+}
+
+const (
+	icsUTCLayout   = "20060102T150405Z"
+	icsLocalLayout = "20060102T150405"
+	icsDateLayout  = "20060102"
+)
+
+var icsWeekdays = map[time.Weekday]string{
+	time.Sunday: "SU", time.Monday: "MO", time.Tuesday: "TU", time.Wednesday: "WE",
+	time.Thursday: "TH", time.Friday: "FR", time.Saturday: "SA",
+}
+
+// ToICS renders events as an iCalendar VCALENDAR document with CRLF line endings.
+func ToICS(events ...ICSEvent) string {
+	var b strings.Builder
+	b.WriteString("BEGIN:VCALENDAR\r\nVERSION:2.0\r\nPRODID:-//chronogo//EN\r\n")
+	for _, e := range events {
+		b.WriteString(e.VEvent())
+	}
+	b.WriteString("END:VCALENDAR\r\n")
+	return b.String()
+}
+
+// VEvent renders the event as a VEVENT block. Date-times in UTC are written in the
+// UTC form (20240115T103000Z); those in an IANA zone use TZID (readers are expected
+// to know the zone, so no VTIMEZONE is emitted); fixed offsets are converted to UTC.
+func (e ICSEvent) VEvent() string {
+	stamp := e.Stamp
+	if stamp.IsZero() {
+		stamp = NowUTC()
+	}
+
+	var lines []string
+	lines = append(lines, "BEGIN:VEVENT")
+	if e.UID != "" {
+		lines = append(lines, "UID:"+icsEscape(e.UID))
+	}
+	lines = append(lines, "DTSTAMP:"+stamp.UTC().Format(icsUTCLayout))
+	lines = append(lines, icsDateProperty("DTSTART", e.Period.Start, e.AllDay))
+	lines = append(lines, icsDateProperty("DTEND", e.Period.End, e.AllDay))
+	if e.RRule != nil {
+		lines = append(lines, "RRULE:"+e.RRule.String())
+	}
+	if e.Summary != "" {
+		lines = append(lines, "SUMMARY:"+icsEscape(e.Summary))
+	}
+	if e.Description != "" {
+		lines = append(lines, "DESCRIPTION:"+icsEscape(e.Description))
+	}
+	if e.Location != "" {
+		lines = append(lines, "LOCATION:"+icsEscape(e.Location))
+	}
+	lines = append(lines, "END:VEVENT")
+
+	var b strings.Builder
+	for _, line := range lines {
+		b.WriteString(icsFold(line))
+		b.WriteString("\r\n")
+	}
+	return b.String()
+}
+
+// icsDateProperty formats a DTSTART or DTEND property.
+func icsDateProperty(name string, dt DateTime, allDay bool) string {
+	if allDay {
+		return name + ";VALUE=DATE:" + dt.Format(icsDateLayout)
+	}
+	loc := dt.Location()
+	if loc == time.UTC || !icsNamedZone(loc) {
+		return name + ":" + dt.UTC().Format(icsUTCLayout)
+	}
+	return name + ";TZID=" + loc.String() + ":" + dt.Format(icsLocalLayout)
+}
+
+// icsNamedZone reports whether loc is an IANA zone that a calendar reader can resolve.
+func icsNamedZone(loc *time.Location) bool {
+	name := loc.String()
+	if name == "Local" || name == "" || !strings.Contains(name, "/") {
+		return false
+	}
+	_, err := time.LoadLocation(name)
+	return err == nil
+}
+
+// icsEscape escapes TEXT values.
+func icsEscape(s string) string {
+	return strings.NewReplacer(`\`, `\\`, ";", `\;`, ",", `\,`, "\r\n", `\n`, "\n", `\n`).Replace(s)
+}
+
+// icsUnescape reverses icsEscape.
+func icsUnescape(s string) string {
+	return strings.NewReplacer(`\\`, `\`, `\;`, ";", `\,`, ",", `\n`, "\n", `\N`, "\n").Replace(s)
+}
+
+// icsFold folds a content line at 75 octets without splitting UTF-8 sequences.
+func icsFold(line string) string {
+	if len(line) <= 75 {
+		return line
+	}
+	var b strings.Builder
+	width := 75
+	for len(line) > width {
+		cut := width
+		for cut > 0 && line[cut]&0xC0 == 0x80 {
+			cut--
+		}
+		b.WriteString(line[:cut])
+		b.WriteString("\r\n ")
+		line = line[cut:]
+		width = 74 // continuation lines start with a space
+	}
+	b.WriteString(line)
+	return b.String()
+}
+
+// ParseICS parses the VEVENTs of an iCalendar document. It understands UID, SUMMARY,
+// DESCRIPTION, LOCATION, DTSTART, DTEND, DURATION and simple RRULEs; other properties
+// and components are ignored. Date-times may be UTC, carry a TZID, or be floating
+// (read in UTC); VALUE=DATE makes the event all-day.
+//
+// Example:
+//
+//	events, err := chronogo.ParseICS(invite)
+//	events[0].Period.Start // DTSTART in its TZID location
+func ParseICS(data string) ([]ICSEvent, error) {
+	// Unfold continuation lines
+	data = strings.ReplaceAll(data, "\r\n", "\n")
+	data = strings.ReplaceAll(data, "\n ", "")
+	data = strings.ReplaceAll(data, "\n\t", "")
+
+	var events []ICSEvent
+	var current *ICSEvent
+	var duration *ISO8601Duration
+	hasEnd := false
+
+	for _, line := range strings.Split(data, "\n") {
+		line = strings.TrimRight(line, "\r")
+		if line == "" {
+			continue
+		}
+		name, params, value, ok := splitICSLine(line)
+		if !ok {
+			return nil, ParseError(line, errors.New("malformed iCalendar line"))
+		}
+
+		switch {
+		case name == "BEGIN" && value == "VEVENT":
+			current, duration, hasEnd = &ICSEvent{}, nil, false
+			continue
+		case name == "END" && value == "VEVENT":
+			if current == nil {
+				return nil, ParseError(line, errors.New("END:VEVENT without BEGIN"))
+			}
+			if current.Period.Start.IsZero() {
+				return nil, ParseError(line, errors.New("VEVENT without DTSTART"))
+			}
+			switch {
+			case duration != nil:
+				current.Period.End = addDurationToDateTime(current.Period.Start, *duration)
+			case !hasEnd && current.AllDay:
+				current.Period.End = current.Period.Start.AddDays(1)
+			case !hasEnd:
+				current.Period.End = current.Period.Start
+			}
+			events = append(events, *current)
+			current = nil
+			continue
+		}
+		if current == nil {
+			continue
+		}
+
+		var err error
+		switch name {
+		case "UID":
+			current.UID = icsUnescape(value)
+		case "SUMMARY":
+			current.Summary = icsUnescape(value)
+		case "DESCRIPTION":
+			current.Description = icsUnescape(value)
+		case "LOCATION":
+			current.Location = icsUnescape(value)
+		case "DTSTAMP":
+			current.Stamp, err = parseICSDateTime(value, params)
+		case "DTSTART":
+			current.Period.Start, err = parseICSDateTime(value, params)
+			current.AllDay = params["VALUE"] == "DATE" || len(value) == len(icsDateLayout)
+		case "DTEND":
+			current.Period.End, err = parseICSDateTime(value, params)
+			hasEnd = true
+		case "DURATION":
+			var d ISO8601Duration
+			d, err = parseICSDuration(value)
+			duration = &d
+		case "RRULE":
+			current.RRule, err = parseRRule(value)
+		}
+		if err != nil {
+			return nil, ParseError(line, err)
+		}
+	}
+
+	if current != nil {
+		return nil, ParseError(data, errors.New("unterminated VEVENT"))
+	}
+	return events, nil
+}
+
+// splitICSLine splits a content line into its name, parameters and value.
+func splitICSLine(line string) (string, map[string]string, string, bool) {
+	colon := -1
+	inQuotes := false
+	for i := 0; i < len(line); i++ {
+		if line[i] == '"' {
+			inQuotes = !inQuotes
+		} else if line[i] == ':' && !inQuotes {
+			colon = i
+			break
+		}
+	}
+	if colon < 0 {
+		return "", nil, "", false
+	}
+
+	fields := strings.Split(line[:colon], ";")
+	params := make(map[string]string, len(fields)-1)
+	for _, param := range fields[1:] {
+		key, val, _ := strings.Cut(param, "=")
+		params[strings.ToUpper(key)] = strings.Trim(val, `"`)
+	}
+	return strings.ToUpper(fields[0]), params, line[colon+1:], true
+}
+
+// parseICSDateTime parses a DATE or DATE-TIME value honoring its TZID parameter.
+func parseICSDateTime(value string, params map[string]string) (DateTime, error) {
+	loc := time.UTC
+	if tzid, ok := params["TZID"]; ok {
+		l, err := time.LoadLocation(tzid)
+		if err != nil {
+			return DateTime{}, fmt.Errorf("%w: TZID %q", ErrUnknownTimezone, tzid)
+		}
+		loc = l
+	}
+
+	layout := icsLocalLayout
+	switch {
+	case params["VALUE"] == "DATE" || len(value) == len(icsDateLayout):
+		layout = icsDateLayout
+	case strings.HasSuffix(value, "Z"):
+		layout, loc = icsUTCLayout, time.UTC
+	}
+	t, err := time.ParseInLocation(layout, value, loc)
+	if err != nil {
+		return DateTime{}, fmt.Errorf("%w: %v", ErrInvalidFormat, err)
+	}
+	return DateTime{t}, nil
+}
+
+// parseICSDuration parses a DURATION value, which may use weeks (P2W).
+func parseICSDuration(value string) (ISO8601Duration, error) {
+	if weeks, ok := strings.CutSuffix(strings.TrimPrefix(value, "P"), "W"); ok && strings.HasPrefix(value, "P") {
+		n, err := strconv.Atoi(weeks)
+		if err != nil {
+			return ISO8601Duration{}, fmt.Errorf("%w: %s", ErrInvalidDuration, value)
+		}
+		return ISO8601Duration{Days: n * 7}, nil
+	}
+	d, err := parseDuration(value)
+	if err != nil {
+		return ISO8601Duration{}, fmt.Errorf("%w: %s", ErrInvalidDuration, value)
+	}
+	return d, nil
+}
+
+// parseRRule parses an RRULE value. Rule parts beyond FREQ, INTERVAL, COUNT, UNTIL,
+// plain BYDAY weekdays and WKST are rejected rather than silently ignored.
+func parseRRule(value string) (*RecurrenceRule, error) {
+	rule := &RecurrenceRule{}
+	hasFreq := false
+	for _, part := range strings.Split(value, ";") {
+		key, val, _ := strings.Cut(part, "=")
+		switch strings.ToUpper(key) {
+		case "FREQ":
+			hasFreq = true
+			switch strings.ToUpper(val) {
+			case "DAILY":
+				rule.Frequency = RecurDaily
+			case "WEEKLY":
+				rule.Frequency = RecurWeekly
+			case "MONTHLY":
+				rule.Frequency = RecurMonthly
+			case "YEARLY":
+				rule.Frequency = RecurYearly
+			default:
+				return nil, fmt.Errorf("%w: unsupported FREQ %q", ErrInvalidFormat, val)
+			}
+		case "INTERVAL", "COUNT":
+			n, err := strconv.Atoi(val)
+			if err != nil || n < 1 {
+				return nil, fmt.Errorf("%w: invalid %s %q", ErrInvalidFormat, key, val)
+			}
+			if strings.ToUpper(key) == "INTERVAL" {
+				rule.Interval = n
+			} else {
+				rule.Count = n
+			}
+		case "UNTIL":
+			until, err := parseICSDateTime(val, nil)
+			if err != nil {
+				return nil, err
+			}
+			rule.Until = until
+		case "BYDAY":
+			for _, code := range strings.Split(val, ",") {
+				wd, ok := icsWeekdayByCode(code)
+				if !ok {
+					return nil, fmt.Errorf("%w: unsupported BYDAY %q", ErrInvalidFormat, code)
+				}
+				rule.ByDay = append(rule.ByDay, wd)
+			}
+		case "WKST":
+			wd, ok := icsWeekdayByCode(val)
+			if !ok {
+				return nil, fmt.Errorf("%w: unsupported WKST %q", ErrInvalidFormat, val)
+			}
+			rule.WeekStart = &wd
+		default:
+			return nil, fmt.Errorf("%w: unsupported RRULE part %q", ErrInvalidFormat, key)
+		}
+	}
+	if !hasFreq {
+		return nil, fmt.Errorf("%w: RRULE without FREQ", ErrInvalidFormat)
+	}
+	return rule, nil
+}
+
+// icsWeekdayByCode maps a two-letter iCalendar weekday code to a time.Weekday.
+func icsWeekdayByCode(code string) (time.Weekday, bool) {
+	code = strings.ToUpper(code)
+	for wd, c := range icsWeekdays {
+		if c == code {
+			return wd, true
+		}
+	}
+	return 0, false
+}
@@ -0,0 +1,249 @@
+package chronogo
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestToICS(t *testing.T) {
+	ny, _ := time.LoadLocation("America/New_York")
+	start := Date(2024, time.January, 15, 9, 30, 0, 0, ny)
+	stamp := Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+	event := ICSEvent{
+		UID:     "standup@example.com",
+		Summary: "Standup; daily, short",
+		Period:  NewPeriod(start, start.AddMinutes(15)),
+		RRule:   &RecurrenceRule{Frequency: RecurWeekly, Interval: 2, ByDay: []time.Weekday{time.Monday, time.Wednesday}},
+		Stamp:   stamp,
+	}
+	got := ToICS(event)
+
+	for _, want := range []string{
+		"BEGIN:VCALENDAR\r\n",
+		"DTSTAMP:20240101T000000Z\r\n",
+		"DTSTART;TZID=America/New_York:20240115T093000\r\n",
+		"DTEND;TZID=America/New_York:20240115T094500\r\n",
+		"RRULE:FREQ=WEEKLY;INTERVAL=2;BYDAY=MO,WE\r\n",
+		`SUMMARY:Standup\; daily\, short` + "\r\n",
+		"END:VCALENDAR\r\n",
+	} {
+		if !strings.Contains(got, want) {
+			t.Errorf("ToICS() missing %q in:\n%s", want, got)
+		}
+	}
+
+	// UTC and fixed offsets use the UTC form
+	fixed := Date(2024, time.January, 15, 9, 30, 0, 0, FixedZone("", 2*3600))
+	utc := ICSEvent{Period: NewPeriod(fixed, fixed.AddHours(1)), Stamp: stamp}.VEvent()
+	if !strings.Contains(utc, "DTSTART:20240115T073000Z\r\n") {
+		t.Errorf("VEvent() with fixed offset:\n%s", utc)
+	}
+
+	allDay := ICSEvent{
+		Period: NewPeriod(Date(2024, time.July, 4, 0, 0, 0, 0, time.UTC), Date(2024, time.July, 5, 0, 0, 0, 0, time.UTC)),
+		AllDay: true,
+		Stamp:  stamp,
+	}.VEvent()
+	if !strings.Contains(allDay, "DTSTART;VALUE=DATE:20240704\r\n") || !strings.Contains(allDay, "DTEND;VALUE=DATE:20240705\r\n") {
+		t.Errorf("VEvent() all-day:\n%s", allDay)
+	}
+
+	long := ICSEvent{Description: strings.Repeat("é", 60), Period: NewPeriod(fixed, fixed), Stamp: stamp}.VEvent()
+	for _, line := range strings.Split(long, "\r\n") {
+		if len(line) > 75 {
+			t.Errorf("line longer than 75 octets: %q", line)
+		}
+	}
+}
+
+func TestParseICSRoundTrip(t *testing.T) {
+	ny, _ := time.LoadLocation("America/New_York")
+	start := Date(2024, time.March, 8, 18, 0, 0, 0, ny)
+	until := Date(2024, time.June, 1, 0, 0, 0, 0, time.UTC)
+	original := ICSEvent{
+		UID:         "abc",
+		Summary:     "Review, planning",
+		Description: "Line one\nLine two; " + strings.Repeat("x", 100),
+		Location:    "Room 1",
+		Period:      NewPeriod(start, start.AddHours(1)),
+		RRule:       &RecurrenceRule{Frequency: RecurMonthly, Count: 3, Until: until},
+		Stamp:       Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC),
+	}
+
+	events, err := ParseICS(ToICS(original))
+	if err != nil {
+		t.Fatalf("ParseICS() error = %v", err)
+	}
+	if len(events) != 1 {
+		t.Fatalf("ParseICS() returned %d events, want 1", len(events))
+	}
+	got := events[0]
+	if got.UID != original.UID || got.Summary != original.Summary || got.Description != original.Description || got.Location != original.Location {
+		t.Errorf("text properties = %+v", got)
+	}
+	if !got.Period.Start.Equal(start) || got.Period.Start.Location().String() != "America/New_York" {
+		t.Errorf("Start = %v, want %v", got.Period.Start, start)
+	}
+	if !got.Period.End.Equal(start.AddHours(1)) || !got.Stamp.Equal(original.Stamp) {
+		t.Errorf("End = %v, Stamp = %v", got.Period.End, got.Stamp)
+	}
+	if got.RRule == nil || got.RRule.Frequency != RecurMonthly || got.RRule.Count != 3 || !got.RRule.Until.Equal(until) {
+		t.Errorf("RRule = %+v", got.RRule)
+	}
+}
+
+func TestParseICSForms(t *testing.T) {
+	data := strings.Join([]string{
+		"BEGIN:VCALENDAR",
+		"BEGIN:VEVENT",
+		"DTSTART:20240115T103000Z",
+		"DURATION:PT1H30M",
+		"SUMMARY:utc",
+		"END:VEVENT",
+		"BEGIN:VEVENT",
+		"DTSTART;TZID=\"Europe/Paris\":20240115T103000",
+		"DURATION:P1W",
+		"SUMMARY:paris",
+		"END:VEVENT",
+		"BEGIN:VEVENT",
+		"DTSTART:20240115T103000",
+		"SUMMARY:floating",
+		"END:VEVENT",
+		"BEGIN:VEVENT",
+		"DTSTART;VALUE=DATE:20240704",
+		"SUMMARY:all",
+		"  day",
+		"END:VEVENT",
+		"END:VCALENDAR",
+	}, "\r\n")
+
+	events, err := ParseICS(data)
+	if err != nil {
+		t.Fatalf("ParseICS() error = %v", err)
+	}
+	if len(events) != 4 {
+		t.Fatalf("ParseICS() returned %d events, want 4", len(events))
+	}
+
+	utc := Date(2024, time.January, 15, 10, 30, 0, 0, time.UTC)
+	if !events[0].Period.Start.Equal(utc) || events[0].Period.Duration() != 90*time.Minute {
+		t.Errorf("UTC event = %v", events[0].Period)
+	}
+
+	paris := events[1].Period.Start
+	if paris.Location().String() != "Europe/Paris" || paris.Hour() != 10 || !paris.Equal(utc.AddHours(-1)) {
+		t.Errorf("TZID start = %v", paris)
+	}
+	if !events[1].Period.End.Equal(paris.AddDays(7)) {
+		t.Errorf("P1W end = %v", events[1].Period.End)
+	}
+
+	if !events[2].Period.Start.Equal(utc) || !events[2].Period.End.Equal(utc) {
+		t.Errorf("floating event = %v", events[2].Period)
+	}
+
+	if !events[3].AllDay || events[3].Summary != "all day" || !events[3].Period.End.Equal(events[3].Period.Start.AddDays(1)) {
+		t.Errorf("all-day event = %+v", events[3])
+	}
+}
+
+func TestParseICSErrors(t *testing.T) {
+	tests := []struct {
+		name string
+		data string
+		want error
+	}{
+		{"unknown TZID", "BEGIN:VEVENT\nDTSTART;TZID=Mars/Base:20240101T000000\nEND:VEVENT", ErrInvalidTimezone},
+		{"bad date", "BEGIN:VEVENT\nDTSTART:2024-01-01\nEND:VEVENT", ErrInvalidFormat},
+		{"unsupported rule", "BEGIN:VEVENT\nDTSTART:20240101T000000Z\nRRULE:FREQ=MONTHLY;BYSETPOS=-1\nEND:VEVENT", ErrInvalidFormat},
+		{"positional BYDAY", "BEGIN:VEVENT\nDTSTART:20240101T000000Z\nRRULE:FREQ=MONTHLY;BYDAY=1MO\nEND:VEVENT", ErrInvalidFormat},
+		{"bad WKST", "BEGIN:VEVENT\nDTSTART:20240101T000000Z\nRRULE:FREQ=WEEKLY;WKST=XX\nEND:VEVENT", ErrInvalidFormat},
+		{"bad duration", "BEGIN:VEVENT\nDTSTART:20240101T000000Z\nDURATION:1H\nEND:VEVENT", ErrInvalidDuration},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := ParseICS(tt.data)
+			if !errors.Is(err, tt.want) {
+				t.Errorf("ParseICS() error = %v, want %v", err, tt.want)
+			}
+		})
+	}
+
+	for _, data := range []string{
+		"BEGIN:VEVENT\nSUMMARY:no start\nEND:VEVENT",
+		"BEGIN:VEVENT\nDTSTART:20240101T000000Z",
+		"BEGIN:VEVENT\nnot a property\nEND:VEVENT",
+	} {
+		if _, err := ParseICS(data); err == nil {
+			t.Errorf("ParseICS(%q) expected error", data)
+		}
+	}
+}
+
+func TestRecurrenceRuleOccurrences(t *testing.T) {
+	start := Date(2024, time.January, 31, 9, 0, 0, 0, time.UTC)
+
+	monthly := RecurrenceRule{Frequency: RecurMonthly, Count: 3}.Occurrences(start, 10)
+	want := []DateTime{start, Date(2024, time.March, 31, 9, 0, 0, 0, time.UTC), Date(2024, time.May, 31, 9, 0, 0, 0, time.UTC)}
+	if len(monthly) != len(want) {
+		t.Fatalf("monthly occurrences = %v, want %v", monthly, want)
+	}
+	for i := range want {
+		if !monthly[i].Equal(want[i]) {
+			t.Errorf("monthly[%d] = %v, want %v", i, monthly[i], want[i])
+		}
+	}
+
+	// Wednesday start, every Monday and Wednesday
+	wed := Date(2024, time.January, 3, 9, 0, 0, 0, time.UTC)
+	weekly := RecurrenceRule{Frequency: RecurWeekly, ByDay: []time.Weekday{time.Monday, time.Wednesday}}.Occurrences(wed, 4)
+	wantDays := []int{3, 8, 10, 15}
+	for i, day := range wantDays {
+		if weekly[i].Day() != day || weekly[i].Hour() != 9 {
+			t.Errorf("weekly[%d] = %v, want January %d", i, weekly[i], day)
+		}
+	}
+
+	// Biweekly periods start on Monday (the default WKST), not on the Wednesday start
+	biweekly := RecurrenceRule{Frequency: RecurWeekly, Interval: 2, ByDay: []time.Weekday{time.Monday, time.Wednesday}}.Occurrences(wed, 4)
+	wantDays = []int{3, 15, 17, 29}
+	if len(biweekly) != len(wantDays) {
+		t.Fatalf("biweekly occurrences = %v, want January %v", biweekly, wantDays)
+	}
+	for i, day := range wantDays {
+		if biweekly[i].Day() != day {
+			t.Errorf("biweekly[%d] = %v, want January %d", i, biweekly[i], day)
+		}
+	}
+
+	// With WKST=SU the week of the Wednesday start begins on Sunday December 31st
+	events, err := ParseICS("BEGIN:VEVENT\nDTSTART:20240103T090000Z\nRRULE:FREQ=WEEKLY;INTERVAL=2;BYDAY=SU,MO,WE;WKST=SU\nEND:VEVENT")
+	if err != nil {
+		t.Fatalf("ParseICS() error = %v", err)
+	}
+	rule := events[0].RRule
+	if rule.WeekStart == nil || *rule.WeekStart != time.Sunday || !strings.HasSuffix(rule.String(), ";WKST=SU") {
+		t.Fatalf("parsed rule = %s", rule)
+	}
+	wantDays = []int{3, 14, 15, 17}
+	for i, dt := range rule.Occurrences(wed, 4) {
+		if dt.Day() != wantDays[i] {
+			t.Errorf("WKST=SU occurrence %d = %v, want January %d", i, dt, wantDays[i])
+		}
+	}
+
+	until := Date(2024, time.January, 5, 9, 0, 0, 0, time.UTC)
+	daily := RecurrenceRule{Frequency: RecurDaily, Interval: 2, Until: until}.Occurrences(wed, 100)
+	if len(daily) != 2 || !daily[1].Equal(until) {
+		t.Errorf("daily occurrences = %v", daily)
+	}
+
+	leap := Date(2024, time.February, 29, 0, 0, 0, 0, time.UTC)
+	yearly := RecurrenceRule{Frequency: RecurYearly}.Occurrences(leap, 2)
+	if len(yearly) != 2 || yearly[1].Year() != 2028 {
+		t.Errorf("yearly occurrences = %v", yearly)
+	}
+}
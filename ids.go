@@ -0,0 +1,166 @@
+package chronogo
+
+import (
+	"crypto/rand"
+	"encoding/binary"
+	"fmt"
+	"math/big"
+	"strings"
+	"time"
+)
+
+// ULIDs and KSUIDs are sortable IDs whose leading bits are a timestamp. The helpers
+// below read and write that timestamp: ULIDs hold Unix milliseconds in 48 bits
+// (the first 10 of 26 Crockford base32 characters); KSUIDs hold seconds since
+// 2014-05-13 16:53:20 UTC in 32 bits (the first 4 of 20 bytes, base62-encoded to 27
+// characters).
+
+const (
+	crockfordAlphabet = "0123456789ABCDEFGHJKMNPQRSTVWXYZ"
+	base62Alphabet    = "0123456789ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz"
+
+	ulidLength  = 26
+	ulidMaxTime = 1<<48 - 1
+
+	ksuidLength = 27
+	ksuidEpoch  = 1400000000
+)
+
+// FromULID returns the timestamp of a ULID in UTC, with millisecond precision.
+// Decoding is case-insensitive. It returns an error wrapping ErrInvalidFormat if id is
+// not a valid ULID.
+//
+// Example:
+//
+//	dt, err := chronogo.FromULID("01ARZ3NDEKTSV4RRFFQ69G5FAV")
+//	// 2016-07-30 23:54:10.259 UTC
+func FromULID(id string) (DateTime, error) {
+	if len(id) != ulidLength {
+		return DateTime{}, ParseError(id, fmt.Errorf("%w: ULID must be %d characters", ErrInvalidFormat, ulidLength))
+	}
+	upper := strings.ToUpper(id)
+	for i := 0; i < ulidLength; i++ {
+		if strings.IndexByte(crockfordAlphabet, upper[i]) < 0 {
+			return DateTime{}, ParseError(id, fmt.Errorf("%w: invalid ULID character %q", ErrInvalidFormat, id[i]))
+		}
+	}
+	// 10 characters carry 50 bits; a ULID timestamp is 48, so the first must be 0-7
+	if upper[0] > '7' {
+		return DateTime{}, ParseError(id, fmt.Errorf("%w: ULID timestamp overflows 48 bits", ErrInvalidFormat))
+	}
+
+	var ms int64
+	for i := 0; i < 10; i++ {
+		ms = ms<<5 | int64(strings.IndexByte(crockfordAlphabet, upper[i]))
+	}
+	return FromUnixMilli(ms, time.UTC), nil
+}
+
+// ULIDTimestamp returns the timestamp of a ULID, or the zero DateTime if id is not a
+// valid ULID. Use FromULID to tell invalid IDs apart.
+func ULIDTimestamp(id string) DateTime {
+	dt, err := FromULID(id)
+	if err != nil {
+		return DateTime{}
+	}
+	return dt
+}
+
+// NewULIDAt returns a new ULID carrying dt's timestamp (truncated to the
+// millisecond) and 80 random bits. IDs made at different milliseconds sort in time
+// order. It returns an error wrapping ErrInvalidRange if dt is before 1970 or beyond
+// the year 10889, the limit of the 48-bit timestamp.
+//
+// Example:
+//
+//	id, err := chronogo.NewULIDAt(order.CreatedAt)
+func NewULIDAt(dt DateTime) (string, error) {
+	ms := dt.UnixMilli()
+	if ms < 0 || ms > ulidMaxTime {
+		return "", fmt.Errorf("%w: %s is outside the ULID timestamp range", ErrInvalidRange, dt.ToISO8601String())
+	}
+
+	var entropy [10]byte
+	if _, err := rand.Read(entropy[:]); err != nil {
+		return "", err
+	}
+
+	var b [ulidLength]byte
+	for i := 9; i >= 0; i-- {
+		b[i] = crockfordAlphabet[ms&31]
+		ms >>= 5
+	}
+	// 80 random bits as 16 characters of 5 bits each
+	hi := uint64(binary.BigEndian.Uint16(entropy[:2]))
+	lo := binary.BigEndian.Uint64(entropy[2:])
+	for i := ulidLength - 1; i >= 10; i-- {
+		b[i] = crockfordAlphabet[lo&31]
+		lo = lo>>5 | (hi&31)<<59
+		hi >>= 5
+	}
+	return string(b[:]), nil
+}
+
+// FromKSUID returns the timestamp of a KSUID in UTC, with second precision. It
+// returns an error wrapping ErrInvalidFormat if id is not a valid KSUID.
+//
+// Example:
+//
+//	dt, err := chronogo.FromKSUID("0ujtsYcgvSTl8PAuAdqWYSMnLOv")
+//	// 2017-10-10 04:00:47 UTC
+func FromKSUID(id string) (DateTime, error) {
+	raw, err := decodeKSUID(id)
+	if err != nil {
+		return DateTime{}, ParseError(id, err)
+	}
+	seconds := int64(binary.BigEndian.Uint32(raw[:4])) + ksuidEpoch
+	return FromUnix(seconds, 0, time.UTC), nil
+}
+
+// NewKSUIDAt returns a new KSUID carrying dt's timestamp (truncated to the second)
+// and 128 random bits. It returns an error wrapping ErrInvalidRange if dt is outside
+// the KSUID range, 2014-05-13 to 2150-06-19.
+func NewKSUIDAt(dt DateTime) (string, error) {
+	seconds := dt.Unix() - ksuidEpoch
+	if seconds < 0 || seconds > 1<<32-1 {
+		return "", fmt.Errorf("%w: %s is outside the KSUID timestamp range", ErrInvalidRange, dt.ToISO8601String())
+	}
+
+	var raw [20]byte
+	binary.BigEndian.PutUint32(raw[:4], uint32(seconds))
+	if _, err := rand.Read(raw[4:]); err != nil {
+		return "", err
+	}
+
+	n := new(big.Int).SetBytes(raw[:])
+	base := big.NewInt(62)
+	mod := new(big.Int)
+	var b [ksuidLength]byte
+	for i := ksuidLength - 1; i >= 0; i-- {
+		n.DivMod(n, base, mod)
+		b[i] = base62Alphabet[mod.Int64()]
+	}
+	return string(b[:]), nil
+}
+
+// decodeKSUID decodes a base62 KSUID into its 20 bytes.
+func decodeKSUID(id string) ([20]byte, error) {
+	var raw [20]byte
+	if len(id) != ksuidLength {
+		return raw, fmt.Errorf("%w: KSUID must be %d characters", ErrInvalidFormat, ksuidLength)
+	}
+	n := new(big.Int)
+	base := big.NewInt(62)
+	for i := 0; i < len(id); i++ {
+		digit := strings.IndexByte(base62Alphabet, id[i])
+		if digit < 0 {
+			return raw, fmt.Errorf("%w: invalid KSUID character %q", ErrInvalidFormat, id[i])
+		}
+		n.Mul(n, base).Add(n, big.NewInt(int64(digit)))
+	}
+	if n.BitLen() > 160 {
+		return raw, fmt.Errorf("%w: KSUID overflows 20 bytes", ErrInvalidFormat)
+	}
+	n.FillBytes(raw[:])
+	return raw, nil
+}
@@ -0,0 +1,84 @@
+package chronogo
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestFromULID(t *testing.T) {
+	want := Date(2016, time.July, 30, 23, 54, 10, 259000000, time.UTC)
+	for _, id := range []string{"01ARZ3NDEKTSV4RRFFQ69G5FAV", "01arz3ndektsv4rrffq69g5fav"} {
+		dt, err := FromULID(id)
+		if err != nil {
+			t.Fatalf("FromULID(%q) error = %v", id, err)
+		}
+		if !dt.Equal(want) {
+			t.Errorf("FromULID(%q) = %v, want %v", id, dt, want)
+		}
+	}
+
+	for _, id := range []string{"", "01ARZ3NDEK", "01ARZ3NDEKTSV4RRFFQ69G5FAU!", "01ARZ3NDEKTSV4RRFFQ69G5FAI", "81ARZ3NDEKTSV4RRFFQ69G5FAV"} {
+		if _, err := FromULID(id); !errors.Is(err, ErrInvalidFormat) {
+			t.Errorf("FromULID(%q) error = %v, want ErrInvalidFormat", id, err)
+		}
+		if !ULIDTimestamp(id).IsZero() {
+			t.Errorf("ULIDTimestamp(%q) should be zero", id)
+		}
+	}
+}
+
+func TestNewULIDAt(t *testing.T) {
+	dt := Date(2024, time.March, 5, 18, 30, 15, 123456789, time.UTC)
+	id, err := NewULIDAt(dt)
+	if err != nil {
+		t.Fatalf("NewULIDAt() error = %v", err)
+	}
+	if len(id) != 26 {
+		t.Fatalf("NewULIDAt() = %q, want 26 characters", id)
+	}
+	if got := ULIDTimestamp(id); !got.Equal(dt.TruncateToMillisecond()) {
+		t.Errorf("ULIDTimestamp(NewULIDAt()) = %v, want %v", got, dt.TruncateToMillisecond())
+	}
+
+	other, _ := NewULIDAt(dt)
+	if other == id {
+		t.Error("NewULIDAt() should add random bits")
+	}
+	later, _ := NewULIDAt(dt.AddMilliseconds(1))
+	if later <= id || later <= other {
+		t.Error("ULIDs from later milliseconds should sort after earlier ones")
+	}
+
+	if _, err := NewULIDAt(Date(1969, time.December, 31, 0, 0, 0, 0, time.UTC)); !errors.Is(err, ErrInvalidRange) {
+		t.Errorf("NewULIDAt() before 1970 error = %v, want ErrInvalidRange", err)
+	}
+}
+
+func TestKSUID(t *testing.T) {
+	dt, err := FromKSUID("0ujtsYcgvSTl8PAuAdqWYSMnLOv")
+	if err != nil {
+		t.Fatalf("FromKSUID() error = %v", err)
+	}
+	if want := Date(2017, time.October, 10, 4, 0, 47, 0, time.UTC); !dt.Equal(want) {
+		t.Errorf("FromKSUID() = %v, want %v", dt, want)
+	}
+
+	at := Date(2024, time.March, 5, 18, 30, 15, 500000000, time.UTC)
+	id, err := NewKSUIDAt(at)
+	if err != nil {
+		t.Fatalf("NewKSUIDAt() error = %v", err)
+	}
+	if got, err := FromKSUID(id); err != nil || !got.Equal(at.Truncate(UnitSecond)) {
+		t.Errorf("FromKSUID(NewKSUIDAt()) = %v, %v", got, err)
+	}
+
+	for _, id := range []string{"short", "0ujtsYcgvSTl8PAuAdqWYSMnLO_", "zzzzzzzzzzzzzzzzzzzzzzzzzzz"} {
+		if _, err := FromKSUID(id); !errors.Is(err, ErrInvalidFormat) {
+			t.Errorf("FromKSUID(%q) error = %v, want ErrInvalidFormat", id, err)
+		}
+	}
+	if _, err := NewKSUIDAt(Date(2010, time.January, 1, 0, 0, 0, 0, time.UTC)); !errors.Is(err, ErrInvalidRange) {
+		t.Errorf("NewKSUIDAt() before the epoch error = %v, want ErrInvalidRange", err)
+	}
+}
@@ -0,0 +1,169 @@
+package chronogo
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// The wrapper types below fix the JSON wire format of a single struct field, so
+// models can mix formats without custom MarshalJSON methods. Each embeds DateTime,
+// so the full API remains available on the field. Like DateTime, they write null
+// for the zero value when SetMarshalZeroAsNull is enabled and read null as zero.
+//
+// Example:
+//
+//	type Invoice struct {
+//		IssuedOn  chronogo.DateOnly    `json:"issued_on"`  // "2024-06-15"
+//		CreatedAt chronogo.UnixSeconds `json:"created_at"` // 1718461800
+//	}
+
+// DateOnly is a DateTime that marshals to JSON as a calendar date, "2006-01-02".
+// Unmarshaled values are midnight UTC.
+type DateOnly struct {
+	DateTime
+}
+
+// MarshalJSON implements json.Marshaler.
+func (d DateOnly) MarshalJSON() ([]byte, error) {
+	return marshalLayout(d.DateTime, time.DateOnly)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (d *DateOnly) UnmarshalJSON(data []byte) error {
+	return unmarshalLayout(data, &d.DateTime, time.DateOnly)
+}
+
+// TimeOnly is a DateTime that marshals to JSON as a wall-clock time, "15:04:05".
+// Unmarshaled values fall on January 1 of year 0 in UTC, like time.Parse, and
+// "15:04" is accepted as well.
+type TimeOnly struct {
+	DateTime
+}
+
+// MarshalJSON implements json.Marshaler.
+func (t TimeOnly) MarshalJSON() ([]byte, error) {
+	return marshalLayout(t.DateTime, time.TimeOnly)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (t *TimeOnly) UnmarshalJSON(data []byte) error {
+	if err := unmarshalLayout(data, &t.DateTime, time.TimeOnly); err != nil {
+		return unmarshalLayout(data, &t.DateTime, "15:04")
+	}
+	return nil
+}
+
+// UnixSeconds is a DateTime that marshals to JSON as a Unix timestamp in whole
+// seconds. Unmarshaled values are in UTC.
+type UnixSeconds struct {
+	DateTime
+}
+
+// MarshalJSON implements json.Marshaler.
+func (u UnixSeconds) MarshalJSON() ([]byte, error) {
+	if u.IsZero() && marshalZeroAsNull.Load() {
+		return []byte("null"), nil
+	}
+	return strconv.AppendInt(nil, u.Unix(), 10), nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (u *UnixSeconds) UnmarshalJSON(data []byte) error {
+	return unmarshalUnix(data, &u.DateTime, FromUnixUTC)
+}
+
+// UnixMillis is a DateTime that marshals to JSON as a Unix timestamp in
+// milliseconds, as JavaScript's Date.now() produces. Unmarshaled values are in UTC.
+type UnixMillis struct {
+	DateTime
+}
+
+// MarshalJSON implements json.Marshaler.
+func (u UnixMillis) MarshalJSON() ([]byte, error) {
+	if u.IsZero() && marshalZeroAsNull.Load() {
+		return []byte("null"), nil
+	}
+	return strconv.AppendInt(nil, u.UnixMilli(), 10), nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (u *UnixMillis) UnmarshalJSON(data []byte) error {
+	return unmarshalUnix(data, &u.DateTime, FromUnixMilliUTC)
+}
+
+// Layout supplies the Go time layout of a Formatted field.
+type Layout interface {
+	Layout() string
+}
+
+// Formatted is a DateTime that marshals to JSON with the layout of L, for formats
+// the concrete wrappers do not cover. Unmarshaled values without an offset are in UTC.
+//
+// Example:
+//
+//	type euroDate struct{}
+//
+//	func (euroDate) Layout() string { return "02.01.2006" }
+//
+//	type Booking struct {
+//		Arrival chronogo.Formatted[euroDate] `json:"arrival"` // "15.06.2024"
+//	}
+type Formatted[L Layout] struct {
+	DateTime
+}
+
+// MarshalJSON implements json.Marshaler.
+func (f Formatted[L]) MarshalJSON() ([]byte, error) {
+	var layout L
+	return marshalLayout(f.DateTime, layout.Layout())
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (f *Formatted[L]) UnmarshalJSON(data []byte) error {
+	var layout L
+	return unmarshalLayout(data, &f.DateTime, layout.Layout())
+}
+
+// marshalLayout writes dt as a JSON string in layout.
+func marshalLayout(dt DateTime, layout string) ([]byte, error) {
+	if dt.IsZero() && marshalZeroAsNull.Load() {
+		return []byte("null"), nil
+	}
+	return json.Marshal(dt.Format(layout))
+}
+
+// unmarshalLayout reads a JSON string in layout into dt.
+func unmarshalLayout(data []byte, dt *DateTime, layout string) error {
+	if isJSONNull(data) {
+		*dt = DateTime{}
+		return nil
+	}
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return ParseError(string(data), fmt.Errorf("%w: expected a JSON string", ErrInvalidFormat))
+	}
+	t, err := time.ParseInLocation(layout, strings.TrimSpace(s), time.UTC)
+	if err != nil {
+		return FormatError(layout, fmt.Errorf("%w: %v", ErrInvalidFormat, err))
+	}
+	*dt = DateTime{t}
+	return nil
+}
+
+// unmarshalUnix reads a JSON integer (or a string holding one) into dt.
+func unmarshalUnix(data []byte, dt *DateTime, from func(int64) DateTime) error {
+	if isJSONNull(data) {
+		*dt = DateTime{}
+		return nil
+	}
+	s := strings.Trim(strings.TrimSpace(string(data)), `"`)
+	n, err := strconv.ParseInt(s, 10, 64)
+	if err != nil {
+		return ParseError(string(data), fmt.Errorf("%w: expected an integer Unix timestamp", ErrInvalidFormat))
+	}
+	*dt = from(n)
+	return nil
+}
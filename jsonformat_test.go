@@ -0,0 +1,101 @@
+package chronogo
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+)
+
+type euroDate struct{}
+
+func (euroDate) Layout() string { return "02.01.2006" }
+
+func TestJSONFormatWrappers(t *testing.T) {
+	type model struct {
+		Date    DateOnly            `json:"date"`
+		Time    TimeOnly            `json:"time"`
+		Seconds UnixSeconds         `json:"seconds"`
+		Millis  UnixMillis          `json:"millis"`
+		Euro    Formatted[euroDate] `json:"euro"`
+	}
+
+	dt := Date(2024, time.June, 15, 14, 30, 5, 250000000, time.UTC)
+	m := model{
+		Date:    DateOnly{dt},
+		Time:    TimeOnly{dt},
+		Seconds: UnixSeconds{dt},
+		Millis:  UnixMillis{dt},
+		Euro:    Formatted[euroDate]{dt},
+	}
+	data, err := json.Marshal(m)
+	if err != nil {
+		t.Fatalf("Marshal() error = %v", err)
+	}
+	want := `{"date":"2024-06-15","time":"14:30:05","seconds":1718461805,"millis":1718461805250,"euro":"15.06.2024"}`
+	if string(data) != want {
+		t.Errorf("Marshal() = %s, want %s", data, want)
+	}
+
+	var back model
+	if err := json.Unmarshal(data, &back); err != nil {
+		t.Fatalf("Unmarshal() error = %v", err)
+	}
+	if !back.Date.Equal(Date(2024, time.June, 15, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("Date = %v", back.Date)
+	}
+	if back.Time.Hour() != 14 || back.Time.Minute() != 30 || back.Time.Second() != 5 {
+		t.Errorf("Time = %v", back.Time)
+	}
+	if !back.Seconds.Equal(dt.Truncate(UnitSecond)) || !back.Millis.Equal(dt) {
+		t.Errorf("Seconds = %v, Millis = %v", back.Seconds, back.Millis)
+	}
+	if back.Euro.Day() != 15 || back.Euro.Month() != time.June {
+		t.Errorf("Euro = %v", back.Euro)
+	}
+
+	// The embedded DateTime API is available on the field
+	if back.Date.AddDays(1).Day() != 16 {
+		t.Error("wrapper should expose DateTime methods")
+	}
+}
+
+func TestJSONFormatWrappersLenientInput(t *testing.T) {
+	var tm TimeOnly
+	if err := json.Unmarshal([]byte(`"09:15"`), &tm); err != nil || tm.Hour() != 9 || tm.Minute() != 15 {
+		t.Errorf("TimeOnly from HH:MM = %v, %v", tm, err)
+	}
+	var secs UnixSeconds
+	if err := json.Unmarshal([]byte(`"1718461805"`), &secs); err != nil || secs.Unix() != 1718461805 {
+		t.Errorf("UnixSeconds from string = %v, %v", secs, err)
+	}
+
+	var d DateOnly
+	for _, input := range []string{`"2024/06/15"`, `"2024-06-15T00:00:00Z"`, `20240615`} {
+		if err := json.Unmarshal([]byte(input), &d); !errors.Is(err, ErrInvalidFormat) {
+			t.Errorf("DateOnly from %s error = %v, want ErrInvalidFormat", input, err)
+		}
+	}
+	var ms UnixMillis
+	if err := json.Unmarshal([]byte(`1.5`), &ms); !errors.Is(err, ErrInvalidFormat) {
+		t.Errorf("UnixMillis from 1.5 error = %v, want ErrInvalidFormat", err)
+	}
+}
+
+func TestJSONFormatWrappersNull(t *testing.T) {
+	defer SetMarshalZeroAsNull(false)
+
+	type model struct {
+		Date    DateOnly    `json:"date"`
+		Seconds UnixSeconds `json:"seconds"`
+	}
+	m := model{Date: DateOnly{Date(2024, time.June, 15, 0, 0, 0, 0, time.UTC)}, Seconds: UnixSeconds{Date(2024, time.June, 15, 0, 0, 0, 0, time.UTC)}}
+	if err := json.Unmarshal([]byte(`{"date":null,"seconds":null}`), &m); err != nil || !m.Date.IsZero() || !m.Seconds.IsZero() {
+		t.Errorf("Unmarshal(null) = %+v, %v", m, err)
+	}
+
+	SetMarshalZeroAsNull(true)
+	if data, _ := json.Marshal(model{}); string(data) != `{"date":null,"seconds":null}` {
+		t.Errorf("Marshal(zero) = %s", data)
+	}
+}
@@ -0,0 +1,104 @@
+package chronogo
+
+import "sort"
+
+// DateTime values make poor map keys: the struct holds a location pointer and a
+// monotonic clock reading, so the same instant seen from two zones (or read from
+// two clocks) gives two different keys. Use UTCKey to key by instant, DateKey to key
+// by calendar day, or DateTimeSet for a set of instants.
+
+// UTCKey returns a canonical key for the instant dt represents: its Unix time in
+// nanoseconds. Equal instants give equal keys whatever their location. The key is
+// only meaningful for years 1678 to 2262, the range of UnixNano.
+//
+// Example:
+//
+//	seen := map[int64]bool{}
+//	seen[dt.UTCKey()] = true
+//	seen[dt.In(tokyo).UTCKey()] // true
+func (dt DateTime) UTCKey() int64 {
+	return dt.UnixNano()
+}
+
+// DateKey returns the calendar date of dt in its own location as an integer of the
+// form YYYYMMDD. Keys sort in date order, which makes them convenient for daily
+// buckets and lookups.
+//
+// Example:
+//
+//	chronogo.Date(2024, 3, 5, 18, 0, 0, 0, time.UTC).DateKey() // 20240305
+func (dt DateTime) DateKey() int {
+	return dt.Year()*10000 + int(dt.Month())*100 + dt.Day()
+}
+
+// instantKey identifies an instant over the full range of time.Time.
+type instantKey struct {
+	sec  int64
+	nsec int
+}
+
+func keyOf(dt DateTime) instantKey {
+	return instantKey{sec: dt.Unix(), nsec: dt.Nanosecond()}
+}
+
+// DateTimeSet is a set of instants. Values equal under DateTime.Equal are the same
+// member even when they carry different locations; the first value added is kept.
+// The zero value is not usable; create sets with NewDateTimeSet.
+type DateTimeSet struct {
+	members map[instantKey]DateTime
+}
+
+// NewDateTimeSet creates a set holding the given values.
+//
+// Example:
+//
+//	set := chronogo.NewDateTimeSet(dt, dt.In(tokyo), dt.AddHours(1))
+//	set.Len() // 2
+func NewDateTimeSet(dts ...DateTime) *DateTimeSet {
+	s := &DateTimeSet{members: make(map[instantKey]DateTime, len(dts))}
+	for _, dt := range dts {
+		s.Add(dt)
+	}
+	return s
+}
+
+// Add adds dt to the set and reports whether it was not already present.
+func (s *DateTimeSet) Add(dt DateTime) bool {
+	key := keyOf(dt)
+	if _, ok := s.members[key]; ok {
+		return false
+	}
+	s.members[key] = dt
+	return true
+}
+
+// Contains reports whether the set holds the instant dt.
+func (s *DateTimeSet) Contains(dt DateTime) bool {
+	_, ok := s.members[keyOf(dt)]
+	return ok
+}
+
+// Remove removes the instant dt from the set and reports whether it was present.
+func (s *DateTimeSet) Remove(dt DateTime) bool {
+	key := keyOf(dt)
+	if _, ok := s.members[key]; !ok {
+		return false
+	}
+	delete(s.members, key)
+	return true
+}
+
+// Len returns the number of instants in the set.
+func (s *DateTimeSet) Len() int {
+	return len(s.members)
+}
+
+// Values returns the members in chronological order.
+func (s *DateTimeSet) Values() []DateTime {
+	values := make([]DateTime, 0, len(s.members))
+	for _, dt := range s.members {
+		values = append(values, dt)
+	}
+	sort.Slice(values, func(i, j int) bool { return values[i].Before(values[j]) })
+	return values
+}
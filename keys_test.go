@@ -0,0 +1,69 @@
+package chronogo
+
+import (
+	"testing"
+	"time"
+)
+
+func TestCanonicalKeys(t *testing.T) {
+	dt := Date(2024, time.March, 5, 18, 0, 0, 0, time.UTC)
+	tokyo := FixedZone("JST", 9*3600)
+
+	if dt.UTCKey() != dt.In(tokyo).UTCKey() {
+		t.Error("Expected the same instant in two zones to share a UTCKey")
+	}
+	if dt.UTCKey() == dt.AddNanoseconds(1).UTCKey() {
+		t.Error("Expected different instants to have different UTCKeys")
+	}
+
+	if got := dt.DateKey(); got != 20240305 {
+		t.Errorf("DateKey() = %d, want 20240305", got)
+	}
+	// 18:00 UTC is already the next day in Tokyo
+	if got := dt.In(tokyo).DateKey(); got != 20240306 {
+		t.Errorf("DateKey() in Tokyo = %d, want 20240306", got)
+	}
+
+	counts := map[int]int{}
+	for _, d := range []DateTime{dt, dt.AddHours(2), dt.AddDays(1)} {
+		counts[d.DateKey()]++
+	}
+	if counts[20240305] != 2 || counts[20240306] != 1 {
+		t.Errorf("DateKey buckets = %v", counts)
+	}
+}
+
+func TestDateTimeSet(t *testing.T) {
+	dt := Date(2024, time.March, 5, 18, 0, 0, 0, time.UTC)
+	tokyo := FixedZone("JST", 9*3600)
+
+	set := NewDateTimeSet(dt.AddHours(1), dt, dt.In(tokyo))
+	if set.Len() != 2 {
+		t.Fatalf("Len() = %d, want 2", set.Len())
+	}
+	if !set.Contains(dt.In(tokyo)) || set.Contains(dt.AddHours(2)) {
+		t.Error("Contains() should match by instant")
+	}
+	if set.Add(dt.UTC()) {
+		t.Error("Add() of an existing instant should return false")
+	}
+
+	values := set.Values()
+	if len(values) != 2 || !values[0].Equal(dt) || !values[1].Equal(dt.AddHours(1)) {
+		t.Errorf("Values() = %v, want chronological order", values)
+	}
+	if values[0].Location() != time.UTC {
+		t.Errorf("Values() kept %v, want the first value added", values[0].Location())
+	}
+
+	if !set.Remove(dt.In(tokyo)) || set.Remove(dt) || set.Len() != 1 {
+		t.Error("Remove() should delete by instant once")
+	}
+
+	// Instants beyond the UnixNano range remain distinct
+	far := Date(3000, time.January, 1, 0, 0, 0, 0, time.UTC)
+	set = NewDateTimeSet(far, far.AddDays(1))
+	if set.Len() != 2 {
+		t.Errorf("Len() = %d for far-future instants, want 2", set.Len())
+	}
+}
@@ -0,0 +1,141 @@
+package chronogo
+
+import (
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+)
+
+// Go's time package, like POSIX time, ignores leap seconds: every UTC day has 86400
+// seconds. The table below records the leap seconds inserted since 1972 so that
+// instants can be converted to and from TAI (International Atomic Time), and so that
+// log lines stamped 23:59:60 can be parsed.
+
+// leapSecondDays lists the UTC dates whose last minute had 61 seconds, as published
+// in IERS Bulletin C. No leap second has been scheduled after 2016.
+var leapSecondDays = []struct {
+	year  int
+	month time.Month
+	day   int
+}{
+	{1972, time.June, 30}, {1972, time.December, 31}, {1973, time.December, 31},
+	{1974, time.December, 31}, {1975, time.December, 31}, {1976, time.December, 31},
+	{1977, time.December, 31}, {1978, time.December, 31}, {1979, time.December, 31},
+	{1981, time.June, 30}, {1982, time.June, 30}, {1983, time.June, 30},
+	{1985, time.June, 30}, {1987, time.December, 31}, {1989, time.December, 31},
+	{1990, time.December, 31}, {1992, time.June, 30}, {1993, time.June, 30},
+	{1994, time.June, 30}, {1995, time.December, 31}, {1997, time.June, 30},
+	{1998, time.December, 31}, {2005, time.December, 31}, {2008, time.December, 31},
+	{2012, time.June, 30}, {2015, time.June, 30}, {2016, time.December, 31},
+}
+
+// taiBaseOffset is TAI−UTC when leap seconds were introduced on 1972-01-01.
+const taiBaseOffset = 10 * time.Second
+
+// leapSecondEnds holds, for each leap second, the Unix time of the midnight that
+// follows it, in ascending order. TAI−UTC grows by one second at each of them.
+var leapSecondEnds = func() []int64 {
+	ends := make([]int64, len(leapSecondDays))
+	for i, d := range leapSecondDays {
+		ends[i] = time.Date(d.year, d.month, d.day+1, 0, 0, 0, 0, time.UTC).Unix()
+	}
+	return ends
+}()
+
+// TAIOffset returns TAI−UTC at dt: 10 seconds on 1972-01-01 plus one second for
+// each leap second since, 37 seconds from 2017 on. Dates before 1972, when UTC
+// was kept close to atomic time by varying the length of its second, use 10 seconds.
+//
+// Example:
+//
+//	chronogo.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC).TAIOffset() // 37s
+func (dt DateTime) TAIOffset() time.Duration {
+	unix := dt.Unix()
+	passed := sort.Search(len(leapSecondEnds), func(i int) bool { return leapSecondEnds[i] > unix })
+	return taiBaseOffset + time.Duration(passed)*time.Second
+}
+
+// IsLeapSecondDay reports whether the UTC day containing dt ended with a leap second
+// (a 23:59:60 second).
+//
+// Example:
+//
+//	chronogo.Date(2016, 12, 31, 12, 0, 0, 0, time.UTC).IsLeapSecondDay() // true
+func (dt DateTime) IsLeapSecondDay() bool {
+	y, m, d := dt.UTC().Date()
+	next := time.Date(y, m, d+1, 0, 0, 0, 0, time.UTC).Unix()
+	i := sort.Search(len(leapSecondEnds), func(i int) bool { return leapSecondEnds[i] >= next })
+	return i < len(leapSecondEnds) && leapSecondEnds[i] == next
+}
+
+// ToTAI returns dt on the TAI time scale, as a UTC DateTime whose clock reads TAI.
+// Use FromTAI to convert back.
+//
+// Example:
+//
+//	chronogo.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC).ToTAI() // 2024-01-01 00:00:37
+func (dt DateTime) ToTAI() DateTime {
+	return DateTime{dt.Time.UTC().Add(dt.TAIOffset())}
+}
+
+// FromTAI converts a TAI reading (a DateTime whose UTC clock reads TAI) to UTC. The
+// TAI second that is a leap second has no UTC equivalent in Go; like POSIX time it
+// maps onto the first second of the following day.
+func FromTAI(tai DateTime) DateTime {
+	t := tai.Time.UTC()
+	offset := taiBaseOffset
+	for i, end := range leapSecondEnds {
+		// TAI reads end + offset when UTC reaches the midnight after leap second i
+		if t.Before(time.Unix(end, 0).Add(taiBaseOffset + time.Duration(i+1)*time.Second)) {
+			break
+		}
+		offset = taiBaseOffset + time.Duration(i+1)*time.Second
+	}
+	return DateTime{t.Add(-offset)}
+}
+
+// FromTAISmeared converts a TAI reading to UTC-SLS (UTC with smoothed leap seconds):
+// over the 1000 UTC seconds before a leap second's end, the clock runs slow by
+// 1/1001 so that the leap second is absorbed and no instant repeats. Outside those
+// windows the result equals FromTAI.
+func FromTAISmeared(tai DateTime) DateTime {
+	t := tai.Time.UTC()
+	for i, end := range leapSecondEnds {
+		newOffset := taiBaseOffset + time.Duration(i+1)*time.Second
+		windowEnd := time.Unix(end, 0).Add(newOffset)
+		windowStart := windowEnd.Add(-1001 * time.Second)
+		if !t.Before(windowStart) && t.Before(windowEnd) {
+			elapsed := t.Sub(windowStart)
+			smeared := time.Duration(int64(elapsed) * 1000 / 1001)
+			return DateTime{time.Unix(end, 0).Add(-1000*time.Second + smeared).UTC()}
+		}
+	}
+	return FromTAI(tai)
+}
+
+// leapSecondPattern matches a clock reading with a seconds field of 60.
+var leapSecondPattern = regexp.MustCompile(`^(.*\d{2}:\d{2}):60([.,]\d+)?(.*)$`)
+
+// parseLeapSecond parses a technical timestamp whose seconds field is 60, such as
+// "2016-12-31T23:59:60Z". The input is accepted only if it names an actual leap
+// second; like POSIX time, the result is the first instant of the following UTC day
+// plus any fractional second.
+func parseLeapSecond(value string, loc *time.Location) (DateTime, bool) {
+	if !strings.Contains(value, ":60") {
+		return DateTime{}, false
+	}
+	m := leapSecondPattern.FindStringSubmatch(value)
+	if m == nil {
+		return DateTime{}, false
+	}
+	dt, ok := tryStrictFormats(m[1]+":59"+m[2]+m[3], loc)
+	if !ok {
+		return DateTime{}, false
+	}
+	utc := dt.UTC()
+	if utc.Hour() != 23 || utc.Minute() != 59 || utc.Second() != 59 || !dt.IsLeapSecondDay() {
+		return DateTime{}, false
+	}
+	return dt.Add(time.Second), true
+}
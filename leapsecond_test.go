@@ -0,0 +1,117 @@
+package chronogo
+
+import (
+	"testing"
+	"time"
+)
+
+func TestTAIOffset(t *testing.T) {
+	tests := []struct {
+		dt   DateTime
+		want time.Duration
+	}{
+		{Date(1970, time.January, 1, 0, 0, 0, 0, time.UTC), 10 * time.Second},
+		{Date(1972, time.June, 30, 23, 59, 59, 0, time.UTC), 10 * time.Second},
+		{Date(1972, time.July, 1, 0, 0, 0, 0, time.UTC), 11 * time.Second},
+		{Date(2016, time.December, 31, 23, 59, 59, 999999999, time.UTC), 36 * time.Second},
+		{Date(2017, time.January, 1, 0, 0, 0, 0, time.UTC), 37 * time.Second},
+		{Date(2024, time.June, 1, 0, 0, 0, 0, time.UTC), 37 * time.Second},
+		// 09:00 in Tokyo is midnight UTC, when the 2017 leap second took effect
+		{Date(2017, time.January, 1, 9, 0, 0, 0, FixedZone("JST", 9*3600)), 37 * time.Second},
+	}
+	for _, tt := range tests {
+		if got := tt.dt.TAIOffset(); got != tt.want {
+			t.Errorf("TAIOffset(%v) = %v, want %v", tt.dt, got, tt.want)
+		}
+	}
+}
+
+func TestIsLeapSecondDay(t *testing.T) {
+	if !Date(2016, time.December, 31, 12, 0, 0, 0, time.UTC).IsLeapSecondDay() {
+		t.Error("2016-12-31 ended with a leap second")
+	}
+	if !Date(2015, time.June, 30, 0, 0, 0, 0, time.UTC).IsLeapSecondDay() {
+		t.Error("2015-06-30 ended with a leap second")
+	}
+	if Date(2016, time.December, 30, 12, 0, 0, 0, time.UTC).IsLeapSecondDay() {
+		t.Error("2016-12-30 did not end with a leap second")
+	}
+	// The UTC date decides: 2017-01-01 08:00 in Tokyo is still 2016-12-31 UTC
+	if !Date(2017, time.January, 1, 8, 0, 0, 0, FixedZone("JST", 9*3600)).IsLeapSecondDay() {
+		t.Error("IsLeapSecondDay should use the UTC date")
+	}
+}
+
+func TestTAIConversion(t *testing.T) {
+	dt := Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC)
+	tai := dt.ToTAI()
+	if want := Date(2024, time.January, 1, 0, 0, 37, 0, time.UTC); !tai.Equal(want) {
+		t.Errorf("ToTAI() = %v, want %v", tai, want)
+	}
+	if back := FromTAI(tai); !back.Equal(dt) {
+		t.Errorf("FromTAI(ToTAI()) = %v, want %v", back, dt)
+	}
+
+	// Around the 2016 leap second: TAI 2017-01-01 00:00:35 is UTC 23:59:59,
+	// 00:00:36 the leap second itself, 00:00:37 UTC midnight
+	before := FromTAI(Date(2017, time.January, 1, 0, 0, 35, 0, time.UTC))
+	leap := FromTAI(Date(2017, time.January, 1, 0, 0, 36, 500000000, time.UTC))
+	after := FromTAI(Date(2017, time.January, 1, 0, 0, 37, 0, time.UTC))
+	midnight := Date(2017, time.January, 1, 0, 0, 0, 0, time.UTC)
+	if !before.Equal(midnight.Add(-time.Second)) || !leap.Equal(midnight.Add(500*time.Millisecond)) || !after.Equal(midnight) {
+		t.Errorf("FromTAI around leap second = %v, %v, %v", before, leap, after)
+	}
+}
+
+func TestFromTAISmeared(t *testing.T) {
+	midnight := Date(2017, time.January, 1, 0, 0, 0, 0, time.UTC)
+	windowEnd := midnight.Add(37 * time.Second)
+
+	if got := FromTAISmeared(windowEnd); !got.Equal(midnight) {
+		t.Errorf("FromTAISmeared(window end) = %v, want %v", got, midnight)
+	}
+	if got := FromTAISmeared(windowEnd.Add(-1001 * time.Second)); !got.Equal(midnight.Add(-1000 * time.Second)) {
+		t.Errorf("FromTAISmeared(window start) = %v", got)
+	}
+	// Monotonic through the leap second
+	prev := FromTAISmeared(windowEnd.Add(-2 * time.Second))
+	for i := 1; i <= 4; i++ {
+		got := FromTAISmeared(windowEnd.Add(time.Duration(i)*500*time.Millisecond - 2*time.Second))
+		if !got.After(prev) {
+			t.Errorf("FromTAISmeared not increasing: %v after %v", got, prev)
+		}
+		prev = got
+	}
+	dt := Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC)
+	if got := FromTAISmeared(dt.ToTAI()); !got.Equal(dt) {
+		t.Errorf("FromTAISmeared outside a window = %v, want %v", got, dt)
+	}
+}
+
+func TestParseLeapSecond(t *testing.T) {
+	midnight := Date(2017, time.January, 1, 0, 0, 0, 0, time.UTC)
+	tests := []struct {
+		input string
+		want  DateTime
+	}{
+		{"2016-12-31T23:59:60Z", midnight},
+		{"2016-12-31T23:59:60.25Z", midnight.Add(250 * time.Millisecond)},
+		{"2017-01-01T08:59:60+09:00", midnight},
+	}
+	for _, tt := range tests {
+		for _, parse := range []func(string) (DateTime, error){func(s string) (DateTime, error) { return Parse(s) }, ParseStrict} {
+			got, err := parse(tt.input)
+			if err != nil {
+				t.Errorf("parse(%q) error = %v", tt.input, err)
+				continue
+			}
+			if !got.Equal(tt.want) {
+				t.Errorf("parse(%q) = %v, want %v", tt.input, got, tt.want)
+			}
+		}
+	}
+
+	if _, err := ParseStrict("2016-12-30T23:59:60Z"); err == nil {
+		t.Error("ParseStrict should reject :60 on a day without a leap second")
+	}
+}
@@ -2,8 +2,11 @@ package chronogo
 
 import (
 	"fmt"
+	"strconv"
 	"strings"
 	"sync"
+	"sync/atomic"
+	"time"
 )
 
 // Locale represents a specific locale configuration for formatting dates and times.
@@ -18,6 +21,18 @@ type Locale struct {
 	Ordinals     map[int]string           // Ordinal suffixes (1st, 2nd, 3rd, ...)
 	TimeUnits    map[string]TimeUnitNames // Time unit names for human differences
 	DateFormats  map[string]string        // Common date format patterns
+
+	// CalendarFormats holds token patterns for CalendarString, keyed by
+	// "sameDay", "nextDay", "lastDay", "nextWeek", "lastWeek" and "sameElse".
+	CalendarFormats map[string]string
+
+	// DayParts holds day-part names indexed by DayPart (early morning, morning,
+	// afternoon, evening, night).
+	DayParts []string
+
+	// Qualifiers holds patterns wrapping an approximate quantity such as "2 years",
+	// keyed by "about", "over" and "almost" (e.g. "almost %s").
+	Qualifiers map[string]string
 }
 
 // TimeUnitNames contains singular and plural forms for time units
@@ -38,11 +53,13 @@ var (
 		locales: make(map[string]*Locale),
 	}
 
-	// Default locale
-	defaultLocale = "en-US"
+	// Default locale code; an unset value means "en-US"
+	defaultLocale atomic.Value // string
 )
 
-// RegisterLocale registers a new locale in the global registry
+// RegisterLocale registers a new locale in the global registry, replacing any
+// locale with the same code. It is safe for concurrent use. The registry keeps the
+// pointer, so a Locale must not be modified after it has been registered.
 func RegisterLocale(locale *Locale) {
 	localeRegistry.mutex.Lock()
 	defer localeRegistry.mutex.Unlock()
@@ -73,18 +90,22 @@ func GetAvailableLocales() []string {
 	return codes
 }
 
-// SetDefaultLocale sets the default locale for operations
+// SetDefaultLocale sets the default locale for operations.
+// It is safe for concurrent use with the ...Default methods that read it.
 func SetDefaultLocale(code string) error {
 	if _, err := GetLocale(code); err != nil {
 		return err
 	}
-	defaultLocale = code
+	defaultLocale.Store(code)
 	return nil
 }
 
 // GetDefaultLocale returns the current default locale code
 func GetDefaultLocale() string {
-	return defaultLocale
+	if code, ok := defaultLocale.Load().(string); ok {
+		return code
+	}
+	return "en-US"
 }
 
 // FormatLocalized formats the datetime using locale-specific patterns
@@ -97,9 +118,31 @@ func (dt DateTime) FormatLocalized(pattern, localeCode string) (string, error) {
 	return dt.formatWithLocale(pattern, locale), nil
 }
 
+// FormatTokens formats the datetime using chronogo-style tokens and the default locale.
+// In addition to the tokens understood by FromFormatTokens, it supports:
+//   - Do: day of month with the locale's ordinal suffix ("15th", "15e", "15.")
+//   - Q: quarter of the year (1-4)
+//   - GGGG: ISO 8601 week-numbering year
+//   - WW / W: ISO 8601 week number, zero-padded / unpadded
+//   - E: ISO 8601 weekday number (Monday=1 ... Sunday=7)
+//   - d: day of week number (Sunday=0 ... Saturday=6)
+//   - [text]: literal text that is never interpreted as tokens
+//
+// ISO week dates round-trip with FromFormatTokens:
+//
+//	dt.FormatTokens("GGGG-[W]WW-E") // "2024-W24-6"
+//
+// Example:
+//
+//	dt := chronogo.Date(2024, time.June, 15, 0, 0, 0, 0, time.UTC)
+//	dt.FormatTokens("Do MMM YYYY [Q]Q") // "15th Jun 2024 Q2"
+func (dt DateTime) FormatTokens(pattern string) string {
+	return dt.FormatLocalizedDefault(pattern)
+}
+
 // FormatLocalizedDefault formats using the default locale
 func (dt DateTime) FormatLocalizedDefault(pattern string) string {
-	locale, err := GetLocale(defaultLocale)
+	locale, err := GetLocale(GetDefaultLocale())
 	if err != nil {
 		// Fallback to English if default locale fails
 		locale, _ = GetLocale("en-US")
@@ -107,43 +150,106 @@ func (dt DateTime) FormatLocalizedDefault(pattern string) string {
 	return dt.formatWithLocale(pattern, locale)
 }
 
-// formatWithLocale performs the actual formatting with locale data
-func (dt DateTime) formatWithLocale(pattern string, locale *Locale) string {
-	// First, convert all standard tokens to Go format
-	goLayout := convertTokenFormat(pattern)
+// tokenFormatters render tokens that need locale data or have no Go layout equivalent.
+// Longer tokens must come before their prefixes.
+var tokenFormatters = []struct {
+	token  string
+	format func(dt DateTime, locale *Locale) string
+}{
+	{"MMMM", func(dt DateTime, locale *Locale) string {
+		return locale.MonthNames[dt.Month()-1]
+	}},
+	{"MMM", func(dt DateTime, locale *Locale) string {
+		return locale.MonthAbbr[dt.Month()-1]
+	}},
+	{"dddd", func(dt DateTime, locale *Locale) string {
+		return locale.WeekdayNames[dt.Weekday()]
+	}},
+	{"ddd", func(dt DateTime, locale *Locale) string {
+		return locale.WeekdayAbbr[dt.Weekday()]
+	}},
+	{"Do", func(dt DateTime, locale *Locale) string {
+		return strconv.Itoa(dt.Day()) + locale.getOrdinalSuffix(dt.Day())
+	}},
+	{"GGGG", func(dt DateTime, _ *Locale) string {
+		return fmt.Sprintf("%04d", dt.ISOWeekYear())
+	}},
+	{"WW", func(dt DateTime, _ *Locale) string {
+		return fmt.Sprintf("%02d", dt.ISOWeekNumber())
+	}},
+	{"W", func(dt DateTime, _ *Locale) string {
+		return strconv.Itoa(dt.ISOWeekNumber())
+	}},
+	{"Q", func(dt DateTime, _ *Locale) string {
+		return strconv.Itoa(dt.Quarter())
+	}},
+	{"E", func(dt DateTime, _ *Locale) string {
+		return strconv.Itoa((int(dt.Weekday())+6)%7 + 1)
+	}},
+	{"d", func(dt DateTime, _ *Locale) string {
+		return strconv.Itoa(int(dt.Weekday()))
+	}},
+}
 
-	// Format with Go's time package
-	result := dt.Format(goLayout)
+// formatWithLocale performs the actual formatting with locale data.
+// Text inside square brackets is emitted literally, tokens in tokenFormatters
+// (names, Do, Q, ...) are rendered directly, and everything else goes through
+// formatLayoutWithLocale.
+func (dt DateTime) formatWithLocale(pattern string, locale *Locale) string {
+	var b strings.Builder
+	runStart := 0
+	flush := func(end int) {
+		if end > runStart {
+			b.WriteString(dt.formatLayoutWithLocale(pattern[runStart:end], locale))
+		}
+	}
 
-	// Now replace the English parts with localized versions
-	// We need to be careful about the order and use the actual formatted values
+	for i := 0; i < len(pattern); {
+		if pattern[i] == '[' {
+			if j := strings.IndexByte(pattern[i+1:], ']'); j >= 0 {
+				flush(i)
+				b.WriteString(pattern[i+1 : i+1+j])
+				i += j + 2
+				runStart = i
+				continue
+			}
+		}
 
-	// Get the English month/weekday names that Go would have produced
-	englishMonth := dt.Format("January")
-	englishMonthAbbr := dt.Format("Jan")
-	englishWeekday := dt.Format("Monday")
-	englishWeekdayAbbr := dt.Format("Mon")
+		matched := false
+		for _, tf := range tokenFormatters {
+			end := i + len(tf.token)
+			if end > len(pattern) || pattern[i:end] != tf.token {
+				continue
+			}
+			validStart := i == 0 || !isTokenChar(pattern[i-1])
+			validEnd := end == len(pattern) || !isTokenChar(pattern[end])
+			if validStart && validEnd {
+				flush(i)
+				b.WriteString(tf.format(dt, locale))
+				i = end
+				runStart = i
+				matched = true
+				break
+			}
+		}
+		if !matched {
+			i++
+		}
+	}
+	flush(len(pattern))
 
-	// Replace with localized versions
-	localizedMonth := locale.MonthNames[dt.Month()-1]
-	localizedMonthAbbr := locale.MonthAbbr[dt.Month()-1]
-	localizedWeekday := locale.WeekdayNames[dt.Weekday()]
-	localizedWeekdayAbbr := locale.WeekdayAbbr[dt.Weekday()]
+	return b.String()
+}
 
-	// Replace English names with localized names
-	result = strings.ReplaceAll(result, englishMonth, localizedMonth)
-	result = strings.ReplaceAll(result, englishMonthAbbr, localizedMonthAbbr)
-	result = strings.ReplaceAll(result, englishWeekday, localizedWeekday)
-	result = strings.ReplaceAll(result, englishWeekdayAbbr, localizedWeekdayAbbr)
+// formatLayoutWithLocale formats a run of standard tokens through Go's layout
+// engine and then swaps in the locale's AM/PM names. Month and weekday names are
+// rendered by tokenFormatters so abbreviations can't clobber full names.
+func (dt DateTime) formatLayoutWithLocale(pattern string, locale *Locale) string {
+	// First, convert all standard tokens to Go format
+	goLayout := convertTokenFormat(pattern)
 
-	// Handle ordinals - Go's "2nd" format becomes the actual day with suffix
-	if strings.Contains(pattern, "Do") {
-		day := dt.Day()
-		// Go will format "Do" token as "2nd" but we want actual day with localized suffix
-		goOrdinalPattern := dt.Format("2nd") // This gives us the Go-formatted ordinal
-		localizedOrdinal := fmt.Sprintf("%d%s", day, locale.getOrdinalSuffix(day))
-		result = strings.ReplaceAll(result, goOrdinalPattern, localizedOrdinal)
-	}
+	// Format with Go's time package
+	result := dt.Format(goLayout)
 
 	// Handle AM/PM
 	if strings.Contains(pattern, "A") || strings.Contains(pattern, "a") {
@@ -161,6 +267,75 @@ func (dt DateTime) formatWithLocale(pattern string, locale *Locale) string {
 	return result
 }
 
+// CalendarString returns a calendar-style phrase relative to reference (default now)
+// using the default locale, such as "Today at 2:30 PM", "Yesterday at 9:00 AM",
+// "Last Monday at 4:15 PM" or "06/15/2024" for dates more than a week away.
+//
+// Example:
+//
+//	ref := chronogo.Date(2024, 6, 15, 12, 0, 0, 0, time.UTC)
+//	ref.AddDays(1).At(14, 30, 0).CalendarString(ref) // "Tomorrow at 2:30 PM"
+func (dt DateTime) CalendarString(reference ...DateTime) string {
+	locale, err := GetLocale(GetDefaultLocale())
+	if err != nil {
+		// Fallback to English
+		locale, _ = GetLocale("en-US")
+	}
+	return dt.calendarStringWithLocale(calendarReference(reference), locale)
+}
+
+// CalendarStringLocalized returns a calendar-style phrase relative to reference (default now)
+// in the specified locale, e.g. "mañana a las 14:30" for es-ES.
+func (dt DateTime) CalendarStringLocalized(localeCode string, reference ...DateTime) (string, error) {
+	locale, err := GetLocale(localeCode)
+	if err != nil {
+		return "", err
+	}
+	return dt.calendarStringWithLocale(calendarReference(reference), locale), nil
+}
+
+// calendarReference returns the first reference or now.
+func calendarReference(reference []DateTime) DateTime {
+	if len(reference) > 0 {
+		return reference[0]
+	}
+	return Now()
+}
+
+// calendarStringWithLocale picks the locale calendar pattern from the number of
+// calendar days between dt and reference, compared in dt's timezone.
+func (dt DateTime) calendarStringWithLocale(reference DateTime, locale *Locale) string {
+	ref := reference.In(dt.Location())
+	dayStart := time.Date(dt.Year(), dt.Month(), dt.Day(), 0, 0, 0, 0, time.UTC)
+	refStart := time.Date(ref.Year(), ref.Month(), ref.Day(), 0, 0, 0, 0, time.UTC)
+	days := int(dayStart.Sub(refStart).Hours() / 24)
+
+	var key string
+	switch {
+	case days == 0:
+		key = "sameDay"
+	case days == 1:
+		key = "nextDay"
+	case days == -1:
+		key = "lastDay"
+	case days > 1 && days < 7:
+		key = "nextWeek"
+	case days < -1 && days > -7:
+		key = "lastWeek"
+	default:
+		key = "sameElse"
+	}
+
+	pattern, ok := locale.CalendarFormats[key]
+	if !ok {
+		// Locales registered without calendar formats fall back to English phrasing
+		if en, err := GetLocale("en-US"); err == nil {
+			pattern = en.CalendarFormats[key]
+		}
+	}
+	return dt.formatWithLocale(pattern, locale)
+}
+
 // getOrdinalSuffix returns the ordinal suffix for a number in the locale
 func (locale *Locale) getOrdinalSuffix(n int) string {
 	if suffix, exists := locale.Ordinals[n]; exists {
@@ -204,7 +379,7 @@ func (dt DateTime) HumanStringLocalized(localeCode string, other ...DateTime) (s
 
 // HumanStringLocalizedDefault returns a human-readable difference using the default locale
 func (dt DateTime) HumanStringLocalizedDefault(other ...DateTime) string {
-	locale, err := GetLocale(defaultLocale)
+	locale, err := GetLocale(GetDefaultLocale())
 	if err != nil {
 		// Fallback to English
 		locale, _ = GetLocale("en-US")
@@ -222,6 +397,23 @@ func (dt DateTime) HumanStringLocalizedDefault(other ...DateTime) string {
 
 // humanStringWithLocale generates human-readable time differences using locale data
 func (dt DateTime) humanStringWithLocale(reference DateTime, locale *Locale) string {
+	return dt.humanString(reference, locale, false)
+}
+
+// humanUnitLengths are the approximate unit lengths used by human-readable differences.
+var humanUnitLengths = map[string]time.Duration{
+	"second": time.Second,
+	"minute": time.Minute,
+	"hour":   time.Hour,
+	"day":    24 * time.Hour,
+	"week":   7 * 24 * time.Hour,
+	"month":  30 * 24 * time.Hour,
+	"year":   365 * 24 * time.Hour,
+}
+
+// humanString generates a human-readable difference, optionally qualifying values
+// that are not close to a whole number of units ("about", "over", "almost").
+func (dt DateTime) humanString(reference DateTime, locale *Locale, qualify bool) string {
 	duration := dt.Sub(reference)
 	isPast := duration < 0
 	if isPast {
@@ -267,9 +459,35 @@ func (dt DateTime) humanStringWithLocale(reference DateTime, locale *Locale) str
 		}
 	}
 
+	if qualify {
+		if qualifier, qualified := humanQualifier(duration, unit, value); qualifier != "" {
+			return locale.formatQualifiedTimeUnit(unit, qualified, qualifier, isPast)
+		}
+	}
 	return locale.formatTimeUnit(unit, value, isPast)
 }
 
+// humanQualifier picks the qualifier for a duration expressed as value whole units:
+// none when exact, "about" for up to a quarter unit more, "over" up to three quarters,
+// and "almost" (with the value rounded up) beyond that.
+func humanQualifier(duration time.Duration, unit string, value int) (string, int) {
+	length := humanUnitLengths[unit]
+	if length == 0 {
+		return "", value
+	}
+	fraction := float64(duration-time.Duration(value)*length) / float64(length)
+	switch {
+	case fraction <= 0:
+		return "", value
+	case fraction < 0.25:
+		return "about", value
+	case fraction < 0.75:
+		return "over", value
+	default:
+		return "almost", value + 1
+	}
+}
+
 // formatTimeUnit formats a time unit with proper singular/plural and tense
 func (locale *Locale) formatTimeUnit(unit string, value int, isPast bool) string {
 	unitNames, exists := locale.TimeUnits[unit]
@@ -283,21 +501,55 @@ func (locale *Locale) formatTimeUnit(unit string, value int, isPast bool) string
 		unitName = unitNames.Plural
 	}
 
-	// Check for locale-specific patterns
+	return fmt.Sprintf(locale.tensePattern(isPast), value, unitName)
+}
+
+// formatQualifiedTimeUnit formats a time unit like formatTimeUnit, wrapping the
+// quantity ("2 years") in the locale's qualifier pattern ("almost %s").
+func (locale *Locale) formatQualifiedTimeUnit(unit string, value int, qualifier string, isPast bool) string {
+	wrap, ok := locale.Qualifiers[qualifier]
+	if !ok {
+		wrap = qualifier + " %s"
+	}
+
+	unitName := unit
+	if unitNames, exists := locale.TimeUnits[unit]; exists {
+		unitName = unitNames.Singular
+		if value != 1 {
+			unitName = unitNames.Plural
+		}
+	} else if value != 1 {
+		unitName += "s"
+	}
+
+	// Split the tense pattern around its "%d %s" quantity
+	pattern := locale.tensePattern(isPast)
+	start := strings.Index(pattern, "%d")
+	if start < 0 {
+		return fmt.Sprintf(pattern, value, unitName)
+	}
+	end := strings.Index(pattern[start:], "%s")
+	if end < 0 {
+		return fmt.Sprintf(pattern, value, unitName)
+	}
+	end += start + 2
+	quantity := fmt.Sprintf(wrap, fmt.Sprintf(pattern[start:end], value, unitName))
+	return pattern[:start] + quantity + pattern[end:]
+}
+
+// tensePattern returns the locale's past or future pattern taking a count and a unit
+// name, falling back to English.
+func (locale *Locale) tensePattern(isPast bool) string {
 	if patterns, exists := locale.TimeUnits["patterns"]; exists {
 		if isPast {
-			return fmt.Sprintf(patterns.Singular, value, unitName) // past pattern
-		} else {
-			return fmt.Sprintf(patterns.Plural, value, unitName) // future pattern
+			return patterns.Singular // past pattern
 		}
+		return patterns.Plural // future pattern
 	}
-
-	// Default English-style formatting
 	if isPast {
-		return fmt.Sprintf("%d %s ago", value, unitName)
-	} else {
-		return fmt.Sprintf("in %d %s", value, unitName)
+		return "%d %s ago"
 	}
+	return "in %d %s"
 }
 
 // formatFewMoments formats "a few moments" type messages
@@ -328,7 +580,7 @@ func (dt DateTime) GetMonthName(localeCode string) (string, error) {
 
 // GetMonthNameDefault returns the localized month name using default locale
 func (dt DateTime) GetMonthNameDefault() string {
-	name, _ := dt.GetMonthName(defaultLocale)
+	name, _ := dt.GetMonthName(GetDefaultLocale())
 	return name
 }
 
@@ -343,10 +595,48 @@ func (dt DateTime) GetWeekdayName(localeCode string) (string, error) {
 
 // GetWeekdayNameDefault returns the localized weekday name using default locale
 func (dt DateTime) GetWeekdayNameDefault() string {
-	name, _ := dt.GetWeekdayName(defaultLocale)
+	name, _ := dt.GetWeekdayName(GetDefaultLocale())
 	return name
 }
 
+// WeekdayName returns the weekday name in the given locale, such as "Monday" or
+// "lunes". An empty code uses the default locale, and an unknown code falls back to
+// the English name, so the result can be used directly without error handling.
+//
+// Example:
+//
+//	dt.WeekdayName("es-ES") // "sábado"
+func (dt DateTime) WeekdayName(localeCode string) string {
+	return dt.weekdayName(localeCode, false)
+}
+
+// WeekdayShortName returns the abbreviated weekday name in the given locale, such as
+// "Mon" or "lun". Empty and unknown codes are handled as in WeekdayName.
+func (dt DateTime) WeekdayShortName(localeCode string) string {
+	return dt.weekdayName(localeCode, true)
+}
+
+// weekdayName looks up the full or abbreviated weekday name, falling back to English.
+func (dt DateTime) weekdayName(localeCode string, short bool) string {
+	if localeCode == "" {
+		localeCode = GetDefaultLocale()
+	}
+	names := []string(nil)
+	if locale, err := GetLocale(localeCode); err == nil {
+		names = locale.WeekdayNames
+		if short {
+			names = locale.WeekdayAbbr
+		}
+	}
+	if int(dt.Weekday()) < len(names) {
+		return names[dt.Weekday()]
+	}
+	if short {
+		return dt.Weekday().String()[:3]
+	}
+	return dt.Weekday().String()
+}
+
 // init registers default locales
 func init() {
 	registerDefaultLocales()
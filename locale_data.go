@@ -49,6 +49,16 @@ func createEnUSLocale() *Locale {
 			"long":   "January 2, 2006",
 			"full":   "Monday, January 2, 2006",
 		},
+		CalendarFormats: map[string]string{
+			"sameDay":  "[Today at] h:mm A",
+			"nextDay":  "[Tomorrow at] h:mm A",
+			"lastDay":  "[Yesterday at] h:mm A",
+			"nextWeek": "dddd [at] h:mm A",
+			"lastWeek": "[Last] dddd [at] h:mm A",
+			"sameElse": "MM/DD/YYYY",
+		},
+		DayParts:   []string{"early morning", "morning", "afternoon", "evening", "night"},
+		Qualifiers: map[string]string{"about": "about %s", "over": "over %s", "almost": "almost %s"},
 	}
 }
 
@@ -90,6 +100,16 @@ func createEsESLocale() *Locale {
 			"long":   "2 de enero de 2006",
 			"full":   "lunes, 2 de enero de 2006",
 		},
+		CalendarFormats: map[string]string{
+			"sameDay":  "[hoy a las] HH:mm",
+			"nextDay":  "[mañana a las] HH:mm",
+			"lastDay":  "[ayer a las] HH:mm",
+			"nextWeek": "dddd [a las] HH:mm",
+			"lastWeek": "dddd [pasado a las] HH:mm",
+			"sameElse": "DD/MM/YYYY",
+		},
+		DayParts:   []string{"madrugada", "mañana", "tarde", "tarde", "noche"},
+		Qualifiers: map[string]string{"about": "alrededor de %s", "over": "más de %s", "almost": "casi %s"},
 	}
 }
 
@@ -131,6 +151,16 @@ func createFrFRLocale() *Locale {
 			"long":   "2 janvier 2006",
 			"full":   "lundi 2 janvier 2006",
 		},
+		CalendarFormats: map[string]string{
+			"sameDay":  "[Aujourd’hui à] HH:mm",
+			"nextDay":  "[Demain à] HH:mm",
+			"lastDay":  "[Hier à] HH:mm",
+			"nextWeek": "dddd [à] HH:mm",
+			"lastWeek": "dddd [dernier à] HH:mm",
+			"sameElse": "DD/MM/YYYY",
+		},
+		DayParts:   []string{"petit matin", "matin", "après-midi", "soir", "nuit"},
+		Qualifiers: map[string]string{"about": "environ %s", "over": "plus de %s", "almost": "presque %s"},
 	}
 }
 
@@ -172,6 +202,16 @@ func createDeDELocale() *Locale {
 			"long":   "2. Januar 2006",
 			"full":   "Montag, 2. Januar 2006",
 		},
+		CalendarFormats: map[string]string{
+			"sameDay":  "[heute um] HH:mm [Uhr]",
+			"nextDay":  "[morgen um] HH:mm [Uhr]",
+			"lastDay":  "[gestern um] HH:mm [Uhr]",
+			"nextWeek": "dddd [um] HH:mm [Uhr]",
+			"lastWeek": "[letzten] dddd [um] HH:mm [Uhr]",
+			"sameElse": "DD.MM.YYYY",
+		},
+		DayParts:   []string{"früher Morgen", "Morgen", "Nachmittag", "Abend", "Nacht"},
+		Qualifiers: map[string]string{"about": "etwa %s", "over": "über %s", "almost": "fast %s"},
 	}
 }
 
@@ -213,6 +253,16 @@ func createZhHansLocale() *Locale {
 			"long":   "2006年1月2日",
 			"full":   "2006年1月2日星期一",
 		},
+		CalendarFormats: map[string]string{
+			"sameDay":  "[今天] HH:mm",
+			"nextDay":  "[明天] HH:mm",
+			"lastDay":  "[昨天] HH:mm",
+			"nextWeek": "[下]dddd HH:mm",
+			"lastWeek": "[上]dddd HH:mm",
+			"sameElse": "YYYY/MM/DD",
+		},
+		DayParts:   []string{"凌晨", "上午", "下午", "傍晚", "晚上"},
+		Qualifiers: map[string]string{"about": "大约%s", "over": "超过%s", "almost": "将近%s"},
 	}
 }
 
@@ -254,6 +304,16 @@ func createPtBRLocale() *Locale {
 			"long":   "2 de janeiro de 2006",
 			"full":   "segunda-feira, 2 de janeiro de 2006",
 		},
+		CalendarFormats: map[string]string{
+			"sameDay":  "[Hoje às] HH:mm",
+			"nextDay":  "[Amanhã às] HH:mm",
+			"lastDay":  "[Ontem às] HH:mm",
+			"nextWeek": "dddd [às] HH:mm",
+			"lastWeek": "dddd [anterior às] HH:mm",
+			"sameElse": "DD/MM/YYYY",
+		},
+		DayParts:   []string{"madrugada", "manhã", "tarde", "noite", "noite"},
+		Qualifiers: map[string]string{"about": "cerca de %s", "over": "mais de %s", "almost": "quase %s"},
 	}
 }
 
@@ -384,6 +444,16 @@ func createJaJPLocale() *Locale {
 			"long":   "2006年1月2日",
 			"full":   "2006年1月2日(月)",
 		},
+		CalendarFormats: map[string]string{
+			"sameDay":  "[今日] HH:mm",
+			"nextDay":  "[明日] HH:mm",
+			"lastDay":  "[昨日] HH:mm",
+			"nextWeek": "[来週]dddd HH:mm",
+			"lastWeek": "[先週]dddd HH:mm",
+			"sameElse": "YYYY/MM/DD",
+		},
+		DayParts:   []string{"未明", "朝", "午後", "夕方", "夜"},
+		Qualifiers: map[string]string{"about": "約%s", "over": "%s以上", "almost": "%s近く"},
 	}
 }
 
@@ -2,6 +2,7 @@ package chronogo
 
 import (
 	"strings"
+	"sync"
 	"testing"
 	"time"
 )
@@ -87,6 +88,47 @@ func TestDefaultLocale(t *testing.T) {
 	_ = SetDefaultLocale("en-US")
 }
 
+func TestLocaleConcurrentAccess(t *testing.T) {
+	defer func() { _ = SetDefaultLocale("en-US") }()
+
+	dt := Date(2024, time.March, 1, 10, 0, 0, 0, time.UTC)
+	codes := []string{"en-US", "es-ES", "fr-FR", "de-DE"}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			for j := 0; j < 100; j++ {
+				switch (i + j) % 4 {
+				case 0:
+					_ = SetDefaultLocale(codes[j%len(codes)])
+				case 1:
+					_ = dt.FormatLocalizedDefault("MMMM Do")
+				case 2:
+					_ = dt.HumanStringLocalizedDefault()
+				default:
+					_ = GetAvailableLocales()
+				}
+			}
+		}(i)
+	}
+	// Re-registering an existing locale while others read it
+	en, _ := GetLocale("en-US")
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		for j := 0; j < 50; j++ {
+			RegisterLocale(en)
+		}
+	}()
+	wg.Wait()
+
+	if code := GetDefaultLocale(); code != "en-US" && code != "es-ES" && code != "fr-FR" && code != "de-DE" {
+		t.Errorf("GetDefaultLocale() = %q after concurrent updates", code)
+	}
+}
+
 func TestFormatLocalized(t *testing.T) {
 	dt := Date(2024, time.January, 15, 14, 30, 0, 0, time.UTC)
 
@@ -172,6 +214,38 @@ func TestFormatLocalizedDefault(t *testing.T) {
 	t.Logf("Default Spanish format result: %s", result)
 }
 
+func TestFormatTokens(t *testing.T) {
+	dt := Date(2024, time.June, 15, 14, 30, 0, 0, time.UTC)
+
+	tests := []struct {
+		pattern  string
+		expected string
+	}{
+		{"Do MMM YYYY [Q]Q", "15th Jun 2024 Q2"},
+		{"[Quarter] Q, YYYY", "Quarter 2, 2024"},
+		{"YYYY-MM-DD [at] HH:mm", "2024-06-15 at 14:30"},
+		{"dddd, MMMM Do", "Saturday, June 15th"},
+		{"Q", "2"},
+	}
+	for _, tt := range tests {
+		if got := dt.FormatTokens(tt.pattern); got != tt.expected {
+			t.Errorf("FormatTokens(%q) = %q, want %q", tt.pattern, got, tt.expected)
+		}
+	}
+
+	if got := Date(2024, time.November, 1, 0, 0, 0, 0, time.UTC).FormatTokens("Do [Q]Q"); got != "1st Q4" {
+		t.Errorf("FormatTokens ordinal = %q, want %q", got, "1st Q4")
+	}
+
+	got, err := dt.FormatLocalized("Do MMMM YYYY [T]Q", "fr-FR")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got != "15e juin 2024 T2" {
+		t.Errorf("FormatLocalized fr-FR = %q, want %q", got, "15e juin 2024 T2")
+	}
+}
+
 func TestHumanStringLocalized(t *testing.T) {
 	now := Now()
 
@@ -312,6 +386,23 @@ func TestGetWeekdayName(t *testing.T) {
 	}
 }
 
+func TestWeekdayNameShorthand(t *testing.T) {
+	dt := Date(2024, time.January, 13, 0, 0, 0, 0, time.UTC) // Saturday
+
+	if got := dt.WeekdayName("es-ES"); got != "sábado" {
+		t.Errorf("WeekdayName(es-ES) = %q", got)
+	}
+	if got := dt.WeekdayShortName("es-ES"); got != "sáb" {
+		t.Errorf("WeekdayShortName(es-ES) = %q", got)
+	}
+	if got := dt.WeekdayName(""); got != dt.GetWeekdayNameDefault() {
+		t.Errorf("WeekdayName(\"\") = %q, want default locale name", got)
+	}
+	if got, short := dt.WeekdayName("xx-XX"), dt.WeekdayShortName("xx-XX"); got != "Saturday" || short != "Sat" {
+		t.Errorf("unknown locale = %q, %q; want English fallback", got, short)
+	}
+}
+
 func TestGetMonthNameDefault(t *testing.T) {
 	dt := Date(2024, time.September, 1, 0, 0, 0, 0, time.UTC)
 
@@ -630,3 +721,62 @@ func TestJapaneseAMPM(t *testing.T) {
 		t.Errorf("Expected PM to be '午後', got '%s'", locale.AMPMNames[1])
 	}
 }
+
+func TestCalendarString(t *testing.T) {
+	ref := Date(2024, time.June, 15, 12, 0, 0, 0, time.UTC) // Saturday
+
+	tests := []struct {
+		dt       DateTime
+		expected string
+	}{
+		{ref.At(14, 30, 0), "Today at 2:30 PM"},
+		{ref.AddDays(1).At(14, 30, 0), "Tomorrow at 2:30 PM"},
+		{ref.AddDays(-1).At(9, 0, 0), "Yesterday at 9:00 AM"},
+		{ref.AddDays(3).At(10, 5, 0), "Tuesday at 10:05 AM"},
+		{ref.AddDays(-5).At(16, 15, 0), "Last Monday at 4:15 PM"},
+		{ref.AddDays(7), "06/22/2024"},
+		{ref.AddDays(-30), "05/16/2024"},
+	}
+	for _, tt := range tests {
+		got, err := tt.dt.CalendarStringLocalized("en-US", ref)
+		if err != nil {
+			t.Fatalf("CalendarStringLocalized error: %v", err)
+		}
+		if got != tt.expected {
+			t.Errorf("CalendarString(%v) = %q, want %q", tt.dt, got, tt.expected)
+		}
+	}
+
+	localized := []struct {
+		locale   string
+		dt       DateTime
+		expected string
+	}{
+		{"es-ES", ref.AddDays(1).At(14, 30, 0), "mañana a las 14:30"},
+		{"fr-FR", ref.AddDays(-1).At(9, 0, 0), "Hier à 09:00"},
+		{"de-DE", ref.AddDays(2).At(8, 0, 0), "Montag um 08:00 Uhr"},
+		{"pt-BR", ref.At(18, 45, 0), "Hoje às 18:45"},
+		{"ja-JP", ref.AddDays(-1).At(7, 5, 0), "昨日 07:05"},
+		{"de-DE", ref.AddDays(10), "25.06.2024"},
+	}
+	for _, tt := range localized {
+		got, err := tt.dt.CalendarStringLocalized(tt.locale, ref)
+		if err != nil {
+			t.Fatalf("CalendarStringLocalized(%s) error: %v", tt.locale, err)
+		}
+		if got != tt.expected {
+			t.Errorf("CalendarStringLocalized(%s, %v) = %q, want %q", tt.locale, tt.dt, got, tt.expected)
+		}
+	}
+
+	if _, err := ref.CalendarStringLocalized("xx-XX", ref); err == nil {
+		t.Error("Expected error for unknown locale")
+	}
+
+	// Day boundaries are evaluated in the datetime's own timezone
+	tokyo := time.FixedZone("JST", 9*3600)
+	late := Date(2024, time.June, 15, 23, 30, 0, 0, time.UTC).In(tokyo) // June 16 08:30 JST
+	if got := late.CalendarString(ref); got != "Tomorrow at 8:30 AM" {
+		t.Errorf("CalendarString across zones = %q, want %q", got, "Tomorrow at 8:30 AM")
+	}
+}
@@ -0,0 +1,189 @@
+package chronogo
+
+import (
+	"bytes"
+	"database/sql/driver"
+	"encoding/json"
+)
+
+// The Null types below represent nullable database columns and optional JSON
+// fields, in the style of sql.NullTime: Valid is false for SQL NULL and JSON null.
+// Prefer them over zero values when "no value" must survive a round trip, so APIs
+// do not expose "0001-01-01T00:00:00Z". For plain DateTime fields, the omitzero JSON
+// tag option (Go 1.24+) omits zero values, since DateTime has an IsZero method, and
+// SetMarshalZeroAsNull writes them as null. The omitempty option has no effect on
+// struct types such as DateTime.
+
+// NullDateTime is a DateTime that may be null.
+//
+// Example:
+//
+//	var deleted chronogo.NullDateTime
+//	row.Scan(&deleted)
+//	if deleted.Valid {
+//		fmt.Println(deleted.DateTime)
+//	}
+type NullDateTime struct {
+	DateTime DateTime
+	Valid    bool // Valid is true if DateTime is not NULL
+}
+
+// NewNullDateTime returns a valid NullDateTime holding dt.
+func NewNullDateTime(dt DateTime) NullDateTime {
+	return NullDateTime{DateTime: dt, Valid: true}
+}
+
+// Scan implements the sql.Scanner interface.
+func (n *NullDateTime) Scan(value any) error {
+	if value == nil {
+		*n = NullDateTime{}
+		return nil
+	}
+	if err := n.DateTime.Scan(value); err != nil {
+		return err
+	}
+	n.Valid = true
+	return nil
+}
+
+// Value implements the driver.Valuer interface.
+func (n NullDateTime) Value() (driver.Value, error) {
+	if !n.Valid {
+		return nil, nil
+	}
+	return n.DateTime.Value()
+}
+
+// MarshalJSON implements json.Marshaler, writing null when not Valid.
+func (n NullDateTime) MarshalJSON() ([]byte, error) {
+	if !n.Valid {
+		return []byte("null"), nil
+	}
+	return n.DateTime.MarshalJSON()
+}
+
+// UnmarshalJSON implements json.Unmarshaler; null makes the value invalid.
+func (n *NullDateTime) UnmarshalJSON(data []byte) error {
+	if isJSONNull(data) {
+		*n = NullDateTime{}
+		return nil
+	}
+	if err := n.DateTime.UnmarshalJSON(data); err != nil {
+		return err
+	}
+	n.Valid = true
+	return nil
+}
+
+// NullPeriod is a Period that may be null. In SQL it maps to a tstzrange column.
+type NullPeriod struct {
+	Period Period
+	Valid  bool // Valid is true if Period is not NULL
+}
+
+// NewNullPeriod returns a valid NullPeriod holding p.
+func NewNullPeriod(p Period) NullPeriod {
+	return NullPeriod{Period: p, Valid: true}
+}
+
+// Scan implements the sql.Scanner interface.
+func (n *NullPeriod) Scan(value any) error {
+	if value == nil {
+		*n = NullPeriod{}
+		return nil
+	}
+	if err := n.Period.Scan(value); err != nil {
+		return err
+	}
+	n.Valid = true
+	return nil
+}
+
+// Value implements the driver.Valuer interface.
+func (n NullPeriod) Value() (driver.Value, error) {
+	if !n.Valid {
+		return nil, nil
+	}
+	return n.Period.Value()
+}
+
+// MarshalJSON implements json.Marshaler, writing null when not Valid.
+func (n NullPeriod) MarshalJSON() ([]byte, error) {
+	if !n.Valid {
+		return []byte("null"), nil
+	}
+	return json.Marshal(n.Period)
+}
+
+// UnmarshalJSON implements json.Unmarshaler; null makes the value invalid.
+func (n *NullPeriod) UnmarshalJSON(data []byte) error {
+	if isJSONNull(data) {
+		*n = NullPeriod{}
+		return nil
+	}
+	if err := json.Unmarshal(data, &n.Period); err != nil {
+		return err
+	}
+	n.Valid = true
+	return nil
+}
+
+// NullChronoDuration is a ChronoDuration that may be null. In SQL it maps to an
+// interval column.
+type NullChronoDuration struct {
+	Duration ChronoDuration
+	Valid    bool // Valid is true if Duration is not NULL
+}
+
+// NewNullChronoDuration returns a valid NullChronoDuration holding d.
+func NewNullChronoDuration(d ChronoDuration) NullChronoDuration {
+	return NullChronoDuration{Duration: d, Valid: true}
+}
+
+// Scan implements the sql.Scanner interface.
+func (n *NullChronoDuration) Scan(value any) error {
+	if value == nil {
+		*n = NullChronoDuration{}
+		return nil
+	}
+	if err := n.Duration.Scan(value); err != nil {
+		return err
+	}
+	n.Valid = true
+	return nil
+}
+
+// Value implements the driver.Valuer interface.
+func (n NullChronoDuration) Value() (driver.Value, error) {
+	if !n.Valid {
+		return nil, nil
+	}
+	return n.Duration.Value()
+}
+
+// MarshalJSON implements json.Marshaler, writing null when not Valid and otherwise
+// the duration as encoding/json writes a ChronoDuration.
+func (n NullChronoDuration) MarshalJSON() ([]byte, error) {
+	if !n.Valid {
+		return []byte("null"), nil
+	}
+	return json.Marshal(n.Duration)
+}
+
+// UnmarshalJSON implements json.Unmarshaler; null makes the value invalid.
+func (n *NullChronoDuration) UnmarshalJSON(data []byte) error {
+	if isJSONNull(data) {
+		*n = NullChronoDuration{}
+		return nil
+	}
+	if err := json.Unmarshal(data, &n.Duration); err != nil {
+		return err
+	}
+	n.Valid = true
+	return nil
+}
+
+// isJSONNull reports whether data is the JSON literal null.
+func isJSONNull(data []byte) bool {
+	return bytes.Equal(bytes.TrimSpace(data), []byte("null"))
+}
@@ -0,0 +1,116 @@
+package chronogo
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+)
+
+func TestNullDateTime(t *testing.T) {
+	dt := Date(2024, time.January, 15, 10, 30, 0, 0, time.UTC)
+
+	var n NullDateTime
+	if err := n.Scan(dt.Time); err != nil || !n.Valid || !n.DateTime.Equal(dt) {
+		t.Errorf("Scan(time) = %+v, %v", n, err)
+	}
+	if v, err := n.Value(); err != nil || v != dt.Time {
+		t.Errorf("Value() = %v, %v", v, err)
+	}
+	if err := n.Scan(nil); err != nil || n.Valid {
+		t.Errorf("Scan(nil) = %+v, %v", n, err)
+	}
+	if v, err := n.Value(); err != nil || v != nil {
+		t.Errorf("Value() of NULL = %v, %v", v, err)
+	}
+	if err := n.Scan(42); err == nil || n.Valid {
+		t.Error("Scan() of an unsupported type should fail and stay invalid")
+	}
+
+	type payload struct {
+		Deleted NullDateTime `json:"deleted"`
+	}
+	data, _ := json.Marshal(payload{})
+	if string(data) != `{"deleted":null}` {
+		t.Errorf("Marshal(invalid) = %s", data)
+	}
+	data, _ = json.Marshal(payload{Deleted: NewNullDateTime(dt)})
+	if string(data) != `{"deleted":"2024-01-15T10:30:00Z"}` {
+		t.Errorf("Marshal(valid) = %s", data)
+	}
+	var p payload
+	if err := json.Unmarshal(data, &p); err != nil || !p.Deleted.Valid || !p.Deleted.DateTime.Equal(dt) {
+		t.Errorf("Unmarshal(valid) = %+v, %v", p, err)
+	}
+	if err := json.Unmarshal([]byte(`{"deleted":null}`), &p); err != nil || p.Deleted.Valid {
+		t.Errorf("Unmarshal(null) = %+v, %v", p, err)
+	}
+}
+
+func TestNullPeriod(t *testing.T) {
+	period := NewPeriod(Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC), Date(2024, time.February, 1, 0, 0, 0, 0, time.UTC))
+
+	var n NullPeriod
+	if err := n.Scan(`["2024-01-01 00:00:00+00","2024-02-01 00:00:00+00")`); err != nil || !n.Valid || !n.Period.Start.Equal(period.Start) {
+		t.Errorf("Scan(range) = %+v, %v", n, err)
+	}
+	if v, _ := n.Value(); v == nil {
+		t.Error("Value() of a valid NullPeriod should not be nil")
+	}
+	if err := n.Scan(nil); err != nil || n.Valid {
+		t.Errorf("Scan(nil) = %+v, %v", n, err)
+	}
+	if v, _ := n.Value(); v != nil {
+		t.Errorf("Value() of NULL = %v", v)
+	}
+
+	data, _ := json.Marshal(NewNullPeriod(period))
+	var back NullPeriod
+	if err := json.Unmarshal(data, &back); err != nil || !back.Valid || !back.Period.End.Equal(period.End) {
+		t.Errorf("JSON round trip = %+v, %v (from %s)", back, err, data)
+	}
+	if data, _ := json.Marshal(NullPeriod{}); string(data) != "null" {
+		t.Errorf("Marshal(invalid) = %s", data)
+	}
+}
+
+func TestNullChronoDuration(t *testing.T) {
+	var n NullChronoDuration
+	if err := n.Scan("1 day 02:00:00"); err != nil || !n.Valid || n.Duration.Duration != 26*time.Hour {
+		t.Errorf("Scan(interval) = %+v, %v", n, err)
+	}
+	if v, _ := n.Value(); v != "P1DT2H" {
+		t.Errorf("Value() = %v, want P1DT2H", v)
+	}
+	if err := n.Scan(nil); err != nil || n.Valid {
+		t.Errorf("Scan(nil) = %+v, %v", n, err)
+	}
+
+	data, _ := json.Marshal(NewNullChronoDuration(NewDuration(90 * time.Second)))
+	var back NullChronoDuration
+	if err := json.Unmarshal(data, &back); err != nil || !back.Valid || back.Duration.Duration != 90*time.Second {
+		t.Errorf("JSON round trip = %+v, %v (from %s)", back, err, data)
+	}
+	if err := json.Unmarshal([]byte("null"), &back); err != nil || back.Valid {
+		t.Errorf("Unmarshal(null) = %+v, %v", back, err)
+	}
+}
+
+func TestMarshalZeroAsNull(t *testing.T) {
+	defer SetMarshalZeroAsNull(false)
+
+	if data, _ := json.Marshal(DateTime{}); string(data) != `"0001-01-01T00:00:00Z"` {
+		t.Errorf("Marshal(zero) by default = %s", data)
+	}
+	SetMarshalZeroAsNull(true)
+	if data, _ := json.Marshal(DateTime{}); string(data) != "null" {
+		t.Errorf("Marshal(zero) with SetMarshalZeroAsNull = %s", data)
+	}
+	dt := Date(2024, time.January, 15, 10, 30, 0, 0, time.UTC)
+	if data, _ := json.Marshal(dt); string(data) != `"2024-01-15T10:30:00Z"` {
+		t.Errorf("Marshal(non-zero) = %s", data)
+	}
+	var back DateTime
+	if err := json.Unmarshal([]byte("null"), &back); err != nil || !back.IsZero() {
+		t.Errorf("Unmarshal(null) = %v, %v", back, err)
+	}
+}
@@ -7,6 +7,7 @@ import (
 	"strconv"
 	"strings"
 	"time"
+	"unicode/utf8"
 )
 
 var (
@@ -30,10 +31,146 @@ var (
 	ErrNoMatchingFormat = errors.New("no matching format found")
 )
 
-// ParseOptions defines options for advanced parsing
+// ParseOptions are per-call settings for Parse and ParseInLocation. The zero value
+// keeps the defaults. See ParseConfig for the settings accepted by ParseWith.
 type ParseOptions struct {
 	Exact  bool // Return exact type (Date, Time, Interval) if true
 	Strict bool // Use strict parsing (RFC3339/ISO8601 only) if true
+
+	// Languages restricts natural-language parsing for this call, for example to the
+	// language of the user making a request. Empty uses DefaultParseConfig.Languages.
+	Languages []string
+
+	// DisableNaturalLanguage accepts only technical formats for this call
+	// (see ParseConfig.DisableNaturalLanguage).
+	DisableNaturalLanguage bool
+
+	// RelativeTo is the reference instant for relative inputs such as "tomorrow" or
+	// "3 days ago". When zero, the current time is used (respecting SetTestNow).
+	RelativeTo DateTime
+
+	// DateOrder selects how numeric dates such as "15/06/24" are read.
+	DateOrder DateOrder
+
+	// TwoDigitYearPivot controls two-digit years in numeric dates: years below the
+	// pivot map to 20xx and the rest to 19xx. Zero uses the default pivot of 69.
+	TwoDigitYearPivot int
+
+	// Century, when non-zero, places every two-digit year in that century
+	// (e.g. 20 reads "24" as 2024) and overrides TwoDigitYearPivot.
+	Century int
+
+	// PreferredRegion picks the meaning of ambiguous zone abbreviations such as
+	// "CST" or "IST" (an ISO 3166 country code like "CN" or "IE").
+	// See LookupZoneAbbreviation.
+	PreferredRegion string
+}
+
+// DateOrder selects the field order used to read numeric dates like "01/02/03".
+// Dates starting with a 4-digit year are always read as year-month-day.
+type DateOrder int
+
+const (
+	// DateOrderAuto infers the order: a leading 4-digit field is the year, otherwise
+	// a first field above 12 means day-month-year, and anything else month-day-year.
+	DateOrderAuto DateOrder = iota
+	// DateOrderDMY reads day, month, year (15/06/2024).
+	DateOrderDMY
+	// DateOrderMDY reads month, day, year (06/15/2024).
+	DateOrderMDY
+	// DateOrderYMD reads year, month, day (24/06/15).
+	DateOrderYMD
+)
+
+// defaultTwoDigitYearPivot matches Go's time package: 69-99 are 19xx, 00-68 are 20xx.
+const defaultTwoDigitYearPivot = 69
+
+// numericDatePattern matches dates made of three numeric fields with an optional time.
+var numericDatePattern = regexp.MustCompile(`^(\d{1,4})([/.\-])(\d{1,2})([/.\-])(\d{1,4})(?:[ T](\d{1,2}):(\d{2})(?::(\d{2}))?)?$`)
+
+// parseNumericDate parses numeric dates such as "15/06/24" or "06-15-2024 14:30" using an
+// explicit field order and two-digit-year rule. The bool result is false when value is
+// not a numeric date or, with DateOrderAuto, when it mixes separators and the general
+// parsers should handle it. With an explicit order, mixed separators such as "15/06-24"
+// are an error rather than a guess.
+func parseNumericDate(value string, loc *time.Location, config ParseConfig) (DateTime, bool, error) {
+	m := numericDatePattern.FindStringSubmatch(value)
+	if m == nil {
+		return DateTime{}, false, nil
+	}
+	if m[2] != m[4] {
+		if config.DateOrder == DateOrderAuto {
+			return DateTime{}, false, nil
+		}
+		return DateTime{}, true, ParseError(value, fmt.Errorf("%w: mixed date separators %q and %q", ErrInvalidFormat, m[2], m[4]))
+	}
+	fields := [3]string{m[1], m[3], m[5]}
+
+	order := config.DateOrder
+	if len(fields[0]) == 4 {
+		// A leading 4-digit year is unambiguous whatever the configured order
+		order = DateOrderYMD
+	} else if order == DateOrderAuto {
+		first, _ := strconv.Atoi(fields[0])
+		switch {
+		case first > 12:
+			order = DateOrderDMY
+		default:
+			order = DateOrderMDY
+		}
+	}
+
+	var yearField, monthField, dayField string
+	switch order {
+	case DateOrderDMY:
+		dayField, monthField, yearField = fields[0], fields[1], fields[2]
+	case DateOrderMDY:
+		monthField, dayField, yearField = fields[0], fields[1], fields[2]
+	case DateOrderYMD:
+		yearField, monthField, dayField = fields[0], fields[1], fields[2]
+	default:
+		return DateTime{}, true, ParseError(value, fmt.Errorf("%w: unknown date order %d", ErrInvalidFormat, order))
+	}
+	if len(dayField) > 2 || (len(yearField) != 2 && len(yearField) != 4) {
+		return DateTime{}, true, ParseError(value, fmt.Errorf("%w: fields do not match the date order", ErrInvalidFormat))
+	}
+
+	year, _ := strconv.Atoi(yearField)
+	month, _ := strconv.Atoi(monthField)
+	day, _ := strconv.Atoi(dayField)
+	if len(yearField) == 2 {
+		year = expandTwoDigitYear(year, config.TwoDigitYearPivot, config.Century)
+	}
+
+	var hour, minute, second int
+	if m[6] != "" {
+		hour, _ = strconv.Atoi(m[6])
+		minute, _ = strconv.Atoi(m[7])
+		if m[8] != "" {
+			second, _ = strconv.Atoi(m[8])
+		}
+	}
+
+	t := time.Date(year, time.Month(month), day, hour, minute, second, 0, loc)
+	if month < 1 || month > 12 || t.Day() != day || hour > 23 || minute > 59 || second > 59 {
+		return DateTime{}, true, ParseError(value, fmt.Errorf("%w: %04d-%02d-%02d %02d:%02d:%02d is not a valid date", ErrInvalidFormat, year, month, day, hour, minute, second))
+	}
+	return DateTime{t}, true, nil
+}
+
+// expandTwoDigitYear maps a two-digit year to a full year using an explicit century
+// or, failing that, a pivot (years below the pivot are 20xx, the rest 19xx).
+func expandTwoDigitYear(year, pivot, century int) int {
+	if century != 0 {
+		return century*100 + year
+	}
+	if pivot == 0 {
+		pivot = defaultTwoDigitYearPivot
+	}
+	if year < pivot {
+		return 2000 + year
+	}
+	return 1900 + year
 }
 
 // Parse is an intelligent datetime parser that handles:
@@ -72,11 +209,22 @@ func ParseInLocation(value string, loc *time.Location, options ...ParseOptions)
 		opts = options[0]
 	}
 
+	languages := opts.Languages
+	if len(languages) == 0 {
+		languages = DefaultParseConfig.Languages
+	}
+
 	// Build ParseConfig from options
 	config := ParseConfig{
-		Strict:    opts.Strict,
-		Languages: DefaultParseConfig.Languages,
-		Location:  loc,
+		Strict:                 opts.Strict,
+		Languages:              languages,
+		Location:               loc,
+		RelativeTo:             opts.RelativeTo,
+		DateOrder:              opts.DateOrder,
+		TwoDigitYearPivot:      opts.TwoDigitYearPivot,
+		Century:                opts.Century,
+		PreferredRegion:        opts.PreferredRegion,
+		DisableNaturalLanguage: opts.DisableNaturalLanguage,
 	}
 
 	return ParseWith(value, config)
@@ -147,15 +295,316 @@ func FromFormatInLocation(value, layout string, loc *time.Location) (DateTime, e
 }
 
 // FromFormatTokens parses a datetime string using chronogo-style format tokens.
-// Converts token-based format to Go time format and then parses.
+// Converts token-based format to Go time format and then parses. When the value does
+// not match, the error wraps a *FormatMismatchError giving the position and expected
+// token, and its suggestion names a supported format that fits the value better.
+//
+// Example:
+//
+//	_, err := chronogo.FromFormatTokens("12/25/2024", "DD/MM/YYYY")
+//	// invalid datetime format: "12/25/2024" at position 3: expected MM (month out of range)
+//	// Suggestion: Did you mean "MM/DD/YYYY"? It matches the input
 func FromFormatTokens(value, format string) (DateTime, error) {
 	return FromFormatTokensInLocation(value, format, time.UTC)
 }
 
 // FromFormatTokensInLocation parses using token-style format in the specified location.
+// Formats containing ISO week-date tokens (GGGG, WW, W, E) are parsed by a dedicated
+// week-date parser, so values produced by FormatTokens("GGGG-[W]WW-E") round-trip.
 func FromFormatTokensInLocation(value, format string, loc *time.Location) (DateTime, error) {
-	goLayout := convertTokenFormat(format)
-	return FromFormatInLocation(value, goLayout, loc)
+	if hasWeekDateTokens(format) {
+		return parseWeekDateTokens(value, format, loc)
+	}
+	t, err := time.ParseInLocation(convertTokenFormat(format), value, loc)
+	if err != nil {
+		return DateTime{}, tokenFormatError(value, format, err)
+	}
+	return DateTime{t}, nil
+}
+
+// supportedTokenFormats are the token formats returned by GetSupportedFormats.
+var supportedTokenFormats = []string{
+	"YYYY-MM-DD",
+	"YYYY-MM-DD HH:mm",
+	"YYYY-MM-DD HH:mm:ss",
+	"YYYY-MM-DD h:mm A",
+	"YYYY/MM/DD",
+	"MM/DD/YYYY",
+	"MM/DD/YYYY HH:mm",
+	"MM/DD/YYYY h:mm A",
+	"DD/MM/YYYY",
+	"DD/MM/YYYY HH:mm",
+	"MM-DD-YYYY",
+	"DD-MM-YYYY",
+	"DD.MM.YYYY",
+	"DD.MM.YYYY HH:mm",
+	"MMMM D, YYYY",
+	"MMM D, YYYY",
+	"D MMMM YYYY",
+	"D MMM YYYY",
+	"dddd, MMMM D, YYYY",
+	"ddd, DD MMM YYYY HH:mm:ss",
+	"HH:mm",
+	"HH:mm:ss",
+	"h:mm A",
+}
+
+// GetSupportedFormats returns commonly used token formats for FromFormatTokens. They
+// are the candidates FromFormatTokens draws on when it suggests a format for a value
+// that did not match.
+//
+// Example:
+//
+//	for _, format := range chronogo.GetSupportedFormats() {
+//	    if dt, err := chronogo.FromFormatTokens(input, format); err == nil {
+//	        return dt, nil
+//	    }
+//	}
+func GetSupportedFormats() []string {
+	return append([]string(nil), supportedTokenFormats...)
+}
+
+// tokenFormatError describes where value stopped matching a token format and
+// suggests a supported format that matches it, or gets further into it.
+func tokenFormatError(value, format string, err error) *ChronoError {
+	mismatch := newFormatMismatch(value, err)
+	suggestion := suggestFormat(format)
+	if near, full := nearestTokenFormat(value, format, mismatch.Position); near != "" {
+		if full {
+			suggestion = fmt.Sprintf("Did you mean %q? It matches the input", near)
+		} else {
+			suggestion = fmt.Sprintf("Did you mean %q? It matches more of the input", near)
+		}
+	}
+	return &ChronoError{
+		Op:         "Format",
+		Input:      format,
+		Err:        mismatch,
+		Suggestion: suggestion,
+	}
+}
+
+// newFormatMismatch converts a time.ParseError from a converted token layout into a
+// FormatMismatchError that reports tokens instead of Go layout elements.
+func newFormatMismatch(value string, err error) *FormatMismatchError {
+	mismatch := &FormatMismatchError{Value: value, Err: err}
+	var pe *time.ParseError
+	if !errors.As(err, &pe) {
+		return mismatch
+	}
+	offset := len(value) - len(pe.ValueElem)
+	if offset < 0 || offset > len(value) {
+		offset = 0
+	}
+	if strings.HasSuffix(pe.Message, "out of range") {
+		// Range errors are reported after the element was consumed; point at its start
+		for offset > 0 && isAlphanumeric(value[offset-1]) {
+			offset--
+		}
+	}
+	mismatch.Position = utf8.RuneCountInString(value[:offset])
+	mismatch.Expected = layoutElemToken(pe.LayoutElem)
+	mismatch.Reason = strings.TrimPrefix(pe.Message, ": ")
+	return mismatch
+}
+
+// layoutElemToken maps a Go layout element back to the token that produced it, or
+// quotes it when it is literal text.
+func layoutElemToken(elem string) string {
+	if elem == "" {
+		return "end of input"
+	}
+	for _, token := range formatTokens {
+		if token.replacement == elem {
+			return token.token
+		}
+	}
+	return strconv.Quote(elem)
+}
+
+// nearestTokenFormat returns the supported format other than format that parses
+// value, reporting full as true, or else the one that gets furthest past position.
+func nearestTokenFormat(value, format string, position int) (best string, full bool) {
+	furthest := position
+	for _, candidate := range supportedTokenFormats {
+		if candidate == format {
+			continue
+		}
+		_, err := time.Parse(convertTokenFormat(candidate), value)
+		if err == nil {
+			return candidate, true
+		}
+		if m := newFormatMismatch(value, err); m.Position > furthest {
+			best, furthest = candidate, m.Position
+		}
+	}
+	return best, false
+}
+
+// weekDateTokens lists the tokens accepted by parseWeekDateTokens, longest first.
+var weekDateTokens = []struct {
+	token   string
+	pattern string
+}{
+	{"GGGG", `(\d{4})`},
+	{"WW", `(\d{2})`},
+	{"W", `(\d{1,2})`},
+	{"E", `([1-7])`},
+	{"d", `([0-6])`},
+	{"HH", `(\d{2})`},
+	{"H", `(\d{1,2})`},
+	{"mm", `(\d{2})`},
+	{"m", `(\d{1,2})`},
+	{"ss", `(\d{2})`},
+	{"s", `(\d{1,2})`},
+}
+
+// matchTokenAt reports whether token occurs at position i of format as a whole token,
+// honoring the same whole-token rules as convertTokenFormat.
+func matchTokenAt(format string, i int, token string) bool {
+	end := i + len(token)
+	if end > len(format) || format[i:end] != token {
+		return false
+	}
+	validStart := i == 0 || !isTokenChar(format[i-1])
+	validEnd := end == len(format) || !isTokenChar(format[end])
+	return validStart && validEnd
+}
+
+// hasWeekDateTokens reports whether a token format uses ISO week-date tokens.
+func hasWeekDateTokens(format string) bool {
+	for i := 0; i < len(format); i++ {
+		if format[i] == '[' {
+			if j := strings.IndexByte(format[i+1:], ']'); j >= 0 {
+				i += j + 1
+				continue
+			}
+		}
+		for _, token := range []string{"GGGG", "WW", "W", "E"} {
+			if matchTokenAt(format, i, token) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// parseWeekDateTokens parses an ISO week date described by a token format such as
+// "GGGG-[W]WW-E" or "GGGG[W]WWE HH:mm". The week-year and week are required; the
+// weekday defaults to Monday and the time of day to midnight.
+func parseWeekDateTokens(value, format string, loc *time.Location) (DateTime, error) {
+	var expr strings.Builder
+	var fields []string
+	expr.WriteByte('^')
+
+	for i := 0; i < len(format); {
+		if format[i] == '[' {
+			if j := strings.IndexByte(format[i+1:], ']'); j >= 0 {
+				expr.WriteString(regexp.QuoteMeta(format[i+1 : i+1+j]))
+				i += j + 2
+				continue
+			}
+		}
+
+		// Every letter must be a token here, so tokens may be adjacent ("GGGG[W]WWE")
+		matched := false
+		for _, wt := range weekDateTokens {
+			if strings.HasPrefix(format[i:], wt.token) {
+				expr.WriteString(wt.pattern)
+				fields = append(fields, wt.token)
+				i += len(wt.token)
+				matched = true
+				break
+			}
+		}
+		if matched {
+			continue
+		}
+
+		if isTokenChar(format[i]) {
+			return DateTime{}, FormatError(format, fmt.Errorf("unsupported token at position %d in week-date format", i))
+		}
+		expr.WriteString(regexp.QuoteMeta(format[i : i+1]))
+		i++
+	}
+	expr.WriteByte('$')
+
+	re, err := regexp.Compile(expr.String())
+	if err != nil {
+		return DateTime{}, FormatError(format, err)
+	}
+	matches := re.FindStringSubmatch(value)
+	if matches == nil {
+		return DateTime{}, FormatError(format, fmt.Errorf("cannot parse %q as week date", value))
+	}
+
+	year, week, weekday := -1, -1, 1
+	hour, minute, second := 0, 0, 0
+	for idx, field := range fields {
+		n, _ := strconv.Atoi(matches[idx+1])
+		switch field {
+		case "GGGG":
+			year = n
+		case "WW", "W":
+			week = n
+		case "E":
+			weekday = n
+		case "d":
+			weekday = (n+6)%7 + 1
+		case "HH", "H":
+			hour = n
+		case "mm", "m":
+			minute = n
+		case "ss", "s":
+			second = n
+		}
+	}
+
+	if year < 0 || week < 0 {
+		return DateTime{}, FormatError(format, errors.New("week-date format requires GGGG and WW tokens"))
+	}
+	if hour > 23 || minute > 59 || second > 59 {
+		return DateTime{}, FormatError(format, fmt.Errorf("%w: time in %q", ErrOutOfRange, value))
+	}
+
+	t := isoWeekToDate(year, week, weekday, loc)
+	if y, w := t.ISOWeek(); week < 1 || y != year || w != week {
+		return DateTime{}, FormatError(format, fmt.Errorf("%w: week number %d", ErrOutOfRange, week))
+	}
+
+	return DateTime{time.Date(t.Year(), t.Month(), t.Day(), hour, minute, second, 0, loc)}, nil
+}
+
+// formatTokens maps format tokens to Go layout elements in order of specificity
+// (longest first).
+var formatTokens = []struct {
+	token       string
+	replacement string
+}{
+	{"YYYY", "2006"},
+	{"MMMM", "January"},
+	{"MMM", "Jan"},
+	{"dddd", "Monday"},
+	{"ddd", "Mon"},
+	{"MM", "01"},
+	{"DD", "02"},
+	{"Do", "2nd"}, // Ordinal day - Go doesn't have native support, but we'll handle this specially
+	{"HH", "15"},
+	{"hh", "03"},
+	{"mm", "04"},
+	{"ss", "05"},
+	{"ZZ", "Z0700"},
+	{"YY", "06"},
+	{"Y", "2006"},
+	{"M", "1"},
+	{"D", "2"},
+	{"H", "15"},
+	{"h", "3"},
+	{"m", "4"},
+	{"s", "5"},
+	{"A", "PM"},
+	{"a", "pm"},
+	{"Z", "Z07:00"},
 }
 
 // convertTokenFormat converts token-style format to Go time layout
@@ -163,44 +612,13 @@ func convertTokenFormat(format string) string {
 	// Use a state machine approach to replace tokens without conflicts
 	result := format
 
-	// Define token mappings in order of specificity (longest first)
-	tokens := []struct {
-		token       string
-		replacement string
-	}{
-		{"YYYY", "2006"},
-		{"MMMM", "January"},
-		{"MMM", "Jan"},
-		{"dddd", "Monday"},
-		{"ddd", "Mon"},
-		{"MM", "01"},
-		{"DD", "02"},
-		{"Do", "2nd"}, // Ordinal day - Go doesn't have native support, but we'll handle this specially
-		{"HH", "15"},
-		{"hh", "03"},
-		{"mm", "04"},
-		{"ss", "05"},
-		{"ZZ", "Z0700"},
-		{"YY", "06"},
-		{"Y", "2006"},
-		{"M", "1"},
-		{"D", "2"},
-		{"H", "15"},
-		{"h", "3"},
-		{"m", "4"},
-		{"s", "5"},
-		{"A", "PM"},
-		{"a", "pm"},
-		{"Z", "Z07:00"},
-	}
-
 	// Process each position in the string
 	i := 0
 	for i < len(result) {
 		matched := false
 
 		// Try to match any token at current position
-		for _, token := range tokens {
+		for _, token := range formatTokens {
 			if i+len(token.token) <= len(result) && result[i:i+len(token.token)] == token.token {
 				// Check if this is a complete token (not part of a larger identifier)
 				validStart := i == 0 || !isTokenChar(result[i-1])
@@ -224,6 +642,11 @@ func convertTokenFormat(format string) string {
 	return result
 }
 
+// isAlphanumeric reports whether c is an ASCII letter or digit.
+func isAlphanumeric(c byte) bool {
+	return isTokenChar(c) || (c >= '0' && c <= '9')
+}
+
 // isTokenChar checks if a character can be part of a format token
 func isTokenChar(c byte) bool {
 	return (c >= 'A' && c <= 'Z') || (c >= 'a' && c <= 'z')
@@ -254,7 +677,7 @@ func parseOrdinalDate(value string, loc *time.Location) (DateTime, error) {
 	}
 
 	if dayOfYear < 1 || dayOfYear > maxDays {
-		return DateTime{}, ParseError(value, fmt.Errorf("invalid day of year: %d", dayOfYear))
+		return DateTime{}, ParseError(value, fmt.Errorf("%w: day of year %d", ErrOutOfRange, dayOfYear))
 	}
 
 	// Create date from ordinal day
@@ -290,7 +713,7 @@ func parseWeekDate(value string, loc *time.Location) (DateTime, error) {
 
 	// Validate week number
 	if week < 1 || week > 53 {
-		return DateTime{}, ParseError(value, fmt.Errorf("invalid week number: %d", week))
+		return DateTime{}, ParseError(value, fmt.Errorf("%w: week number %d", ErrOutOfRange, week))
 	}
 
 	// Calculate the date from ISO week
@@ -477,14 +900,21 @@ func subtractDurationFromDateTime(dt DateTime, duration ISO8601Duration) DateTim
 }
 
 // LoadLocation loads a timezone by name.
-// This is a convenience wrapper around time.LoadLocation.
+// This is a convenience wrapper around time.LoadLocation. Errors wrap the original
+// error and ErrUnknownTimezone when the name is not in the time zone database, or
+// ErrInvalidTimezone for other failures such as malformed names or zone data.
 func LoadLocation(name string) (*time.Location, error) {
 	if name == "local" {
 		return time.Local, nil
 	}
 	loc, err := time.LoadLocation(name)
 	if err != nil {
-		return nil, TimezoneError(name, err)
+		kind := ErrInvalidTimezone
+		// time.LoadLocation has no sentinel for a missing zone, only this message
+		if strings.HasPrefix(err.Error(), "unknown time zone ") {
+			kind = ErrUnknownTimezone
+		}
+		return nil, TimezoneError(name, fmt.Errorf("%w %q: %w", kind, name, err))
 	}
 	return loc, nil
 }
@@ -541,55 +971,6 @@ func TryParseUnix(value string) (DateTime, error) {
 	return parseUnixTimestamp(value)
 }
 
-// AvailableTimezones returns a list of commonly used timezone names.
-// This is helpful for error suggestions and validation.
-func AvailableTimezones() []string {
-	return []string{
-		"UTC",
-		"Local",
-		// Americas
-		"America/New_York",
-		"America/Chicago",
-		"America/Denver",
-		"America/Los_Angeles",
-		"America/Toronto",
-		"America/Vancouver",
-		"America/Mexico_City",
-		"America/Sao_Paulo",
-		"America/Argentina/Buenos_Aires",
-		// Europe
-		"Europe/London",
-		"Europe/Paris",
-		"Europe/Berlin",
-		"Europe/Rome",
-		"Europe/Madrid",
-		"Europe/Amsterdam",
-		"Europe/Stockholm",
-		"Europe/Moscow",
-		// Asia
-		"Asia/Tokyo",
-		"Asia/Shanghai",
-		"Asia/Hong_Kong",
-		"Asia/Singapore",
-		"Asia/Bangkok",
-		"Asia/Jakarta",
-		"Asia/Manila",
-		"Asia/Seoul",
-		"Asia/Kolkata",
-		"Asia/Dubai",
-		// Australia/Pacific
-		"Australia/Sydney",
-		"Australia/Melbourne",
-		"Australia/Perth",
-		"Pacific/Auckland",
-		"Pacific/Honolulu",
-		// Africa
-		"Africa/Cairo",
-		"Africa/Johannesburg",
-		"Africa/Lagos",
-	}
-}
-
 // IsValidTimezone checks if a timezone name is valid.
 func IsValidTimezone(name string) bool {
 	_, err := time.LoadLocation(name)
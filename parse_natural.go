@@ -1,55 +1,121 @@
 package chronogo
 
 import (
+	"errors"
+	"fmt"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"sync/atomic"
 	"time"
+	"unicode/utf8"
 
 	"github.com/coredds/godateparser"
 )
 
-// ParseConfig holds configuration for intelligent parsing with natural language support.
+// ParseConfig is the full set of parsing settings accepted by ParseWith. Parse and
+// ParseInLocation build one from DefaultParseConfig and their ParseOptions; use
+// ParseWith directly when a call needs settings those functions do not expose, such
+// as PreferFuture.
+//
+// ParseWith tries, in order: leap-second timestamps, trailing zone abbreviations
+// ("14:30 EST"), numeric dates governed by DateOrder and the two-digit-year rules,
+// technical formats (ISO 8601, RFC 3339, Unix timestamps, ordinal and week dates),
+// compound English phrases ("last Friday of next month", only when Languages
+// includes "en") and finally natural language in the configured Languages. With Strict, only leap-second timestamps
+// and the strict ISO 8601 / RFC 3339 / Unix timestamp formats are tried. The zero
+// value is usable: it parses in UTC with the default languages.
+//
+// Example:
+//
+//	// Restrict a request to the user's language: faster, and fewer ambiguous matches
+//	dt, err := chronogo.ParseWith("15 de marzo", chronogo.ParseConfig{
+//		Languages: []string{"es"},
+//		Location:  userLoc,
+//	})
 type ParseConfig struct {
-	// Strict mode: only parse technical formats (ISO 8601, RFC 3339, Unix timestamps)
-	// When false, enables natural language parsing via godateparser
+	// Strict limits parsing to technical formats (ISO 8601, RFC 3339, Unix
+	// timestamps). When false, natural language is accepted as well.
 	Strict bool
 
-	// Languages for natural language parsing (e.g., "en", "es", "pt", "fr", "de", "zh", "ja")
-	// Default: all supported languages
+	// Languages restricts natural-language parsing to these language codes, from
+	// "en", "es", "pt", "fr", "de", "zh" and "ja". Fewer languages means fewer
+	// ambiguous matches and faster parsing. Empty uses DefaultParseConfig.Languages.
+	// Compound English phrases need "en"; simple English relative expressions such
+	// as "tomorrow" or "3 days ago" are understood by the underlying parser whatever
+	// the languages.
 	Languages []string
 
-	// Location for parsing (default: UTC)
+	// Location is used for inputs without an offset and for relative inputs.
+	// Nil means UTC.
 	Location *time.Location
 
 	// Prefer future dates when parsing ambiguous relative dates
 	// e.g., "Friday" will prefer next Friday if today is not Friday
 	PreferFuture bool
+
+	// RelativeTo is the reference instant for relative inputs such as "tomorrow" or
+	// "next Monday". When zero, the current time is used (respecting SetTestNow).
+	RelativeTo DateTime
+
+	// DateOrder selects how numeric dates such as "15/06/24" are read.
+	// With DateOrderAuto a first field above 12 is the day (15/06/2024) and any
+	// other is the month (01/02/2024 is January 2nd).
+	DateOrder DateOrder
+
+	// TwoDigitYearPivot: two-digit years below the pivot map to 20xx, the rest to 19xx.
+	// Zero uses the default pivot of 69.
+	TwoDigitYearPivot int
+
+	// Century, when non-zero, places every two-digit year in that century (20 -> 20xx).
+	Century int
+
+	// PreferredRegion resolves ambiguous zone abbreviations such as "CST" (US or China)
+	// to the meaning used in that ISO 3166 country. Empty uses the most common meaning.
+	PreferredRegion string
+
+	// DisableNaturalLanguage stops after the technical formats, so inputs such as
+	// "tomorrow" or "next Friday" are rejected. Unlike Strict, zone abbreviations,
+	// numeric dates and the lenient technical layouts are still accepted. See also
+	// SetNaturalLanguageParsing.
+	DisableNaturalLanguage bool
 }
 
-// DefaultParseConfig provides sensible defaults: all languages enabled, UTC location
+// DefaultParseConfig supplies the languages used by Parse and ParseInLocation, and by
+// ParseWith when ParseConfig.Languages is empty: by default every supported language,
+// in UTC. Change it with SetDefaultParseLanguages during initialization.
 var DefaultParseConfig = ParseConfig{
 	Languages:    []string{"en", "es", "pt", "fr", "de", "zh", "ja"},
 	Location:     time.UTC,
 	PreferFuture: false,
 }
 
-// parseWithGodateparser attempts to parse using godateparser for natural language and common formats
-func parseWithGodateparser(value string, loc *time.Location, languages []string, preferFuture bool) (DateTime, error) {
+// parseWithGodateparser attempts to parse using godateparser for natural language and common formats.
+// Relative expressions are resolved against base.
+func parseWithGodateparser(value string, loc *time.Location, base DateTime, languages []string, preferFuture bool) (DateTime, error) {
 	// Configure godateparser settings
 	settings := &godateparser.Settings{
 		Languages: languages,
 	}
 
-	// Set relative base if location is specified
+	// Set relative base in the parsing location
 	if loc != nil {
-		settings.RelativeBase = time.Now().In(loc)
+		settings.RelativeBase = base.Time.In(loc)
 	} else {
-		settings.RelativeBase = time.Now().UTC()
+		settings.RelativeBase = base.Time.UTC()
 	}
 
 	// Note: godateparser v1.3.3 may not have PreferFuture field
 	// This is handled by default behavior in godateparser
 
+	// Invalid UTF-8 can never be a date in any language
+	if !utf8.ValidString(value) {
+		return DateTime{}, ParseError(value, ErrInvalidFormat)
+	}
+
 	// Parse with godateparser
-	result, err := godateparser.ParseDate(value, settings)
+	result, err := parseDateSafely(value, settings)
 	if err != nil {
 		return DateTime{}, ParseError(value, err)
 	}
@@ -62,6 +128,17 @@ func parseWithGodateparser(value string, loc *time.Location, languages []string,
 	return DateTime{result}, nil
 }
 
+// parseDateSafely calls godateparser, turning a panic on malformed input into an error
+// so that Parse never panics.
+func parseDateSafely(value string, settings *godateparser.Settings) (result time.Time, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("%w: %v", ErrInvalidFormat, r)
+		}
+	}()
+	return godateparser.ParseDate(value, settings)
+}
+
 // tryStrictFormats attempts parsing with only strict RFC/ISO formats and Unix timestamps
 // Used by strict mode parsing
 func tryStrictFormats(value string, loc *time.Location) (DateTime, bool) {
@@ -149,6 +226,11 @@ func tryTechnicalFormats(value string, loc *time.Location) (DateTime, bool) {
 		}
 	}
 
+	// Try ISO 8601 basic format without separators (20240115T143000Z)
+	if dt, ok := parseISOBasic(value, loc); ok {
+		return dt, true
+	}
+
 	// Try Unix timestamp (numeric only, but not 8-digit compact dates or 7-digit ordinal dates)
 	if isNumericOnly(value) && len(value) != 8 && len(value) != 7 {
 		if dt, err := parseUnixTimestampInLocation(value, loc); err == nil {
@@ -174,7 +256,8 @@ func tryTechnicalFormats(value string, loc *time.Location) (DateTime, bool) {
 
 // ParseWith parses a datetime string using the provided configuration.
 // This is the most flexible parsing function, allowing fine control over
-// natural language parsing, languages, and location.
+// natural language parsing, languages, and location. See ParseConfig for the
+// order in which formats are tried.
 func ParseWith(value string, config ParseConfig) (DateTime, error) {
 	if value == "" {
 		return DateTime{}, ParseError(value, ErrEmptyString)
@@ -185,6 +268,11 @@ func ParseWith(value string, config ParseConfig) (DateTime, error) {
 		loc = time.UTC
 	}
 
+	// Timestamps taken during a leap second ("23:59:60") that time.Parse rejects
+	if dt, ok := parseLeapSecond(value, loc); ok {
+		return dt, nil
+	}
+
 	// Strict mode: only try strict technical formats (RFC3339, ISO8601, Unix timestamps)
 	if config.Strict {
 		if dt, ok := tryStrictFormats(value, loc); ok {
@@ -193,18 +281,67 @@ func ParseWith(value string, config ParseConfig) (DateTime, error) {
 		return DateTime{}, ParseError(value, ErrNoMatchingFormat)
 	}
 
-	// Try fast-path technical formats first
+	// A trailing zone abbreviation ("14:30 EST") fixes the offset of the wall-clock time
+	if rest, zone, ok := splitZoneAbbreviation(value, config.PreferredRegion); ok {
+		return parseInAbbreviatedZone(rest, zone, config)
+	}
+
+	// Numeric dates with an explicit field order or a two-digit year
+	if dt, ok, err := parseNumericDate(value, loc, config); ok {
+		return dt, err
+	}
+
+	// Try fast-path technical formats
 	if dt, ok := tryTechnicalFormats(value, loc); ok {
 		return dt, nil
 	}
 
-	// Use godateparser for natural language and common formats
+	if config.DisableNaturalLanguage || naturalLanguageDisabled.Load() {
+		return DateTime{}, ParseError(value, ErrNoMatchingFormat)
+	}
+
+	// Relative inputs resolve against RelativeTo, or the (testable) current time
+	base := config.RelativeTo
+	if base.IsZero() {
+		base = NowIn(loc)
+	} else {
+		base = base.In(loc)
+	}
+
 	languages := config.Languages
 	if len(languages) == 0 {
 		languages = DefaultParseConfig.Languages
 	}
 
-	return parseWithGodateparser(value, loc, languages, config.PreferFuture)
+	// Compound English phrases godateparser does not understand
+	if slices.Contains(languages, "en") {
+		if dt, err := parseCompoundPhrase(value, base); !errors.Is(err, errNotCompoundPhrase) {
+			return dt, err
+		}
+	}
+
+	// Use godateparser for natural language and common formats
+	return parseWithGodateparser(value, loc, base, languages, config.PreferFuture)
+}
+
+// parseInAbbreviatedZone parses value as a wall-clock time in zone, the fixed offset
+// named by a zone abbreviation. The value is parsed in UTC (with the reference time
+// expressed as zone wall-clock time) and the result re-read in zone, so the abbreviation
+// wins over any location the parsers would otherwise apply.
+func parseInAbbreviatedZone(value string, zone *time.Location, config ParseConfig) (DateTime, error) {
+	base := config.RelativeTo
+	if base.IsZero() {
+		base = Now()
+	}
+	wall := base.In(zone)
+	config.Location = time.UTC
+	config.RelativeTo = UTC(wall.Year(), wall.Month(), wall.Day(), wall.Hour(), wall.Minute(), wall.Second(), wall.Nanosecond())
+
+	dt, err := ParseWith(value, config)
+	if err != nil {
+		return DateTime{}, err
+	}
+	return Date(dt.Year(), dt.Month(), dt.Day(), dt.Hour(), dt.Minute(), dt.Second(), dt.Nanosecond(), zone), nil
 }
 
 // SetDefaultParseLanguages sets the default languages for Parse() and ParseInLocation().
@@ -214,7 +351,193 @@ func SetDefaultParseLanguages(languages ...string) {
 	DefaultParseConfig.Languages = languages
 }
 
+// naturalLanguageDisabled turns off natural-language parsing for every call.
+var naturalLanguageDisabled atomic.Bool
+
+// SetNaturalLanguageParsing enables or disables natural-language parsing globally.
+// When disabled, Parse, ParseInLocation and ParseWith accept only technical formats,
+// as if every call set ParseConfig.DisableNaturalLanguage, which keeps behavior
+// predictable without changing call sites. It is enabled by default. Safe for
+// concurrent use.
+//
+// Example:
+//
+//	func init() { chronogo.SetNaturalLanguageParsing(false) }
+func SetNaturalLanguageParsing(enabled bool) {
+	naturalLanguageDisabled.Store(!enabled)
+}
+
+// DisableNaturalLanguageParsing is shorthand for SetNaturalLanguageParsing(false).
+func DisableNaturalLanguageParsing() {
+	SetNaturalLanguageParsing(false)
+}
+
 // GetDefaultParseLanguages returns the current default languages for parsing
 func GetDefaultParseLanguages() []string {
 	return DefaultParseConfig.Languages
 }
+
+// errNotCompoundPhrase signals that a value is not a compound phrase and should be
+// handed to the general natural-language parser.
+var errNotCompoundPhrase = errors.New("not a compound phrase")
+
+var (
+	// "third Friday of next month", "last business day of March", "2nd Tuesday in Q"
+	nthInPeriodPattern = regexp.MustCompile(`^(?:the\s+)?(first|1st|second|2nd|third|3rd|fourth|4th|fifth|5th|last)\s+(monday|tuesday|wednesday|thursday|friday|saturday|sunday|business\s+day)\s+(?:of|in)\s+(?:the\s+)?(.+)$`)
+
+	// "end of next quarter", "start of this month", "beginning of March 2025"
+	periodBoundaryPattern = regexp.MustCompile(`^(?:the\s+)?(start|beginning|end)\s+of\s+(?:the\s+)?(.+)$`)
+
+	// "next month", "this quarter", "last year", "month" (the current one)
+	relativePeriodPattern = regexp.MustCompile(`^(?:(this|current|next|last|previous)\s+)?(week|month|quarter|year)$`)
+
+	// "March", "march 2025"
+	monthPeriodPattern = regexp.MustCompile(`^([a-z]+)(?:\s+(\d{4}))?$`)
+)
+
+var compoundOrdinals = map[string]int{
+	"first": 1, "1st": 1, "second": 2, "2nd": 2, "third": 3, "3rd": 3,
+	"fourth": 4, "4th": 4, "fifth": 5, "5th": 5, "last": -1,
+}
+
+var compoundWeekdays = map[string]time.Weekday{
+	"sunday": time.Sunday, "monday": time.Monday, "tuesday": time.Tuesday, "wednesday": time.Wednesday,
+	"thursday": time.Thursday, "friday": time.Friday, "saturday": time.Saturday,
+}
+
+var compoundMonths = map[string]time.Month{
+	"january": time.January, "jan": time.January, "february": time.February, "feb": time.February,
+	"march": time.March, "mar": time.March, "april": time.April, "apr": time.April, "may": time.May,
+	"june": time.June, "jun": time.June, "july": time.July, "jul": time.July, "august": time.August,
+	"aug": time.August, "september": time.September, "sep": time.September, "sept": time.September,
+	"october": time.October, "oct": time.October, "november": time.November, "nov": time.November,
+	"december": time.December, "dec": time.December,
+}
+
+// parseCompoundPhrase parses English phrases that combine an ordinal or boundary with a
+// period, such as "third Friday of next month", "last business day of March" and
+// "end of next quarter". Business days use the default holiday checker.
+// It returns errNotCompoundPhrase when the value does not have that shape.
+func parseCompoundPhrase(value string, now DateTime) (DateTime, error) {
+	phrase := strings.Join(strings.Fields(strings.ToLower(value)), " ")
+
+	if m := nthInPeriodPattern.FindStringSubmatch(phrase); m != nil {
+		anchor, unit, ok := parsePeriodReference(m[3], now)
+		if !ok || unit == UnitWeek {
+			return DateTime{}, errNotCompoundPhrase
+		}
+		n := compoundOrdinals[m[1]]
+
+		var result DateTime
+		if m[2] == "business day" {
+			result = nthBusinessDayOf(n, anchor.StartOf(unit), anchor.EndOf(unit).StartOfDay())
+		} else {
+			result = anchor.NthWeekdayOf(n, compoundWeekdays[m[2]], unitName(unit))
+		}
+		if result.IsZero() {
+			return DateTime{}, ParseError(value, fmt.Errorf("%w: %s does not exist", ErrInvalidRange, phrase))
+		}
+		return result.StartOfDay(), nil
+	}
+
+	if m := periodBoundaryPattern.FindStringSubmatch(phrase); m != nil {
+		anchor, unit, ok := parsePeriodReference(m[2], now)
+		if !ok {
+			return DateTime{}, errNotCompoundPhrase
+		}
+		if m[1] == "end" {
+			return anchor.EndOf(unit), nil
+		}
+		return anchor.StartOf(unit), nil
+	}
+
+	return DateTime{}, errNotCompoundPhrase
+}
+
+// parsePeriodReference resolves "next month", "this quarter", "month", "March" or "March 2025"
+// to a DateTime inside that period and the period's unit.
+func parsePeriodReference(ref string, now DateTime) (DateTime, Unit, bool) {
+	if m := relativePeriodPattern.FindStringSubmatch(ref); m != nil {
+		var unit Unit
+		switch m[2] {
+		case "week":
+			unit = UnitWeek
+		case "month":
+			unit = UnitMonth
+		case "quarter":
+			unit = UnitQuarter
+		default:
+			unit = UnitYear
+		}
+		shift := 0
+		switch m[1] {
+		case "next":
+			shift = 1
+		case "last", "previous":
+			shift = -1
+		}
+		anchor := now.StartOf(unit)
+		switch unit {
+		case UnitWeek:
+			anchor = anchor.AddWeeks(shift)
+		case UnitMonth:
+			anchor = anchor.AddMonths(shift)
+		case UnitQuarter:
+			anchor = anchor.AddQuarters(shift)
+		default:
+			anchor = anchor.AddYears(shift)
+		}
+		return anchor, unit, true
+	}
+
+	if m := monthPeriodPattern.FindStringSubmatch(ref); m != nil {
+		month, ok := compoundMonths[m[1]]
+		if !ok {
+			return DateTime{}, 0, false
+		}
+		year := now.Year()
+		if m[2] != "" {
+			year, _ = strconv.Atoi(m[2])
+		}
+		return Date(year, month, 1, 0, 0, 0, 0, now.Location()), UnitMonth, true
+	}
+
+	return DateTime{}, 0, false
+}
+
+// unitName maps month, quarter and year units to the names used by NthWeekdayOf.
+func unitName(unit Unit) string {
+	switch unit {
+	case UnitMonth:
+		return "month"
+	case UnitQuarter:
+		return "quarter"
+	case UnitYear:
+		return "year"
+	default:
+		return ""
+	}
+}
+
+// nthBusinessDayOf returns the nth business day between first and last (inclusive),
+// or the last one when n is -1. Returns a zero DateTime when there is no such day.
+func nthBusinessDayOf(n int, first, last DateTime) DateTime {
+	if n == -1 {
+		for day := last; !day.Before(first); day = day.AddDays(-1) {
+			if day.IsBusinessDay() {
+				return day
+			}
+		}
+		return DateTime{}
+	}
+	count := 0
+	for day := first; !day.After(last); day = day.AddDays(1) {
+		if day.IsBusinessDay() {
+			count++
+			if count == n {
+				return day
+			}
+		}
+	}
+	return DateTime{}
+}
@@ -1,6 +1,7 @@
 package chronogo
 
 import (
+	"errors"
 	"strings"
 	"testing"
 	"time"
@@ -224,6 +225,55 @@ func TestSetDefaultParseLanguages(t *testing.T) {
 	}
 }
 
+func TestParseOptionsLanguages(t *testing.T) {
+	ref := Date(2024, time.June, 15, 12, 0, 0, 0, time.UTC)
+
+	dt, err := Parse("demain", ParseOptions{Languages: []string{"fr"}, RelativeTo: ref})
+	if err != nil || dt.Day() != 16 {
+		t.Errorf("Parse(demain, fr) = %v, %v", dt, err)
+	}
+
+	// The per-call setting leaves the defaults untouched
+	if got := GetDefaultParseLanguages(); len(got) == 1 && got[0] == "fr" {
+		t.Errorf("Parse() with Languages changed the defaults to %v", got)
+	}
+
+	// Compound English phrases need English among the languages
+	phrase := "first monday of next month"
+	if dt, err := Parse(phrase, ParseOptions{Languages: []string{"es"}, RelativeTo: ref}); err == nil {
+		t.Errorf("Parse(%q, es) = %v, want error", phrase, dt)
+	}
+	if dt, err := Parse(phrase, ParseOptions{Languages: []string{"es", "en"}, RelativeTo: ref}); err != nil || !dt.Equal(Date(2024, time.July, 1, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("Parse(%q, es+en) = %v, %v", phrase, dt, err)
+	}
+}
+
+func TestDisableNaturalLanguageParsing(t *testing.T) {
+	defer SetNaturalLanguageParsing(true)
+
+	if _, err := Parse("tomorrow", ParseOptions{DisableNaturalLanguage: true}); !errors.Is(err, ErrNoMatchingFormat) {
+		t.Errorf("Parse(tomorrow) with DisableNaturalLanguage error = %v, want ErrNoMatchingFormat", err)
+	}
+
+	DisableNaturalLanguageParsing()
+	for _, input := range []string{"tomorrow", "next friday", "last Friday of next month"} {
+		if _, err := Parse(input); !errors.Is(err, ErrNoMatchingFormat) {
+			t.Errorf("Parse(%q) with natural language disabled error = %v", input, err)
+		}
+	}
+	// Technical and lenient layouts still parse, unlike ParseStrict
+	for _, input := range []string{"2024-06-15T14:30:00Z", "2024/06/15", "15/06/24", "14:30 EST", "20240615"} {
+		if _, err := Parse(input); err != nil {
+			t.Errorf("Parse(%q) with natural language disabled error = %v", input, err)
+		}
+	}
+
+	SetNaturalLanguageParsing(true)
+	if _, err := Parse("tomorrow"); err != nil {
+		t.Errorf("Parse(tomorrow) after re-enabling error = %v", err)
+	}
+}
+
 // TestParseStrictOptions tests the Strict option in ParseOptions
 func TestParseStrictOptions(t *testing.T) {
 	t.Run("Strict via ParseOptions", func(t *testing.T) {
@@ -264,6 +314,9 @@ func TestParseErrors(t *testing.T) {
 		{"Empty string", ""},
 		{"Invalid gibberish", "xyzabc123invalidtext"},
 		{"Only whitespace", "   "},
+		{"Invalid UTF-8", "\xff\xfetomorrow"},
+		{"Week zero", "2024-W00-1"},
+		{"Huge ordinal", "2024-999999999999"},
 	}
 
 	for _, tt := range tests {
@@ -362,3 +415,93 @@ func TestParseBackwardCompatibility(t *testing.T) {
 		})
 	}
 }
+
+func TestParseCompoundPhrases(t *testing.T) {
+	SetTestNow(Date(2024, time.June, 12, 15, 0, 0, 0, time.UTC)) // Wednesday
+	defer ClearTestNow()
+
+	tests := []struct {
+		input    string
+		expected DateTime
+	}{
+		{"third Friday of next month", Date(2024, time.July, 19, 0, 0, 0, 0, time.UTC)},
+		{"the 1st Monday of this month", Date(2024, time.June, 3, 0, 0, 0, 0, time.UTC)},
+		{"Last Sunday of March", Date(2024, time.March, 31, 0, 0, 0, 0, time.UTC)},
+		{"second tuesday in november 2025", Date(2025, time.November, 11, 0, 0, 0, 0, time.UTC)},
+		{"last Friday of next quarter", Date(2024, time.September, 27, 0, 0, 0, 0, time.UTC)},
+		{"last business day of March", Date(2024, time.March, 29, 0, 0, 0, 0, time.UTC)},
+		{"first business day of next month", Date(2024, time.July, 1, 0, 0, 0, 0, time.UTC)},
+		{"first business day of January 2025", Date(2025, time.January, 2, 0, 0, 0, 0, time.UTC)}, // Jan 1 is a holiday
+		{"end of next quarter", Date(2024, time.September, 30, 23, 59, 59, 999999999, time.UTC)},
+		{"start of next week", Date(2024, time.June, 17, 0, 0, 0, 0, time.UTC)},
+		{"beginning of last year", Date(2023, time.January, 1, 0, 0, 0, 0, time.UTC)},
+		{"end of the month", Date(2024, time.June, 30, 23, 59, 59, 999999999, time.UTC)},
+		{"end of February", Date(2024, time.February, 29, 23, 59, 59, 999999999, time.UTC)},
+	}
+	for _, tt := range tests {
+		got, err := Parse(tt.input)
+		if err != nil {
+			t.Errorf("Parse(%q) error: %v", tt.input, err)
+			continue
+		}
+		if !got.Equal(tt.expected) {
+			t.Errorf("Parse(%q) = %v, want %v", tt.input, got, tt.expected)
+		}
+	}
+
+	if _, err := Parse("fifth Monday of February"); err == nil {
+		t.Error("Expected error for a fifth Monday that does not exist")
+	}
+
+	ny, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Skip("America/New_York timezone not available")
+	}
+	got, err := ParseInLocation("end of this month", ny)
+	if err != nil {
+		t.Fatalf("ParseInLocation error: %v", err)
+	}
+	if got.Location() != ny || got.Day() != 30 || got.Hour() != 23 {
+		t.Errorf("ParseInLocation(end of this month) = %v", got)
+	}
+}
+
+func TestParseRelativeTo(t *testing.T) {
+	ref := Date(2020, time.February, 28, 10, 0, 0, 0, time.UTC)
+
+	got, err := Parse("tomorrow", ParseOptions{RelativeTo: ref})
+	if err != nil {
+		t.Fatalf("Parse(tomorrow) error: %v", err)
+	}
+	if got.Format("2006-01-02") != "2020-02-29" {
+		t.Errorf("Parse(tomorrow, RelativeTo) = %v, want 2020-02-29", got)
+	}
+
+	got, err = ParseWith("end of next month", ParseConfig{RelativeTo: ref})
+	if err != nil {
+		t.Fatalf("ParseWith(end of next month) error: %v", err)
+	}
+	if want := Date(2020, time.March, 31, 23, 59, 59, 999999999, time.UTC); !got.Equal(want) {
+		t.Errorf("ParseWith(end of next month) = %v, want %v", got, want)
+	}
+
+	// Without RelativeTo the testable clock is used
+	WithTestNow(Date(2031, time.July, 4, 12, 0, 0, 0, time.UTC), func() {
+		got, err := Parse("yesterday")
+		if err != nil {
+			t.Fatalf("Parse(yesterday) error: %v", err)
+		}
+		if got.Format("2006-01-02") != "2031-07-03" {
+			t.Errorf("Parse(yesterday) with SetTestNow = %v, want 2031-07-03", got)
+		}
+
+		// An explicit RelativeTo wins over the test clock
+		got, err = Parse("yesterday", ParseOptions{RelativeTo: ref})
+		if err != nil {
+			t.Fatalf("Parse(yesterday) error: %v", err)
+		}
+		if got.Format("2006-01-02") != "2020-02-27" {
+			t.Errorf("Parse(yesterday, RelativeTo) = %v, want 2020-02-27", got)
+		}
+	})
+}
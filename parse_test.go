@@ -243,32 +243,6 @@ func TestTryParseUnix(t *testing.T) {
 	}
 }
 
-func TestAvailableTimezones(t *testing.T) {
-	timezones := AvailableTimezones()
-
-	// Should return a non-empty slice
-	if len(timezones) == 0 {
-		t.Error("AvailableTimezones() should return non-empty slice")
-	}
-
-	// Should contain common timezones
-	found := false
-	for _, tz := range timezones {
-		if tz == "UTC" {
-			found = true
-			break
-		}
-	}
-	if !found {
-		t.Error("AvailableTimezones() should include 'UTC'")
-	}
-
-	// Check that we have a reasonable number of timezones
-	if len(timezones) < 10 {
-		t.Errorf("Expected more timezones, got %d", len(timezones))
-	}
-}
-
 func TestIsValidTimezone(t *testing.T) {
 	tests := []struct {
 		timezone string
@@ -312,3 +286,93 @@ func TestMin(t *testing.T) {
 		})
 	}
 }
+
+func TestParseDateOrderAndTwoDigitYears(t *testing.T) {
+	tests := []struct {
+		input    string
+		opts     ParseOptions
+		expected string
+	}{
+		{"15/06/24", ParseOptions{}, "2024-06-15T00:00:00Z"},
+		{"06/15/24", ParseOptions{}, "2024-06-15T00:00:00Z"},
+		{"01/02/24", ParseOptions{}, "2024-01-02T00:00:00Z"},
+		{"01/02/24", ParseOptions{DateOrder: DateOrderDMY}, "2024-02-01T00:00:00Z"},
+		{"24.06.15", ParseOptions{DateOrder: DateOrderYMD}, "2024-06-15T00:00:00Z"},
+		{"15-06-2024 14:30", ParseOptions{DateOrder: DateOrderDMY}, "2024-06-15T14:30:00Z"},
+		{"06/15/2024 09:05:07", ParseOptions{DateOrder: DateOrderMDY}, "2024-06-15T09:05:07Z"},
+		{"15/06/2024", ParseOptions{}, "2024-06-15T00:00:00Z"},
+		{"15/06/2024", ParseOptions{DateOrder: DateOrderDMY}, "2024-06-15T00:00:00Z"},
+		{"01/02/2024", ParseOptions{}, "2024-01-02T00:00:00Z"},
+		{"01/02/2024", ParseOptions{DateOrder: DateOrderDMY}, "2024-02-01T00:00:00Z"},
+		{"01/02/2024", ParseOptions{DateOrder: DateOrderMDY}, "2024-01-02T00:00:00Z"},
+		{"15/06/70", ParseOptions{}, "1970-06-15T00:00:00Z"},
+		{"15/06/68", ParseOptions{}, "2068-06-15T00:00:00Z"},
+		{"15/06/68", ParseOptions{TwoDigitYearPivot: 50}, "1968-06-15T00:00:00Z"},
+		{"15/06/99", ParseOptions{Century: 20}, "2099-06-15T00:00:00Z"},
+		{"15/06/05", ParseOptions{Century: 19}, "1905-06-15T00:00:00Z"},
+	}
+	for _, tt := range tests {
+		got, err := Parse(tt.input, tt.opts)
+		if err != nil {
+			t.Errorf("Parse(%q, %+v) error: %v", tt.input, tt.opts, err)
+			continue
+		}
+		if got.Format(time.RFC3339) != tt.expected {
+			t.Errorf("Parse(%q, %+v) = %s, want %s", tt.input, tt.opts, got.Format(time.RFC3339), tt.expected)
+		}
+	}
+
+	invalid := []struct {
+		input string
+		opts  ParseOptions
+	}{
+		{"15/06/24", ParseOptions{DateOrder: DateOrderMDY}},   // month 15
+		{"31/02/2024", ParseOptions{DateOrder: DateOrderDMY}}, // February 31st
+		{"15/06/2024", ParseOptions{DateOrder: DateOrderYMD}}, // year field is not a year
+		{"15/06/2024", ParseOptions{DateOrder: DateOrderMDY}}, // month 15
+		{"15/06/202", ParseOptions{}},                         // three-digit year
+		{"15/06-24", ParseOptions{DateOrder: DateOrderDMY}},   // mixed separators
+		{"2024.06/15", ParseOptions{DateOrder: DateOrderYMD}}, // mixed separators
+	}
+	for _, tt := range invalid {
+		if _, err := Parse(tt.input, tt.opts); err == nil {
+			t.Errorf("Parse(%q, %+v) expected error", tt.input, tt.opts)
+		}
+	}
+}
+
+func TestParseZoneAbbreviations(t *testing.T) {
+	tests := []struct {
+		input  string
+		opts   ParseOptions
+		want   string
+		offset int
+	}{
+		{"2024-06-15 14:30 EST", ParseOptions{}, "2024-06-15T19:30:00Z", -5 * 3600},
+		{"2024-01-15 14:30 EDT", ParseOptions{}, "2024-01-15T18:30:00Z", -4 * 3600},
+		{"2024-07-01 09:00:00 CEST", ParseOptions{}, "2024-07-01T07:00:00Z", 2 * 3600},
+		{"2024-06-15 14:30 IST", ParseOptions{}, "2024-06-15T09:00:00Z", 5*3600 + 30*60},
+		{"2024-06-15 14:30 IST", ParseOptions{PreferredRegion: "IE"}, "2024-06-15T13:30:00Z", 3600},
+		{"2024-06-15 14:30 CST", ParseOptions{PreferredRegion: "CN"}, "2024-06-15T06:30:00Z", 8 * 3600},
+		{"15/06/24 14:30 PST", ParseOptions{DateOrder: DateOrderDMY}, "2024-06-15T22:30:00Z", -8 * 3600},
+	}
+	for _, tt := range tests {
+		dt, err := Parse(tt.input, tt.opts)
+		if err != nil {
+			t.Errorf("Parse(%q) error: %v", tt.input, err)
+			continue
+		}
+		if got := dt.UTC().Format(time.RFC3339); got != tt.want || dt.OffsetSeconds() != tt.offset {
+			t.Errorf("Parse(%q, %+v) = %s (offset %d), want %s (offset %d)", tt.input, tt.opts, got, dt.OffsetSeconds(), tt.want, tt.offset)
+		}
+	}
+
+	// Parsing in another location does not override the abbreviation
+	ny, err := time.LoadLocation("America/New_York")
+	if err == nil {
+		dt, err := ParseInLocation("2024-06-15 14:30 PST", ny)
+		if err != nil || dt.UTC().Hour() != 22 {
+			t.Errorf("ParseInLocation(PST, New York) = %v, %v; want 22:30 UTC", dt, err)
+		}
+	}
+}
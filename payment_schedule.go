@@ -0,0 +1,153 @@
+package chronogo
+
+import "fmt"
+
+// PaymentFrequency is the interval between payments in a PaymentSchedule.
+type PaymentFrequency int
+
+const (
+	// PaymentMonthly schedules a payment every month.
+	PaymentMonthly PaymentFrequency = iota
+	// PaymentQuarterly schedules a payment every 3 months.
+	PaymentQuarterly
+	// PaymentSemiAnnual schedules a payment every 6 months.
+	PaymentSemiAnnual
+	// PaymentAnnual schedules a payment every 12 months.
+	PaymentAnnual
+)
+
+// Months returns the number of months between payments.
+func (f PaymentFrequency) Months() int {
+	switch f {
+	case PaymentQuarterly:
+		return 3
+	case PaymentSemiAnnual:
+		return 6
+	case PaymentAnnual:
+		return 12
+	default:
+		return 1
+	}
+}
+
+// String returns the name of the frequency.
+func (f PaymentFrequency) String() string {
+	switch f {
+	case PaymentMonthly:
+		return "monthly"
+	case PaymentQuarterly:
+		return "quarterly"
+	case PaymentSemiAnnual:
+		return "semiannual"
+	case PaymentAnnual:
+		return "annual"
+	default:
+		return fmt.Sprintf("PaymentFrequency(%d)", int(f))
+	}
+}
+
+// RollConvention selects how a date that is not a business day is adjusted.
+type RollConvention int
+
+const (
+	// RollNone keeps dates unadjusted.
+	RollNone RollConvention = iota
+	// RollFollowing moves to the next business day (see DateTime.RollForwardBusiness).
+	RollFollowing
+	// RollPreceding moves to the previous business day (see DateTime.RollBackwardBusiness).
+	RollPreceding
+	// RollModifiedFollowing moves forward unless that changes the month (see DateTime.ModifiedFollowing).
+	RollModifiedFollowing
+	// RollModifiedPreceding moves back unless that changes the month (see DateTime.ModifiedPreceding).
+	RollModifiedPreceding
+	// RollNearest moves to the closer business day, the next one on ties (see DateTime.NearestBusinessDay).
+	RollNearest
+)
+
+// Apply adjusts dt according to the convention.
+// If no holiday checker is provided, it uses the default holiday checker.
+func (c RollConvention) Apply(dt DateTime, holidayChecker ...HolidayChecker) DateTime {
+	switch c {
+	case RollFollowing:
+		return dt.RollForwardBusiness(holidayChecker...)
+	case RollPreceding:
+		return dt.RollBackwardBusiness(holidayChecker...)
+	case RollModifiedFollowing:
+		return dt.ModifiedFollowing(holidayChecker...)
+	case RollModifiedPreceding:
+		return dt.ModifiedPreceding(holidayChecker...)
+	case RollNearest:
+		return dt.NearestBusinessDay(holidayChecker...)
+	default:
+		return dt
+	}
+}
+
+// PaymentSchedule generates periodic payment or coupon dates.
+//
+// Unadjusted dates are computed from a single anchor so that month-end clamping does not
+// drift (a schedule anchored on January 31 pays on February 29, then March 31), and each
+// date is then adjusted with the roll convention and holiday checker.
+type PaymentSchedule struct {
+	Frequency PaymentFrequency
+	Roll      RollConvention
+
+	// EndOfMonth keeps payments on the last day of each month when the anchor is a month end
+	// (e.g. an April 30 anchor pays on May 31, not May 30).
+	EndOfMonth bool
+
+	// FirstPayment is the first unadjusted payment date. When zero, payments start one
+	// frequency interval after the start of the period passed to Dates.
+	FirstPayment DateTime
+
+	// HolidayChecker decides business days for the roll convention.
+	// When nil, the default holiday checker is used.
+	HolidayChecker HolidayChecker
+}
+
+// Dates returns the adjusted payment dates whose unadjusted dates fall within p
+// (after p.Start unless FirstPayment is set, and up to and including p.End).
+//
+// Example:
+//
+//	schedule := chronogo.PaymentSchedule{
+//	    Frequency: chronogo.PaymentQuarterly,
+//	    Roll:      chronogo.RollModifiedFollowing,
+//	    HolidayChecker: chronogo.NewHolidayChecker("US"),
+//	}
+//	loan := chronogo.NewPeriod(chronogo.UTC(2024, 1, 31, 0, 0, 0, 0), chronogo.UTC(2025, 1, 31, 0, 0, 0, 0))
+//	dates := schedule.Dates(loan) // 2024-04-30, 2024-07-31, 2024-10-31, 2025-01-31
+func (s PaymentSchedule) Dates(p Period) []DateTime {
+	p = p.Abs()
+	step := s.Frequency.Months()
+
+	anchor, k := s.FirstPayment, 0
+	if anchor.IsZero() {
+		anchor, k = p.Start, 1
+	}
+
+	var dates []DateTime
+	for ; ; k++ {
+		unadjusted := s.addMonths(anchor, k*step)
+		if unadjusted.After(p.End) {
+			break
+		}
+		if unadjusted.Before(p.Start) {
+			continue
+		}
+		dates = append(dates, s.Roll.Apply(unadjusted, s.HolidayChecker))
+	}
+	return dates
+}
+
+// addMonths adds months to anchor, clamping to the end of shorter months and, with
+// EndOfMonth, keeping month-end anchors on month ends.
+func (s PaymentSchedule) addMonths(anchor DateTime, months int) DateTime {
+	first := Date(anchor.Year(), anchor.Month(), 1, anchor.Hour(), anchor.Minute(), anchor.Second(), anchor.Nanosecond(), anchor.Location()).AddMonths(months)
+	last := DaysInMonthOf(first.Year(), first.Month())
+	day := anchor.Day()
+	if day > last || (s.EndOfMonth && day == DaysInMonthOf(anchor.Year(), anchor.Month())) {
+		day = last
+	}
+	return first.AddDays(day - 1)
+}
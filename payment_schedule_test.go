@@ -0,0 +1,96 @@
+package chronogo
+
+import (
+	"testing"
+	"time"
+)
+
+func TestPaymentScheduleDates(t *testing.T) {
+	checker := NewUSHolidayChecker()
+	dates := func(ds []DateTime) []string {
+		out := make([]string, len(ds))
+		for i, d := range ds {
+			out[i] = d.ToDateString()
+		}
+		return out
+	}
+	equal := func(a, b []string) bool {
+		if len(a) != len(b) {
+			return false
+		}
+		for i := range a {
+			if a[i] != b[i] {
+				return false
+			}
+		}
+		return true
+	}
+
+	tests := []struct {
+		name     string
+		schedule PaymentSchedule
+		period   Period
+		want     []string
+	}{
+		{
+			name:     "monthly from month end clamps without drift",
+			schedule: PaymentSchedule{Frequency: PaymentMonthly},
+			period:   NewPeriod(UTC(2024, time.January, 31, 0, 0, 0, 0), UTC(2024, time.May, 31, 0, 0, 0, 0)),
+			want:     []string{"2024-02-29", "2024-03-31", "2024-04-30", "2024-05-31"},
+		},
+		{
+			name:     "end-of-month anchor",
+			schedule: PaymentSchedule{Frequency: PaymentMonthly, EndOfMonth: true},
+			period:   NewPeriod(UTC(2024, time.April, 30, 0, 0, 0, 0), UTC(2024, time.July, 31, 0, 0, 0, 0)),
+			want:     []string{"2024-05-31", "2024-06-30", "2024-07-31"},
+		},
+		{
+			name:     "quarterly modified following",
+			schedule: PaymentSchedule{Frequency: PaymentQuarterly, Roll: RollModifiedFollowing, HolidayChecker: checker},
+			period:   NewPeriod(UTC(2024, time.March, 31, 0, 0, 0, 0), UTC(2025, time.March, 31, 0, 0, 0, 0)),
+			// 2024-06-30 is a Sunday and rolling forward would leave June
+			want: []string{"2024-06-28", "2024-09-30", "2024-12-31", "2025-03-31"},
+		},
+		{
+			name:     "semiannual following over a holiday",
+			schedule: PaymentSchedule{Frequency: PaymentSemiAnnual, Roll: RollFollowing, HolidayChecker: checker},
+			period:   NewPeriod(UTC(2024, time.January, 4, 0, 0, 0, 0), UTC(2025, time.January, 4, 0, 0, 0, 0)),
+			// July 4 is Independence Day; 2025-01-04 is a Saturday
+			want: []string{"2024-07-05", "2025-01-06"},
+		},
+		{
+			name:     "explicit first payment",
+			schedule: PaymentSchedule{Frequency: PaymentAnnual, FirstPayment: UTC(2024, time.June, 15, 0, 0, 0, 0)},
+			period:   NewPeriod(UTC(2024, time.January, 1, 0, 0, 0, 0), UTC(2027, time.January, 1, 0, 0, 0, 0)),
+			want:     []string{"2024-06-15", "2025-06-15", "2026-06-15"},
+		},
+		{
+			name:     "first payment before the period",
+			schedule: PaymentSchedule{Frequency: PaymentQuarterly, FirstPayment: UTC(2023, time.January, 15, 0, 0, 0, 0)},
+			period:   NewPeriod(UTC(2024, time.January, 1, 0, 0, 0, 0), UTC(2024, time.June, 30, 0, 0, 0, 0)),
+			want:     []string{"2024-01-15", "2024-04-15"},
+		},
+	}
+	for _, tt := range tests {
+		if got := dates(tt.schedule.Dates(tt.period)); !equal(got, tt.want) {
+			t.Errorf("%s: Dates = %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestRollConventionApply(t *testing.T) {
+	checker := NewUSHolidayChecker()
+	sat := UTC(2024, time.August, 31, 9, 0, 0, 0)
+	if got := RollNone.Apply(sat, checker); !got.Equal(sat) {
+		t.Errorf("RollNone = %v", got)
+	}
+	if got := RollFollowing.Apply(sat, checker); got.ToDateString() != "2024-09-03" {
+		t.Errorf("RollFollowing = %v", got)
+	}
+	if got := RollModifiedFollowing.Apply(sat, checker); got.ToDateString() != "2024-08-30" {
+		t.Errorf("RollModifiedFollowing = %v", got)
+	}
+	if PaymentSemiAnnual.Months() != 6 || PaymentQuarterly.String() != "quarterly" {
+		t.Error("unexpected PaymentFrequency values")
+	}
+}
@@ -103,3 +103,39 @@ func BenchmarkPeriodConcurrent(b *testing.B) {
 		}
 	})
 }
+
+// Diff calendar components are computed on first access and cached afterwards
+
+func BenchmarkDiffCalendarFirstAccess(b *testing.B) {
+	start := Date(2019, time.March, 15, 10, 0, 0, 0, time.UTC)
+	end := Date(2024, time.August, 3, 14, 30, 0, 0, time.UTC)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		d := end.Diff(start)
+		_ = d.Years()
+		_ = d.Months()
+	}
+}
+
+func BenchmarkDiffCalendarRepeatedAccess(b *testing.B) {
+	start := Date(2019, time.March, 15, 10, 0, 0, 0, time.UTC)
+	end := Date(2024, time.August, 3, 14, 30, 0, 0, time.UTC)
+	d := end.Diff(start)
+	_ = d.Years()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_ = d.Years()
+		_ = d.Months()
+		_ = d.Quarters()
+	}
+}
+
+func BenchmarkDiffString(b *testing.B) {
+	start := Date(2019, time.March, 15, 10, 0, 0, 0, time.UTC)
+	end := Date(2024, time.August, 3, 14, 30, 0, 0, time.UTC)
+	d := end.Diff(start)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_ = d.String()
+	}
+}
@@ -11,21 +11,72 @@ import (
 type Period struct {
 	Start DateTime
 	End   DateTime
+	// Bounds selects whether End belongs to the period. The zero value,
+	// BoundsClosed, includes it.
+	Bounds PeriodBounds `json:",omitempty"`
 }
 
+// PeriodBounds selects whether a Period includes its End instant. It affects
+// Contains, Overlaps, ContainsPeriod, DayCount, the Range iterators and Buckets.
+type PeriodBounds int
+
+const (
+	// BoundsClosed includes both Start and End: [start, end]. It is the default.
+	BoundsClosed PeriodBounds = iota
+	// BoundsHalfOpen includes Start but not End: [start, end), like SQL range types.
+	// Consecutive half-open periods such as [Jun 1, Jul 1) and [Jul 1, Aug 1) neither
+	// overlap nor leave a gap.
+	BoundsHalfOpen
+)
+
 // NewPeriod creates a new Period between two DateTime instances.
 func NewPeriod(start, end DateTime) Period {
 	return Period{Start: start, End: end}
 }
 
+// NewHalfOpenPeriod creates a Period that includes start but not end, [start, end).
+//
+// Example:
+//
+//	june := chronogo.NewHalfOpenPeriod(jun1, jul1)
+//	june.Contains(jul1) // false
+func NewHalfOpenPeriod(start, end DateTime) Period {
+	return Period{Start: start, End: end, Bounds: BoundsHalfOpen}
+}
+
+// WithBounds returns a copy of the period with the given bounds.
+func (p Period) WithBounds(bounds PeriodBounds) Period {
+	p.Bounds = bounds
+	return p
+}
+
+// IsHalfOpen reports whether the period excludes its End instant.
+func (p Period) IsHalfOpen() bool {
+	return p.Bounds == BoundsHalfOpen
+}
+
+// withinEnd reports whether dt is not past the period's end, honoring its bounds.
+func (p Period) withinEnd(dt DateTime) bool {
+	if p.IsHalfOpen() {
+		return dt.Before(p.End)
+	}
+	return !dt.After(p.End)
+}
+
+// isEmpty reports whether the period contains no instant: a half-open instant.
+func (p Period) isEmpty() bool {
+	return p.IsHalfOpen() && p.Start.Equal(p.End)
+}
+
 // Duration returns the time.Duration of the period.
 func (p Period) Duration() time.Duration {
 	return p.End.Sub(p.Start)
 }
 
-// Contains checks if a DateTime falls within the period.
+// Contains checks if a DateTime falls within the period. Start is always included;
+// End is included unless the period is half-open.
 func (p Period) Contains(dt DateTime) bool {
-	return !dt.Before(p.Start) && !dt.After(p.End)
+	return !dt.Before(p.Start) && p.withinEnd(dt)
 }
 
 // IsNegative returns true if the period represents a negative duration (end before start).
@@ -34,9 +85,17 @@ func (p Period) IsNegative() bool {
 }
 
 // Abs returns a new Period with positive duration.
+//
+// A negative half-open period excludes its End, which is the earlier instant, so
+// swapping the endpoints would exclude the wrong one. Abs moves both endpoints one
+// nanosecond later instead, which covers exactly the same instants as the original.
 func (p Period) Abs() Period {
 	if p.IsNegative() {
-		return Period{Start: p.End, End: p.Start}
+		p.Start, p.End = p.End, p.Start
+		if p.IsHalfOpen() {
+			p.Start = p.Start.Add(time.Nanosecond)
+			p.End = p.End.Add(time.Nanosecond)
+		}
 	}
 	return p
 }
@@ -84,6 +143,30 @@ func (p Period) Months() int {
 	return months
 }
 
+// DayCount returns the number of calendar dates the period touches in its start
+// location, honoring its bounds: the closed period [Jun 1 00:00, Jun 30 00:00]
+// touches 30 dates, and the half-open [Jun 1 00:00, Jul 1 00:00) also 30. Days, by
+// contrast, counts full 24-hour spans. Negative periods are normalized first.
+func (p Period) DayCount() int {
+	p = p.Abs()
+	if p.isEmpty() {
+		return 0
+	}
+	end := p.End.In(p.Start.Location())
+	count := calendarDaysBetween(p.Start, end) + 1
+	if p.IsHalfOpen() && end.Equal(end.StartOfDay()) {
+		count--
+	}
+	return count
+}
+
+// Nights returns the number of nights a stay covering the period spans in hotel
+// terms: the calendar dates from check-in (Start) to check-out (End), taken in the
+// start location, ignoring the times of day and any DST change. See NightsBetween.
+func (p Period) Nights() int {
+	return NightsBetween(p.Start, p.End, p.Start.Location())
+}
+
 // Days returns the number of full days in the period.
 func (p Period) Days() int {
 	duration := p.Duration()
@@ -228,7 +311,7 @@ func (p Period) RangeWithContext(ctx context.Context, unit string, step ...int)
 
 		current := p.Start
 
-		for !current.After(p.End) {
+		for p.withinEnd(current) {
 			select {
 			case <-ctx.Done():
 				return // Context cancelled, stop iteration
@@ -276,7 +359,7 @@ func (p Period) RangeByUnitWithContext(ctx context.Context, unit Unit, step ...i
 		defer close(ch)
 
 		current := p.Start
-		for !current.After(p.End) {
+		for p.withinEnd(current) {
 			select {
 			case <-ctx.Done():
 				return
@@ -379,7 +462,7 @@ func (p Period) RangeByUnitSlice(unit Unit, step ...int) []DateTime {
 	iterationCount := 0
 	maxIterations := 1000 // Safety limit
 
-	for !current.After(p.End) && iterationCount < maxIterations {
+	for p.withinEnd(current) && iterationCount < maxIterations {
 		result = append(result, current)
 		iterationCount++
 
@@ -432,7 +515,7 @@ func (p Period) FastRangeDays(step ...int) []DateTime {
 	iterationCount := 0
 	maxIterations := 1000
 
-	for !current.After(p.End) && iterationCount < maxIterations {
+	for p.withinEnd(current) && iterationCount < maxIterations {
 		result = append(result, current)
 		current = current.AddDays(stepSize)
 		iterationCount++
@@ -442,7 +525,8 @@ func (p Period) FastRangeDays(step ...int) []DateTime {
 }
 
 // Overlaps checks if this period overlaps with another period.
-// Two periods overlap if they share any common time.
+// Two periods overlap if they share any common time, so closed periods that touch
+// at a boundary overlap while half-open ones do not.
 //
 // Example:
 //
@@ -456,14 +540,60 @@ func (p Period) FastRangeDays(step ...int) []DateTime {
 //	)
 //	p1.Overlaps(p2) // Returns true
 func (p Period) Overlaps(other Period) bool {
-	// Two periods overlap if:
-	// - This period's start is before or equal to other's end AND
-	// - This period's end is after or equal to other's start
-	return !p.Start.After(other.End) && !p.End.Before(other.Start)
+	// Two periods overlap if each one starts no later than the other ends (strictly
+	// before the end when that end is excluded)
+	if p.isEmpty() || other.isEmpty() {
+		return false
+	}
+	return other.withinEnd(p.Start) && p.withinEnd(other.Start)
+}
+
+// OverlapDuration returns how long this period and another period overlap, or 0 if
+// they do not. Periods that merely touch at a boundary overlap for 0.
+//
+// Example:
+//
+//	shift := chronogo.NewPeriod(nineAM, fivePM)
+//	meeting := chronogo.NewPeriod(fourPM, sixPM)
+//	shift.OverlapDuration(meeting) // 1h0m0s
+func (p Period) OverlapDuration(other Period) time.Duration {
+	p, other = p.Abs(), other.Abs()
+	start := p.Start
+	if other.Start.After(start) {
+		start = other.Start
+	}
+	end := p.End
+	if other.End.Before(end) {
+		end = other.End
+	}
+	if !end.After(start) {
+		return 0
+	}
+	return end.Sub(start)
 }
 
-// Gap returns the period between this period and another period.
-// If the periods overlap, returns a zero period.
+// CoverageOf returns the fraction of another period that this period covers, from 0
+// (no overlap) to 1 (other lies entirely within this period). For an instantaneous
+// other it is 1 if this period contains the instant and 0 otherwise.
+//
+// Example:
+//
+//	billing := chronogo.NewPeriod(jun1, jul1)
+//	subscription := chronogo.NewPeriod(jun16, aug1)
+//	billing.CoverageOf(subscription) // ~0.33: 15 of the subscription's 46 days
+func (p Period) CoverageOf(other Period) float64 {
+	total := other.Abs().Duration()
+	if total == 0 {
+		if p.Abs().Contains(other.Start) {
+			return 1
+		}
+		return 0
+	}
+	return float64(p.OverlapDuration(other)) / float64(total)
+}
+
+// Gap returns the period between this period and another period, with this
+// period's bounds. If the periods overlap, returns a zero period.
 //
 // Example:
 //
@@ -476,12 +606,12 @@ func (p Period) Gap(other Period) Period {
 		return Period{}
 	}
 
-	// Find which period comes first
-	if p.End.Before(other.Start) {
-		return Period{Start: p.End, End: other.Start}
+	// Find which period comes first; half-open periods may touch without overlapping
+	if !p.End.After(other.Start) {
+		return Period{Start: p.End, End: other.Start, Bounds: p.Bounds}
 	}
 
-	return Period{Start: other.End, End: p.Start}
+	return Period{Start: other.End, End: p.Start, Bounds: p.Bounds}
 }
 
 // Encompasses checks if this period completely contains another period.
@@ -501,8 +631,47 @@ func (p Period) Encompasses(other Period) bool {
 	return !p.Start.After(other.Start) && !p.End.Before(other.End)
 }
 
+// ContainsPeriod reports whether other lies within this period, boundaries
+// included: other may start at p.Start or end at p.End, and a period contains
+// itself. It matches Encompasses except that negative periods are normalized first
+// and a closed other ending at a half-open p's End is not contained.
+//
+// Example:
+//
+//	june.ContainsPeriod(june)                   // true
+//	june.ContainsPeriodStrictly(june)           // false
+//	june.ContainsPeriodStrictly(midJuneMeeting) // true
+func (p Period) ContainsPeriod(other Period) bool {
+	p, other = p.Abs(), other.Abs()
+	if other.End.Equal(p.End) && p.IsHalfOpen() && !other.IsHalfOpen() {
+		// other includes the end instant that p excludes
+		return false
+	}
+	return !other.Start.Before(p.Start) && !other.End.After(p.End)
+}
+
+// ContainsPeriodStrictly reports whether other lies inside this period without
+// touching either boundary: other must start after p.Start and end before p.End.
+// Negative periods are normalized first.
+func (p Period) ContainsPeriodStrictly(other Period) bool {
+	p, other = p.Abs(), other.Abs()
+	return other.Start.After(p.Start) && other.End.Before(p.End)
+}
+
+// Equal reports whether both periods start and end at the same instants, even if
+// their boundaries are in different locations, and have the same bounds.
+func (p Period) Equal(other Period) bool {
+	return p.Start.Equal(other.Start) && p.End.Equal(other.End) && p.Bounds == other.Bounds
+}
+
+// IsInstant reports whether the period has zero length (Start equals End). A closed
+// instant contains only that instant; a half-open one contains nothing.
+func (p Period) IsInstant() bool {
+	return p.Start.Equal(p.End)
+}
+
 // Merge combines this period with another period, returning a new period
-// that spans from the earliest start to the latest end.
+// that spans from the earliest start to the latest end, with this period's bounds.
 //
 // Example:
 //
@@ -520,5 +689,143 @@ func (p Period) Merge(other Period) Period {
 		end = other.End
 	}
 
-	return Period{Start: start, End: end}
+	return Period{Start: start, End: end, Bounds: p.Bounds}
+}
+
+// Shift returns a copy of the period with both boundaries moved by d (earlier when d is negative).
+//
+// Example:
+//
+//	booking := chronogo.NewPeriod(checkIn, checkOut)
+//	later := booking.Shift(2 * time.Hour)
+func (p Period) Shift(d time.Duration) Period {
+	p.Start = p.Start.Add(d)
+	p.End = p.End.Add(d)
+	return p
+}
+
+// ShiftDays returns a copy of the period with both boundaries moved by n calendar days,
+// keeping their wall-clock times across DST changes (see DateTime.AddDays).
+func (p Period) ShiftDays(n int) Period {
+	p.Start = p.Start.AddDays(n)
+	p.End = p.End.AddDays(n)
+	return p
+}
+
+// ExtendEnd returns a copy of the period with its end moved later by d
+// (or earlier, shortening the period, when d is negative).
+func (p Period) ExtendEnd(d time.Duration) Period {
+	p.End = p.End.Add(d)
+	return p
+}
+
+// ExtendStart returns a copy of the period with its start moved earlier by d
+// (or later, shortening the period, when d is negative).
+func (p Period) ExtendStart(d time.Duration) Period {
+	p.Start = p.Start.Add(-d)
+	return p
+}
+
+// WithStart returns a copy of the period with a new start.
+func (p Period) WithStart(start DateTime) Period {
+	p.Start = start
+	return p
+}
+
+// WithEnd returns a copy of the period with a new end.
+//
+// Example:
+//
+//	extended := booking.WithEnd(booking.End.AddDays(1))
+func (p Period) WithEnd(end DateTime) Period {
+	p.End = end
+	return p
+}
+
+// Next returns the period of identical duration that immediately follows p, for
+// "compare to the next period" analytics. A half-open period's successor starts at
+// its End; a closed period's starts one nanosecond later, so the two never share an
+// instant and [Jun 1 00:00, Jun 7 23:59:59.999999999] is followed by [Jun 8 00:00,
+// Jun 14 23:59:59.999999999]. Negative periods are normalized first. The duration is
+// exact, so across a DST change the boundaries move on the wall clock; use
+// NextCalendar for day- and month-aligned periods.
+func (p Period) Next() Period {
+	p = p.Abs()
+	return p.Shift(p.Duration() + p.boundaryGap())
+}
+
+// Previous returns the period of identical duration that immediately precedes p,
+// mirroring Next, for "compare to the previous period" analytics.
+//
+// Example:
+//
+//	last7 := chronogo.NewHalfOpenPeriod(today.SubtractDays(7), today)
+//	before := last7.Previous() // the 7 days before that
+func (p Period) Previous() Period {
+	p = p.Abs()
+	return p.Shift(-p.Duration() - p.boundaryGap())
+}
+
+// NextCalendar returns the period following p with the same calendar length. A period
+// of whole months (such as a month, quarter or year built with StartOfMonth and
+// EndOfMonth, or half-open between month starts) moves by that many months, so
+// February is followed by all of March. A period of whole days moves by that many
+// days, keeping midnight boundaries across DST changes. Other periods behave like
+// Next.
+//
+// Example:
+//
+//	q1 := chronogo.NewPeriod(dt.StartOfQuarter(), dt.EndOfQuarter()) // Jan 1 - Mar 31
+//	q1.NextCalendar()                                                 // Apr 1 - Jun 30 23:59:59.999999999
+func (p Period) NextCalendar() Period {
+	return p.stepCalendar(1)
+}
+
+// PreviousCalendar returns the period preceding p with the same calendar length,
+// mirroring NextCalendar: the month before March is all of February.
+func (p Period) PreviousCalendar() Period {
+	return p.stepCalendar(-1)
+}
+
+// boundaryGap is the space left between p and an adjacent period so that the two do
+// not share an instant: none for half-open periods, a nanosecond for closed ones.
+func (p Period) boundaryGap() time.Duration {
+	if p.IsHalfOpen() {
+		return 0
+	}
+	return time.Nanosecond
+}
+
+// stepCalendar moves a whole-month or whole-day period by its own calendar length in
+// direction dir (1 or -1), falling back to Next and Previous for other periods.
+func (p Period) stepCalendar(dir int) Period {
+	p = p.Abs()
+	start := p.Start
+	end := p.End.Add(p.boundaryGap()).In(start.Location()) // exclusive end
+	atMidnight := func(dt DateTime) bool {
+		return dt.Equal(DateTime{midnight(dt.Year(), dt.Month(), dt.Day(), dt.Location())})
+	}
+
+	if atMidnight(start) && atMidnight(end) && end.After(start) {
+		var next func(dt DateTime) DateTime
+		if start.Day() == 1 && end.Day() == 1 {
+			months := (end.Year()-start.Year())*12 + int(end.Month()-start.Month())
+			next = func(dt DateTime) DateTime {
+				return DateTime{midnight(dt.Year(), dt.Month()+time.Month(dir*months), 1, dt.Location())}
+			}
+		} else {
+			days := calendarDaysBetween(start, end)
+			next = func(dt DateTime) DateTime {
+				return DateTime{midnight(dt.Year(), dt.Month(), dt.Day()+dir*days, dt.Location())}
+			}
+		}
+		p.Start = next(start)
+		p.End = next(end).Add(-p.boundaryGap())
+		return p
+	}
+
+	if dir < 0 {
+		return p.Previous()
+	}
+	return p.Next()
 }
@@ -188,3 +188,203 @@ func TestPeriodMergeNonOverlapping(t *testing.T) {
 			merged.Start.Format("2006-01-02"), merged.End.Format("2006-01-02"))
 	}
 }
+
+func TestPeriodOverlapDuration(t *testing.T) {
+	day := func(d int) DateTime { return Date(2024, 1, d, 0, 0, 0, 0, time.UTC) }
+
+	tests := []struct {
+		name  string
+		p, q  Period
+		want  time.Duration
+		cover float64
+	}{
+		{"partial", NewPeriod(day(1), day(10)), NewPeriod(day(5), day(15)), 5 * 24 * time.Hour, 0.5},
+		{"contained", NewPeriod(day(1), day(31)), NewPeriod(day(5), day(15)), 10 * 24 * time.Hour, 1},
+		{"disjoint", NewPeriod(day(1), day(5)), NewPeriod(day(10), day(15)), 0, 0},
+		{"touching", NewPeriod(day(1), day(5)), NewPeriod(day(5), day(15)), 0, 0},
+		{"negative periods are normalized", NewPeriod(day(10), day(1)), NewPeriod(day(15), day(5)), 5 * 24 * time.Hour, 0.5},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.p.OverlapDuration(tt.q); got != tt.want {
+				t.Errorf("OverlapDuration() = %v, want %v", got, tt.want)
+			}
+			if got := tt.q.OverlapDuration(tt.p); got != tt.want {
+				t.Errorf("OverlapDuration() should be symmetric, got %v", got)
+			}
+			if got := tt.p.CoverageOf(tt.q); got != tt.cover {
+				t.Errorf("CoverageOf() = %v, want %v", got, tt.cover)
+			}
+		})
+	}
+
+	// Coverage of an instant is all or nothing
+	month := NewPeriod(day(1), day(31))
+	if month.CoverageOf(NewPeriod(day(5), day(5))) != 1 || month.CoverageOf(NewPeriod(Date(2024, 3, 1, 0, 0, 0, 0, time.UTC), Date(2024, 3, 1, 0, 0, 0, 0, time.UTC))) != 0 {
+		t.Error("CoverageOf() an instant should be 1 inside the period and 0 outside")
+	}
+}
+
+func TestPeriodContainsPeriodAndEqual(t *testing.T) {
+	day := func(d int) DateTime { return Date(2024, 6, d, 0, 0, 0, 0, time.UTC) }
+	june := NewPeriod(day(1), day(30))
+
+	tests := []struct {
+		name               string
+		other              Period
+		contains, strictly bool
+	}{
+		{"itself", june, true, false},
+		{"inside", NewPeriod(day(10), day(12)), true, true},
+		{"sharing start", NewPeriod(day(1), day(12)), true, false},
+		{"sharing end", NewPeriod(day(10), day(30)), true, false},
+		{"extending past end", NewPeriod(day(10), Date(2024, 7, 2, 0, 0, 0, 0, time.UTC)), false, false},
+		{"negative inside", NewPeriod(day(12), day(10)), true, true},
+	}
+	for _, tt := range tests {
+		if got := june.ContainsPeriod(tt.other); got != tt.contains {
+			t.Errorf("%s: ContainsPeriod() = %v, want %v", tt.name, got, tt.contains)
+		}
+		if got := june.ContainsPeriodStrictly(tt.other); got != tt.strictly {
+			t.Errorf("%s: ContainsPeriodStrictly() = %v, want %v", tt.name, got, tt.strictly)
+		}
+	}
+
+	tokyo, _ := time.LoadLocation("Asia/Tokyo")
+	same := NewPeriod(june.Start.In(tokyo), june.End.In(tokyo))
+	if !june.Equal(same) || june.Equal(NewPeriod(day(1), day(29))) {
+		t.Error("Equal() should compare instants regardless of location")
+	}
+
+	if !NewPeriod(day(5), day(5)).IsInstant() || june.IsInstant() {
+		t.Error("IsInstant() mismatch")
+	}
+}
+
+func TestPeriodBounds(t *testing.T) {
+	jun1 := Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)
+	jul1 := Date(2024, 7, 1, 0, 0, 0, 0, time.UTC)
+	aug1 := Date(2024, 8, 1, 0, 0, 0, 0, time.UTC)
+	closed := NewPeriod(jun1, jul1)
+	june := NewHalfOpenPeriod(jun1, jul1)
+	july := NewHalfOpenPeriod(jul1, aug1)
+
+	if !closed.Contains(jul1) || june.Contains(jul1) || !june.Contains(jun1) {
+		t.Error("Contains() should exclude End only for half-open periods")
+	}
+	if june.Overlaps(july) || july.Overlaps(june) {
+		t.Error("consecutive half-open periods should not overlap")
+	}
+	if !closed.Overlaps(july) || !july.Overlaps(closed) {
+		t.Error("a closed period ending where another starts should overlap it")
+	}
+	if NewHalfOpenPeriod(Date(2024, 6, 10, 0, 0, 0, 0, time.UTC), Date(2024, 6, 10, 0, 0, 0, 0, time.UTC)).Overlaps(june) {
+		t.Error("an empty half-open period overlaps nothing")
+	}
+	if !june.Gap(july).IsInstant() {
+		t.Error("consecutive half-open periods should leave an empty gap")
+	}
+
+	if got := june.DayCount(); got != 30 {
+		t.Errorf("half-open June DayCount() = %d, want 30", got)
+	}
+	if got := closed.DayCount(); got != 31 {
+		t.Errorf("closed [Jun 1, Jul 1] DayCount() = %d, want 31", got)
+	}
+	if got := NewHalfOpenPeriod(Date(2024, 6, 1, 10, 0, 0, 0, time.UTC), Date(2024, 6, 3, 9, 0, 0, 0, time.UTC)).DayCount(); got != 3 {
+		t.Errorf("DayCount() with times = %d, want 3", got)
+	}
+
+	if n := len(june.RangeByUnitSlice(UnitDay)); n != 30 {
+		t.Errorf("half-open RangeByUnitSlice() = %d days, want 30", n)
+	}
+	if n := len(closed.RangeByUnitSlice(UnitDay)); n != 31 {
+		t.Errorf("closed RangeByUnitSlice() = %d days, want 31", n)
+	}
+	count := 0
+	for range june.RangeDays() {
+		count++
+	}
+	if count != 30 || len(june.FastRangeDays()) != 30 {
+		t.Errorf("half-open RangeDays() = %d days, want 30", count)
+	}
+	if n := len(june.Buckets(UnitMonth)); n != 1 {
+		t.Errorf("half-open Buckets(UnitMonth) = %d, want 1", n)
+	}
+
+	if !june.ContainsPeriod(june) || june.ContainsPeriod(closed) || !closed.ContainsPeriod(june) {
+		t.Error("ContainsPeriod() should respect the end bound")
+	}
+	if june.Equal(closed) || !june.Equal(closed.WithBounds(BoundsHalfOpen)) {
+		t.Error("Equal() should compare bounds")
+	}
+	if !NewHalfOpenPeriod(jul1, jun1).Abs().IsHalfOpen() || !june.Merge(july).IsHalfOpen() {
+		t.Error("Abs() and Merge() should keep the bounds")
+	}
+
+	// A negative half-open period excludes its (earlier) End; Abs keeps it excluded
+	backwards := NewHalfOpenPeriod(jul1, jun1) // covers (jun1, jul1]
+	abs := backwards.Abs()
+	if abs.Contains(jun1) || !abs.Contains(jul1) || !abs.Contains(jun1.Add(time.Nanosecond)) {
+		t.Errorf("Abs() of a negative half-open period = %v, want (jun1, jul1]", abs)
+	}
+	if abs.IsNegative() || abs.Duration() != jul1.Sub(jun1) {
+		t.Errorf("Abs().Duration() = %v, want %v", abs.Duration(), jul1.Sub(jun1))
+	}
+	if closedAbs := NewPeriod(jul1, jun1).Abs(); !closedAbs.Start.Equal(jun1) || !closedAbs.End.Equal(jul1) {
+		t.Errorf("Abs() of a negative closed period = %v", closedAbs)
+	}
+}
+
+func TestPeriodNextPrevious(t *testing.T) {
+	week := NewPeriod(UTC(2024, time.June, 1, 0, 0, 0, 0), UTC(2024, time.June, 7, 23, 59, 59, 999999999))
+	next := week.Next()
+	if !next.Start.Equal(UTC(2024, time.June, 8, 0, 0, 0, 0)) || !next.End.Equal(UTC(2024, time.June, 14, 23, 59, 59, 999999999)) {
+		t.Errorf("Next() = %v", next)
+	}
+	if prev := week.Previous(); !prev.Start.Equal(UTC(2024, time.May, 25, 0, 0, 0, 0)) || prev.Duration() != week.Duration() {
+		t.Errorf("Previous() = %v", prev)
+	}
+	if back := next.Previous(); !back.Equal(week) {
+		t.Errorf("Next().Previous() = %v, want %v", back, week)
+	}
+
+	halfOpen := NewHalfOpenPeriod(UTC(2024, time.June, 1, 9, 0, 0, 0), UTC(2024, time.June, 1, 17, 0, 0, 0))
+	if got := halfOpen.Next(); !got.Start.Equal(halfOpen.End) || !got.IsHalfOpen() || got.Duration() != 8*time.Hour {
+		t.Errorf("half-open Next() = %v", got)
+	}
+	if got := NewPeriod(week.End, week.Start).Next(); !got.Equal(next) {
+		t.Errorf("negative period Next() = %v", got)
+	}
+}
+
+func TestPeriodNextCalendar(t *testing.T) {
+	ny, _ := time.LoadLocation("America/New_York")
+	feb := NewPeriod(Date(2024, time.February, 1, 0, 0, 0, 0, ny), Date(2024, time.February, 29, 0, 0, 0, 0, ny).EndOfDay())
+	march := feb.NextCalendar()
+	if !march.Start.Equal(Date(2024, time.March, 1, 0, 0, 0, 0, ny)) || !march.End.Equal(Date(2024, time.March, 31, 0, 0, 0, 0, ny).EndOfDay()) {
+		t.Errorf("NextCalendar(Feb) = %v", march)
+	}
+	if jan := feb.PreviousCalendar(); jan.Start.Month() != time.January || jan.End.Day() != 31 {
+		t.Errorf("PreviousCalendar(Feb) = %v", jan)
+	}
+
+	q1 := NewHalfOpenPeriod(UTC(2024, time.January, 1, 0, 0, 0, 0), UTC(2024, time.April, 1, 0, 0, 0, 0))
+	if q2 := q1.NextCalendar(); !q2.Start.Equal(UTC(2024, time.April, 1, 0, 0, 0, 0)) || !q2.End.Equal(UTC(2024, time.July, 1, 0, 0, 0, 0)) {
+		t.Errorf("NextCalendar(Q1) = %v", q2)
+	}
+
+	// Whole days keep midnight across the spring-forward change
+	week := NewHalfOpenPeriod(Date(2024, time.March, 4, 0, 0, 0, 0, ny), Date(2024, time.March, 11, 0, 0, 0, 0, ny))
+	if got := week.NextCalendar(); !got.End.Equal(Date(2024, time.March, 18, 0, 0, 0, 0, ny)) {
+		t.Errorf("NextCalendar(week) = %v", got)
+	}
+	if got := week.Next(); got.End.Hour() != 23 {
+		t.Errorf("Next(week) across DST should move the wall clock, got %v", got.End)
+	}
+
+	shift := NewPeriod(UTC(2024, time.June, 1, 9, 0, 0, 0), UTC(2024, time.June, 1, 17, 0, 0, 0))
+	if got := shift.NextCalendar(); !got.Equal(shift.Next()) {
+		t.Errorf("NextCalendar(unaligned) = %v, want Next()", got)
+	}
+}
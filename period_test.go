@@ -820,3 +820,48 @@ func TestRangeByUnitSliceZeroStep(t *testing.T) {
 		t.Errorf("Zero step should default to 1: expected %d items, got %d", expected, len(result))
 	}
 }
+
+func TestPeriodShiftAndExtend(t *testing.T) {
+	start := Date(2024, time.June, 10, 14, 0, 0, 0, time.UTC)
+	end := Date(2024, time.June, 12, 11, 0, 0, 0, time.UTC)
+	p := NewPeriod(start, end)
+
+	shifted := p.Shift(2 * time.Hour)
+	if !shifted.Start.Equal(start.AddHours(2)) || !shifted.End.Equal(end.AddHours(2)) {
+		t.Errorf("Shift(2h) = %v - %v", shifted.Start, shifted.End)
+	}
+	if back := p.Shift(-time.Hour); !back.Start.Equal(start.AddHours(-1)) || back.Duration() != p.Duration() {
+		t.Errorf("Shift(-1h) = %v - %v", back.Start, back.End)
+	}
+	if days := p.ShiftDays(3); !days.Start.Equal(start.AddDays(3)) || !days.End.Equal(end.AddDays(3)) {
+		t.Errorf("ShiftDays(3) = %v - %v", days.Start, days.End)
+	}
+	if longer := p.ExtendEnd(24 * time.Hour); !longer.Start.Equal(start) || !longer.End.Equal(end.AddDays(1)) {
+		t.Errorf("ExtendEnd(24h) = %v - %v", longer.Start, longer.End)
+	}
+	if earlier := p.ExtendStart(time.Hour); !earlier.Start.Equal(start.AddHours(-1)) || !earlier.End.Equal(end) {
+		t.Errorf("ExtendStart(1h) = %v - %v", earlier.Start, earlier.End)
+	}
+
+	newStart := Date(2024, time.June, 11, 0, 0, 0, 0, time.UTC)
+	if got := p.WithStart(newStart); !got.Start.Equal(newStart) || !got.End.Equal(end) {
+		t.Errorf("WithStart = %v - %v", got.Start, got.End)
+	}
+	if got := p.WithEnd(newStart); !got.Start.Equal(start) || !got.End.Equal(newStart) {
+		t.Errorf("WithEnd = %v - %v", got.Start, got.End)
+	}
+	if !p.Start.Equal(start) || !p.End.Equal(end) {
+		t.Error("Expected the original period to be unchanged")
+	}
+
+	// ShiftDays keeps wall-clock times across DST; Shift keeps elapsed time
+	if ny, err := time.LoadLocation("America/New_York"); err == nil {
+		booking := NewPeriod(Date(2024, time.March, 9, 15, 0, 0, 0, ny), Date(2024, time.March, 9, 18, 0, 0, 0, ny))
+		if got := booking.ShiftDays(1); got.Start.Hour() != 15 {
+			t.Errorf("ShiftDays(1) across DST start hour = %d, want 15", got.Start.Hour())
+		}
+		if got := booking.Shift(24 * time.Hour); got.Start.Hour() != 16 {
+			t.Errorf("Shift(24h) across DST start hour = %d, want 16", got.Start.Hour())
+		}
+	}
+}
@@ -0,0 +1,250 @@
+package chronogo
+
+import (
+	"database/sql/driver"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Value implements the driver.Valuer interface, writing the duration as an ISO 8601
+// duration string ("P1DT2H30M"), which PostgreSQL accepts as interval input.
+func (cd ChronoDuration) Value() (driver.Value, error) {
+	return cd.FormatISO(), nil
+}
+
+// Scan implements the sql.Scanner interface for PostgreSQL interval columns. It
+// accepts every IntervalStyle: postgres ("1 year 2 mons 3 days 04:05:06.789"),
+// postgres_verbose ("@ 1 year 2 mons ago"), sql_standard ("1-2 3 4:05:06") and
+// iso_8601 ("P1Y2M3DT4H5M6S"). As in ParseISODuration, a year counts as 365.25
+// days and a month as 30.44 days, since a ChronoDuration has no calendar context.
+func (cd *ChronoDuration) Scan(value any) error {
+	var s string
+	switch v := value.(type) {
+	case string:
+		s = v
+	case []byte:
+		s = string(v)
+	case time.Duration:
+		*cd = ChronoDuration{v}
+		return nil
+	case nil:
+		*cd = ChronoDuration{}
+		return nil
+	default:
+		return fmt.Errorf("unsupported Scan type %T", value)
+	}
+
+	d, err := parsePostgresInterval(s)
+	if err != nil {
+		return err
+	}
+	*cd = d
+	return nil
+}
+
+// Approximate calendar units, matching ParseISODuration.
+const (
+	intervalYear  = time.Duration(365.25 * 24 * float64(time.Hour))
+	intervalMonth = time.Duration(30.44 * 24 * float64(time.Hour))
+)
+
+// postgresIntervalUnits maps interval unit words, singular and plural, to durations.
+var postgresIntervalUnits = map[string]time.Duration{
+	"year": intervalYear, "years": intervalYear,
+	"mon": intervalMonth, "mons": intervalMonth, "month": intervalMonth, "months": intervalMonth,
+	"day": 24 * time.Hour, "days": 24 * time.Hour,
+	"hour": time.Hour, "hours": time.Hour,
+	"min": time.Minute, "mins": time.Minute, "minute": time.Minute, "minutes": time.Minute,
+	"sec": time.Second, "secs": time.Second, "second": time.Second, "seconds": time.Second,
+}
+
+// parsePostgresInterval parses an interval in any PostgreSQL IntervalStyle.
+func parsePostgresInterval(s string) (ChronoDuration, error) {
+	input := s
+	s = strings.TrimSpace(s)
+	if strings.HasPrefix(s, "P") || strings.HasPrefix(s, "-P") || strings.HasPrefix(s, "+P") {
+		d, err := ParseISODuration(s)
+		if err != nil {
+			return ChronoDuration{}, ParseError(input, fmt.Errorf("%w: %v", ErrInvalidDuration, err))
+		}
+		return d, nil
+	}
+
+	// postgres_verbose: "@ 1 day 2 hours ago"
+	negate := false
+	if rest, ok := strings.CutPrefix(s, "@"); ok {
+		s = strings.TrimSpace(rest)
+		if rest, ok := strings.CutSuffix(s, " ago"); ok {
+			s, negate = rest, true
+		}
+	}
+
+	invalid := func() (ChronoDuration, error) {
+		return ChronoDuration{}, ParseError(input, fmt.Errorf("%w: unrecognized interval", ErrInvalidDuration))
+	}
+	fields := strings.Fields(s)
+	if len(fields) == 0 {
+		return invalid()
+	}
+
+	var total time.Duration
+	for i := 0; i < len(fields); i++ {
+		field := fields[i]
+		switch {
+		case strings.Contains(field, ":"):
+			d, ok := parsePostgresClock(field)
+			if !ok {
+				return invalid()
+			}
+			total += d
+		case strings.Contains(field[1:], "-"):
+			// sql_standard year-month field: "1-2"
+			sign := time.Duration(1)
+			if field[0] == '-' {
+				sign, field = -1, field[1:]
+			}
+			y, m, _ := strings.Cut(field, "-")
+			years, err1 := strconv.Atoi(y)
+			months, err2 := strconv.Atoi(m)
+			if err1 != nil || err2 != nil {
+				return invalid()
+			}
+			total += sign * (time.Duration(years)*intervalYear + time.Duration(months)*intervalMonth)
+		default:
+			n, err := strconv.ParseFloat(field, 64)
+			if err != nil {
+				return invalid()
+			}
+			unit := 24 * time.Hour // a bare number in sql_standard style is days
+			if i+1 < len(fields) {
+				if u, ok := postgresIntervalUnits[strings.ToLower(fields[i+1])]; ok {
+					unit = u
+					i++
+				}
+			}
+			total += time.Duration(n * float64(unit))
+		}
+	}
+	if negate {
+		total = -total
+	}
+	return ChronoDuration{total}, nil
+}
+
+// parsePostgresClock parses a signed "HH:MM:SS[.ffffff]" or "HH:MM" interval field.
+func parsePostgresClock(field string) (time.Duration, bool) {
+	sign := time.Duration(1)
+	switch field[0] {
+	case '-':
+		sign, field = -1, field[1:]
+	case '+':
+		field = field[1:]
+	}
+	parts := strings.Split(field, ":")
+	if len(parts) < 2 || len(parts) > 3 {
+		return 0, false
+	}
+	hours, err1 := strconv.Atoi(parts[0])
+	minutes, err2 := strconv.Atoi(parts[1])
+	if err1 != nil || err2 != nil {
+		return 0, false
+	}
+	d := time.Duration(hours)*time.Hour + time.Duration(minutes)*time.Minute
+	if len(parts) == 3 {
+		secs, err := strconv.ParseFloat(parts[2], 64)
+		if err != nil {
+			return 0, false
+		}
+		d += time.Duration(secs * float64(time.Second))
+	}
+	return sign * d, true
+}
+
+// Value implements the driver.Valuer interface, writing the period as a PostgreSQL
+// tstzrange literal with an inclusive start. The end bracket follows Bounds: "]" for
+// closed periods and ")" for half-open ones, as in
+// ["2024-01-01T00:00:00Z","2024-02-01T00:00:00Z").
+func (p Period) Value() (driver.Value, error) {
+	layout := "2006-01-02T15:04:05.999999999Z07:00"
+	closing := ']'
+	if p.IsHalfOpen() {
+		closing = ')'
+	}
+	return fmt.Sprintf(`[%q,%q%c`, p.Start.Format(layout), p.End.Format(layout), closing), nil
+}
+
+// Scan implements the sql.Scanner interface for PostgreSQL tstzrange and tsrange
+// columns, such as ["2024-01-01 00:00:00+00","2024-02-01 00:00:00+00"). The bound
+// values become Start and End, and a closing ")" scans as BoundsHalfOpen while "]"
+// scans as BoundsClosed. Period always includes Start, so the opening bracket is not
+// recorded. An empty range scans as the zero Period. Unbounded or infinite ranges
+// cannot be represented and return an error.
+func (p *Period) Scan(value any) error {
+	var s string
+	switch v := value.(type) {
+	case string:
+		s = v
+	case []byte:
+		s = string(v)
+	case nil:
+		*p = Period{}
+		return nil
+	default:
+		return fmt.Errorf("unsupported Scan type %T", value)
+	}
+
+	input := s
+	s = strings.TrimSpace(s)
+	if strings.EqualFold(s, "empty") {
+		*p = Period{}
+		return nil
+	}
+	if len(s) < 3 || (s[0] != '[' && s[0] != '(') || (s[len(s)-1] != ']' && s[len(s)-1] != ')') {
+		return ParseError(input, fmt.Errorf("%w: not a range literal", ErrInvalidFormat))
+	}
+	lower, upper, ok := strings.Cut(s[1:len(s)-1], ",")
+	if !ok {
+		return ParseError(input, fmt.Errorf("%w: range needs two bounds", ErrInvalidFormat))
+	}
+
+	start, err := parsePostgresTimestamp(lower)
+	if err != nil {
+		return ParseError(input, err)
+	}
+	end, err := parsePostgresTimestamp(upper)
+	if err != nil {
+		return ParseError(input, err)
+	}
+	bounds := BoundsClosed
+	if s[len(s)-1] == ')' {
+		bounds = BoundsHalfOpen
+	}
+	*p = Period{Start: start, End: end, Bounds: bounds}
+	return nil
+}
+
+// postgresTimestampLayouts are the text forms PostgreSQL uses for timestamps.
+var postgresTimestampLayouts = []string{
+	"2006-01-02 15:04:05.999999999Z07",
+	"2006-01-02 15:04:05.999999999Z07:00",
+	"2006-01-02 15:04:05.999999999Z07:00:00",
+	"2006-01-02 15:04:05.999999999",
+	time.RFC3339Nano,
+}
+
+// parsePostgresTimestamp parses a range bound, which may be quoted.
+func parsePostgresTimestamp(bound string) (DateTime, error) {
+	bound = strings.Trim(strings.TrimSpace(bound), `"`)
+	switch strings.ToLower(bound) {
+	case "", "infinity", "-infinity":
+		return DateTime{}, fmt.Errorf("%w: unbounded ranges are not supported", ErrInvalidRange)
+	}
+	for _, layout := range postgresTimestampLayouts {
+		if t, err := time.Parse(layout, bound); err == nil {
+			return DateTime{t}, nil
+		}
+	}
+	return DateTime{}, fmt.Errorf("%w: unrecognized timestamp %q", ErrInvalidFormat, bound)
+}
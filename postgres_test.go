@@ -0,0 +1,151 @@
+package chronogo
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestChronoDurationScanInterval(t *testing.T) {
+	day := 24 * time.Hour
+	year := time.Duration(365.25 * 24 * float64(time.Hour))
+	month := time.Duration(30.44 * 24 * float64(time.Hour))
+	clock := 4*time.Hour + 5*time.Minute + 6789*time.Millisecond
+
+	tests := []struct {
+		input any
+		want  time.Duration
+	}{
+		{"1 year 2 mons 3 days 04:05:06.789", year + 2*month + 3*day + clock},
+		{[]byte("3 days"), 3 * day},
+		{"-1 days +02:03:00", -day + 2*time.Hour + 3*time.Minute},
+		{"00:00:01.5", 1500 * time.Millisecond},
+		{"-00:30:00", -30 * time.Minute},
+		{"@ 1 year 2 mons 3 days 4 hours 5 mins 6.789 secs", year + 2*month + 3*day + clock},
+		{"@ 2 hours ago", -2 * time.Hour},
+		{"1-2 3 4:05:06.789", year + 2*month + 3*day + clock},
+		{"P1Y2M3DT4H5M6.789S", year + 2*month + 3*day + clock},
+		{90 * time.Second, 90 * time.Second},
+		{nil, 0},
+	}
+	for _, tt := range tests {
+		var cd ChronoDuration
+		if err := cd.Scan(tt.input); err != nil {
+			t.Errorf("Scan(%v) error = %v", tt.input, err)
+			continue
+		}
+		if diff := cd.Duration - tt.want; diff > time.Microsecond || diff < -time.Microsecond {
+			t.Errorf("Scan(%v) = %v, want %v", tt.input, cd.Duration, tt.want)
+		}
+	}
+
+	for _, input := range []any{"", "3 fortnights", "1 day 25:xx", 42} {
+		var cd ChronoDuration
+		if err := cd.Scan(input); err == nil {
+			t.Errorf("Scan(%v) expected error", input)
+		}
+	}
+}
+
+func TestChronoDurationValue(t *testing.T) {
+	cd := NewDuration(26*time.Hour + 30*time.Minute)
+	v, err := cd.Value()
+	if err != nil || v != "P1DT2H30M" {
+		t.Errorf("Value() = %v, %v; want P1DT2H30M", v, err)
+	}
+
+	var back ChronoDuration
+	if err := back.Scan(v); err != nil || back != cd {
+		t.Errorf("Scan(Value()) = %v, %v; want %v", back, err, cd)
+	}
+}
+
+func TestPeriodScanRange(t *testing.T) {
+	start := Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC)
+	end := Date(2024, time.February, 1, 0, 0, 0, 0, time.UTC)
+
+	inputs := []any{
+		`["2024-01-01 00:00:00+00","2024-02-01 00:00:00+00")`,
+		[]byte(`["2024-01-01 01:00:00+01","2024-02-01 05:30:00+05:30")`),
+		`[2024-01-01T00:00:00Z,2024-02-01T00:00:00Z]`,
+		`("2024-01-01 00:00:00","2024-02-01 00:00:00")`,
+	}
+	for _, input := range inputs {
+		var p Period
+		if err := p.Scan(input); err != nil {
+			t.Errorf("Scan(%s) error = %v", input, err)
+			continue
+		}
+		if !p.Start.Equal(start) || !p.End.Equal(end) {
+			t.Errorf("Scan(%s) = %v, want %v to %v", input, p, start, end)
+		}
+	}
+
+	bounds := map[string]PeriodBounds{
+		`["2024-01-01 00:00:00+00","2024-02-01 00:00:00+00")`: BoundsHalfOpen,
+		`["2024-01-01 00:00:00+00","2024-02-01 00:00:00+00"]`: BoundsClosed,
+		`("2024-01-01 00:00:00+00","2024-02-01 00:00:00+00"]`: BoundsClosed,
+	}
+	for input, want := range bounds {
+		var p Period
+		if err := p.Scan(input); err != nil || p.Bounds != want {
+			t.Errorf("Scan(%s) bounds = %v, %v, want %v", input, p.Bounds, err, want)
+		}
+	}
+
+	var p Period
+	if err := p.Scan("empty"); err != nil || !p.Start.IsZero() || !p.End.IsZero() {
+		t.Errorf("Scan(empty) = %v, %v", p, err)
+	}
+	if err := p.Scan(`["2024-01-01 00:00:00+00",)`); !errors.Is(err, ErrInvalidRange) {
+		t.Errorf("Scan(unbounded) error = %v, want ErrInvalidRange", err)
+	}
+	if err := p.Scan(`["2024-01-01 00:00:00+00",infinity)`); !errors.Is(err, ErrInvalidRange) {
+		t.Errorf("Scan(infinity) error = %v, want ErrInvalidRange", err)
+	}
+	for _, input := range []any{"2024-01-01", `["tomorrow","later")`, 1} {
+		if err := p.Scan(input); err == nil {
+			t.Errorf("Scan(%v) expected error", input)
+		}
+	}
+}
+
+func TestPeriodValue(t *testing.T) {
+	ny, _ := time.LoadLocation("America/New_York")
+	p := NewPeriod(Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC), Date(2024, time.January, 1, 9, 30, 0, 500000000, ny))
+	v, err := p.Value()
+	if err != nil {
+		t.Fatalf("Value() error = %v", err)
+	}
+	if want := `["2024-01-01T00:00:00Z","2024-01-01T09:30:00.5-05:00"]`; v != want {
+		t.Errorf("Value() = %v, want %v", v, want)
+	}
+
+	v, err = p.WithBounds(BoundsHalfOpen).Value()
+	if err != nil {
+		t.Fatalf("Value() error = %v", err)
+	}
+	if want := `["2024-01-01T00:00:00Z","2024-01-01T09:30:00.5-05:00")`; v != want {
+		t.Errorf("Value() half-open = %v, want %v", v, want)
+	}
+}
+
+func TestPeriodValueScanRoundTrip(t *testing.T) {
+	start := Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC)
+	end := Date(2024, time.February, 1, 0, 0, 0, 0, time.UTC)
+
+	for _, p := range []Period{NewPeriod(start, end), NewHalfOpenPeriod(start, end)} {
+		v, err := p.Value()
+		if err != nil {
+			t.Fatalf("Value() error = %v", err)
+		}
+		var back Period
+		if err := back.Scan(v); err != nil {
+			t.Errorf("Scan(%v) error = %v", v, err)
+			continue
+		}
+		if !back.Equal(p) {
+			t.Errorf("Scan(%v) = %+v, want %+v", v, back, p)
+		}
+	}
+}
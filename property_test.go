@@ -0,0 +1,141 @@
+package chronogo
+
+import (
+	"math/rand"
+	"reflect"
+	"testing"
+	"testing/quick"
+	"time"
+)
+
+// propertyZones mixes fixed zones with northern, southern, half-hour and
+// midnight-transition DST rules.
+var propertyZones = []string{
+	"UTC",
+	"America/New_York",
+	"Europe/London",
+	"Australia/Sydney",
+	"Australia/Lord_Howe",
+	"America/Santiago",
+	"America/Havana",
+	"Asia/Kolkata",
+	"Pacific/Chatham",
+}
+
+// randomDateTime is a DateTime generated for testing/quick.
+type randomDateTime struct {
+	DateTime
+}
+
+// Generate implements quick.Generator. Instants are spread over 1970–2100, with
+// extra weight just around zone transitions and the hours DST rules usually change.
+func (randomDateTime) Generate(r *rand.Rand, _ int) reflect.Value {
+	loc := time.UTC
+	if l, err := time.LoadLocation(propertyZones[r.Intn(len(propertyZones))]); err == nil {
+		loc = l
+	}
+	var t time.Time
+	switch r.Intn(3) {
+	case 0:
+		t = time.Unix(r.Int63n(4102444800), r.Int63n(1e9)).In(loc)
+	case 1:
+		// Within two hours of a zone transition
+		t = time.Date(1990+r.Intn(50), time.Month(1+r.Intn(12)), 1, 0, 0, 0, 0, loc)
+		if _, next := t.ZoneBounds(); !next.IsZero() {
+			t = next
+		}
+		t = t.Add(time.Duration(r.Int63n(int64(4*time.Hour))) - 2*time.Hour)
+	default:
+		// Near midnight or 01:00-03:00, where transitions happen
+		hours := []int{0, 1, 2, 3, 23}
+		t = time.Date(1990+r.Intn(50), time.Month(1+r.Intn(12)), 1+r.Intn(28),
+			hours[r.Intn(len(hours))], r.Intn(60), r.Intn(60), r.Intn(1e9), loc)
+	}
+	return reflect.ValueOf(randomDateTime{DateTime{t}})
+}
+
+var propertyConfig = &quick.Config{MaxCount: 2000}
+
+func TestPropertyAddDaysRoundTrip(t *testing.T) {
+	f := func(rdt randomDateTime, n int16) bool {
+		dt := rdt.DateTime
+		days := int(n)
+		if got := dt.AddDaysAbsolute(days).AddDaysAbsolute(-days); !got.Equal(dt) {
+			t.Logf("AddDaysAbsolute round trip of %v by %d = %v", dt, days, got)
+			return false
+		}
+
+		// Wall-clock arithmetic keeps the clock reading, unless the intermediate
+		// day has no such reading (DST gap)
+		mid := time.Date(dt.Year(), dt.Month(), dt.Day()+days, dt.Hour(), dt.Minute(), dt.Second(), dt.Nanosecond(), time.UTC)
+		if IsNonExistent(mid.Year(), mid.Month(), mid.Day(), mid.Hour(), mid.Minute(), mid.Second(), mid.Nanosecond(), dt.Location()) {
+			return true
+		}
+		got := dt.AddDays(days).SubtractDays(days)
+		if !sameWallClock(got.Time, dt.Time) {
+			t.Logf("AddDays(%d).SubtractDays(%d) of %v = %v", days, days, dt, got)
+			return false
+		}
+		return true
+	}
+	if err := quick.Check(f, propertyConfig); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestPropertyDayBounds(t *testing.T) {
+	f := func(rdt randomDateTime) bool {
+		dt := rdt.DateTime
+		start, end := dt.StartOfDay(), dt.EndOfDay()
+		if start.After(dt) || end.Before(dt) {
+			t.Logf("%v not within [%v, %v]", dt, start, end)
+			return false
+		}
+		return true
+	}
+	if err := quick.Check(f, propertyConfig); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestPropertyDiffInvert(t *testing.T) {
+	f := func(a, b randomDateTime) bool {
+		d, inv := a.Diff(b.DateTime), b.Diff(a.DateTime)
+		got := d.Invert()
+		if got.Duration() != inv.Duration() || got.Years() != inv.Years() ||
+			got.Months() != inv.Months() || got.Days() != inv.Days() {
+			t.Logf("Diff(%v, %v).Invert() = %v, want %v", a, b, got, inv)
+			return false
+		}
+		return true
+	}
+	if err := quick.Check(f, propertyConfig); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestPropertyTruncateRoundIdempotent(t *testing.T) {
+	units := []Unit{UnitSecond, UnitMinute, UnitHour, UnitDay, UnitWeek, UnitMonth, UnitQuarter, UnitYear}
+	f := func(rdt randomDateTime, u uint8) bool {
+		dt := rdt.DateTime
+		unit := units[int(u)%len(units)]
+		truncated := dt.Truncate(unit)
+		if again := truncated.Truncate(unit); !again.Equal(truncated) {
+			t.Logf("Truncate(%v) of %v: %v then %v", unit, dt, truncated, again)
+			return false
+		}
+		if truncated.After(dt) {
+			t.Logf("Truncate(%v) of %v = %v is after the input", unit, dt, truncated)
+			return false
+		}
+		rounded := dt.Round(unit)
+		if again := rounded.Round(unit); !again.Equal(rounded) {
+			t.Logf("Round(%v) of %v: %v then %v", unit, dt, rounded, again)
+			return false
+		}
+		return true
+	}
+	if err := quick.Check(f, propertyConfig); err != nil {
+		t.Error(err)
+	}
+}
@@ -0,0 +1,102 @@
+package chronogo
+
+import (
+	"fmt"
+	"time"
+)
+
+// FractionOfDay returns how much of dt's day has elapsed, from 0 at midnight towards
+// 1 at the next midnight. Days are measured in elapsed time, so on DST transition days
+// noon is not exactly 0.5.
+//
+// Example:
+//
+//	chronogo.Date(2024, time.June, 15, 18, 0, 0, 0, time.UTC).FractionOfDay() // 0.75
+func (dt DateTime) FractionOfDay() float64 {
+	loc := dt.Location()
+	return fractionBetween(dt.Time, midnight(dt.Year(), dt.Month(), dt.Day(), loc), midnight(dt.Year(), dt.Month(), dt.Day()+1, loc))
+}
+
+// FractionOfMonth returns how much of dt's month has elapsed, from 0 at the start of
+// the first day towards 1 at the start of the next month. It accounts for the length
+// of the month, including February in leap years.
+//
+// Example:
+//
+//	chronogo.Date(2024, time.February, 15, 12, 0, 0, 0, time.UTC).FractionOfMonth() // 0.5
+func (dt DateTime) FractionOfMonth() float64 {
+	loc := dt.Location()
+	return fractionBetween(dt.Time, midnight(dt.Year(), dt.Month(), 1, loc), midnight(dt.Year(), dt.Month()+1, 1, loc))
+}
+
+// FractionOfQuarter returns how much of dt's quarter has elapsed, from 0 towards 1.
+func (dt DateTime) FractionOfQuarter() float64 {
+	start := dt.StartOfQuarter()
+	return fractionBetween(dt.Time, start.Time, midnight(start.Year(), start.Month()+3, 1, dt.Location()))
+}
+
+// FractionOfYear returns how much of dt's year has elapsed, from 0 at the start of
+// January 1 towards 1 at the start of the next year, over 365 or 366 days.
+//
+// Example:
+//
+//	chronogo.Date(2024, time.July, 2, 0, 0, 0, 0, time.UTC).FractionOfYear() // 0.5 (183 of 366 days)
+func (dt DateTime) FractionOfYear() float64 {
+	loc := dt.Location()
+	return fractionBetween(dt.Time, midnight(dt.Year(), time.January, 1, loc), midnight(dt.Year()+1, time.January, 1, loc))
+}
+
+// fractionBetween returns the position of t between start and end as a fraction.
+func fractionBetween(t, start, end time.Time) float64 {
+	return float64(t.Sub(start)) / float64(end.Sub(start))
+}
+
+// YearMonth identifies a calendar month of a particular year, for keys such as those
+// returned by Period.ProrateAcrossMonths.
+type YearMonth struct {
+	Year  int
+	Month time.Month
+}
+
+// String returns the month in ISO 8601 form, such as "2024-02".
+func (ym YearMonth) String() string {
+	return fmt.Sprintf("%04d-%02d", ym.Year, int(ym.Month))
+}
+
+// ProrateAcrossMonths returns, for every calendar month the period touches, the
+// fraction of that month the period covers, for billing proration: a monthly price
+// times each fraction gives the charge for that month. Months are taken in the
+// location of p.Start and measured in elapsed time, so leap years and month lengths
+// are handled without day counting. A negative period is normalized first; an
+// instantaneous one returns an empty map.
+//
+// Example:
+//
+//	sub := chronogo.NewPeriod(
+//	    chronogo.Date(2024, time.January, 16, 0, 0, 0, 0, time.UTC),
+//	    chronogo.Date(2024, time.March, 1, 0, 0, 0, 0, time.UTC),
+//	)
+//	sub.ProrateAcrossMonths()
+//	// {2024 January}: 0.516 (16 of 31 days), {2024 February}: 1
+func (p Period) ProrateAcrossMonths() map[YearMonth]float64 {
+	p = p.Abs()
+	loc := p.Start.Location()
+	start, end := p.Start.Time, p.End.In(loc).Time
+
+	result := make(map[YearMonth]float64)
+	for monthStart := midnight(start.Year(), start.Month(), 1, loc); monthStart.Before(end); {
+		monthEnd := midnight(monthStart.Year(), monthStart.Month()+1, 1, loc)
+		from, to := monthStart, monthEnd
+		if start.After(from) {
+			from = start
+		}
+		if end.Before(to) {
+			to = end
+		}
+		if to.After(from) {
+			result[YearMonth{monthStart.Year(), monthStart.Month()}] = fractionBetween(to, monthStart, monthEnd) - fractionBetween(from, monthStart, monthEnd)
+		}
+		monthStart = monthEnd
+	}
+	return result
+}
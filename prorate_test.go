@@ -0,0 +1,68 @@
+package chronogo
+
+import (
+	"math"
+	"testing"
+	"time"
+)
+
+func TestFractionOf(t *testing.T) {
+	tests := []struct {
+		name string
+		got  float64
+		want float64
+	}{
+		{"day at 18:00", Date(2024, time.June, 15, 18, 0, 0, 0, time.UTC).FractionOfDay(), 0.75},
+		{"day at midnight", Date(2024, time.June, 15, 0, 0, 0, 0, time.UTC).FractionOfDay(), 0},
+		{"middle of leap February", Date(2024, time.February, 15, 12, 0, 0, 0, time.UTC).FractionOfMonth(), 0.5},
+		{"middle of common February", Date(2023, time.February, 15, 0, 0, 0, 0, time.UTC).FractionOfMonth(), 0.5},
+		{"quarter start", Date(2024, time.April, 1, 0, 0, 0, 0, time.UTC).FractionOfQuarter(), 0},
+		{"Q1 after January", Date(2024, time.February, 1, 0, 0, 0, 0, time.UTC).FractionOfQuarter(), 31.0 / 91},
+		{"leap year midpoint", Date(2024, time.July, 2, 0, 0, 0, 0, time.UTC).FractionOfYear(), 0.5},
+		{"common year", Date(2023, time.July, 2, 12, 0, 0, 0, time.UTC).FractionOfYear(), 0.5},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if math.Abs(tt.got-tt.want) > 1e-12 {
+				t.Errorf("got %v, want %v", tt.got, tt.want)
+			}
+		})
+	}
+
+	// A 23-hour spring-forward day: 18:00 local is 17 of 23 hours in
+	ny, _ := time.LoadLocation("America/New_York")
+	if got := Date(2024, time.March, 10, 18, 0, 0, 0, ny).FractionOfDay(); math.Abs(got-17.0/23) > 1e-12 {
+		t.Errorf("FractionOfDay() on a DST day = %v, want 17/23", got)
+	}
+}
+
+func TestProrateAcrossMonths(t *testing.T) {
+	sub := NewPeriod(Date(2024, time.January, 16, 0, 0, 0, 0, time.UTC), Date(2024, time.March, 11, 0, 0, 0, 0, time.UTC))
+	got := sub.ProrateAcrossMonths()
+	want := map[YearMonth]float64{
+		{2024, time.January}:  16.0 / 31,
+		{2024, time.February}: 1,
+		{2024, time.March}:    10.0 / 31,
+	}
+	if len(got) != len(want) {
+		t.Fatalf("ProrateAcrossMonths() = %v, want %v", got, want)
+	}
+	for ym, w := range want {
+		if math.Abs(got[ym]-w) > 1e-12 {
+			t.Errorf("ProrateAcrossMonths()[%s] = %v, want %v", ym, got[ym], w)
+		}
+	}
+
+	// A reversed period ending exactly on a month boundary
+	got = NewPeriod(Date(2025, time.January, 1, 0, 0, 0, 0, time.UTC), Date(2024, time.December, 17, 12, 0, 0, 0, time.UTC)).ProrateAcrossMonths()
+	if len(got) != 1 || math.Abs(got[YearMonth{2024, time.December}]-14.5/31) > 1e-12 {
+		t.Errorf("ProrateAcrossMonths() across New Year = %v", got)
+	}
+
+	if got := NewPeriod(sub.Start, sub.Start).ProrateAcrossMonths(); len(got) != 0 {
+		t.Errorf("ProrateAcrossMonths() of an instant = %v, want empty", got)
+	}
+	if s := (YearMonth{2024, time.February}).String(); s != "2024-02" {
+		t.Errorf("YearMonth.String() = %q", s)
+	}
+}
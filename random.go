@@ -0,0 +1,105 @@
+package chronogo
+
+import (
+	"math/rand"
+	"sync"
+	"time"
+)
+
+// RandomGenerator produces random DateTimes from its own seeded source, so property
+// tests and fixture factories can reproduce a run from its seed. It is safe for
+// concurrent use.
+//
+// Example:
+//
+//	gen := chronogo.NewRandomGenerator(42)
+//	created := gen.InPeriod(chronogo.NewPeriod(start, end))
+//	due, err := gen.BusinessDay(chronogo.NewPeriod(start, end), nil)
+type RandomGenerator struct {
+	mu  sync.Mutex
+	rng *rand.Rand
+}
+
+// NewRandomGenerator creates a generator seeded with seed. Generators with the same
+// seed return the same sequence of values.
+func NewRandomGenerator(seed int64) *RandomGenerator {
+	return &RandomGenerator{rng: rand.New(rand.NewSource(seed))}
+}
+
+// defaultRandom backs the package-level Random functions.
+var defaultRandom = NewRandomGenerator(time.Now().UnixNano())
+
+// RandomBetween returns a random instant in [start, end], in start's location.
+// The bounds may be given in either order.
+//
+// Example:
+//
+//	dt := chronogo.RandomBetween(chronogo.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC), chronogo.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC))
+func RandomBetween(start, end DateTime) DateTime {
+	return defaultRandom.Between(start, end)
+}
+
+// RandomBusinessDay returns a random business day within period. A nil checker uses
+// the default holiday checker. See RandomGenerator.BusinessDay.
+func RandomBusinessDay(period Period, checker HolidayChecker) (DateTime, error) {
+	return defaultRandom.BusinessDay(period, checker)
+}
+
+// Between returns a random instant in [start, end], in start's location. The
+// bounds may be given in either order, and every nanosecond is a possible result.
+func (g *RandomGenerator) Between(start, end DateTime) DateTime {
+	loc := start.Location()
+	if end.Before(start) {
+		start, end = end, start
+	}
+
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	// Spans beyond time.Duration's ~292 years pick a second and then a nanosecond
+	if secs := end.Unix() - start.Unix(); secs < 1<<33 {
+		offset := g.rng.Int63n(int64(end.Sub(start)) + 1)
+		return start.Add(time.Duration(offset)).In(loc)
+	}
+	for {
+		sec := start.Unix() + g.rng.Int63n(end.Unix()-start.Unix()+1)
+		t := time.Unix(sec, g.rng.Int63n(1e9))
+		if !t.Before(start.Time) && !t.After(end.Time) {
+			return DateTime{t.In(loc)}
+		}
+	}
+}
+
+// InPeriod returns a random instant within period.
+func (g *RandomGenerator) InPeriod(period Period) DateTime {
+	return g.Between(period.Start, period.End)
+}
+
+// BusinessDay returns the start of a random business day whose date falls within
+// period, using the dates of period.Start's location. A nil checker uses the default
+// US holiday checker. It returns an error wrapping ErrInvalidRange if the period
+// holds no business day.
+func (g *RandomGenerator) BusinessDay(period Period, checker HolidayChecker) (DateTime, error) {
+	period = period.Abs()
+	last := period.End.In(period.Start.Location()).StartOfDay()
+
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	// Reservoir sampling keeps one candidate without collecting them all
+	var chosen DateTime
+	count := 0
+	for day := period.Start.StartOfDay(); !day.After(last); day = day.AddDays(1).StartOfDay() {
+		if !day.IsBusinessDay(checker) {
+			continue
+		}
+		count++
+		if g.rng.Intn(count) == 0 {
+			chosen = day
+		}
+	}
+	if count == 0 {
+		return DateTime{}, RangeError(period.Start, period.End, ErrInvalidRange)
+	}
+	return chosen, nil
+}
@@ -0,0 +1,74 @@
+package chronogo
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestRandomBetween(t *testing.T) {
+	ny, _ := time.LoadLocation("America/New_York")
+	start := Date(2024, time.January, 1, 0, 0, 0, 0, ny)
+	end := Date(2024, time.January, 2, 0, 0, 0, 0, ny)
+
+	for i := 0; i < 200; i++ {
+		dt := RandomBetween(end, start)
+		if dt.Before(start) || dt.After(end) {
+			t.Fatalf("RandomBetween() = %v, outside [%v, %v]", dt, start, end)
+		}
+	}
+	if dt := RandomBetween(start, start); !dt.Equal(start) {
+		t.Errorf("RandomBetween() of an instant = %v", dt)
+	}
+
+	// Spans longer than time.Duration can hold
+	ancient := Date(1, time.January, 1, 0, 0, 0, 0, time.UTC)
+	far := Date(9999, time.December, 31, 0, 0, 0, 0, time.UTC)
+	for i := 0; i < 50; i++ {
+		if dt := RandomBetween(ancient, far); dt.Before(ancient) || dt.After(far) {
+			t.Fatalf("RandomBetween() = %v, outside the range", dt)
+		}
+	}
+}
+
+func TestRandomGeneratorDeterministic(t *testing.T) {
+	period := NewPeriod(Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC), Date(2024, time.December, 31, 0, 0, 0, 0, time.UTC))
+	a, b := NewRandomGenerator(7), NewRandomGenerator(7)
+	for i := 0; i < 20; i++ {
+		if x, y := a.InPeriod(period), b.InPeriod(period); !x.Equal(y) {
+			t.Fatalf("same seed diverged at %d: %v vs %v", i, x, y)
+		}
+	}
+	x, _ := a.BusinessDay(period, nil)
+	y, _ := b.BusinessDay(period, nil)
+	if !x.Equal(y) {
+		t.Errorf("BusinessDay() with same seed = %v vs %v", x, y)
+	}
+}
+
+func TestRandomBusinessDay(t *testing.T) {
+	checker := NewUSHolidayChecker()
+	// Week of July 4th 2024: Monday 1st through Sunday 7th
+	period := NewPeriod(Date(2024, time.July, 1, 12, 0, 0, 0, time.UTC), Date(2024, time.July, 7, 0, 0, 0, 0, time.UTC))
+
+	seen := map[int]bool{}
+	gen := NewRandomGenerator(1)
+	for i := 0; i < 200; i++ {
+		dt, err := gen.BusinessDay(period, checker)
+		if err != nil {
+			t.Fatalf("BusinessDay() error = %v", err)
+		}
+		if !dt.IsBusinessDay(checker) || dt.Hour() != 0 {
+			t.Fatalf("BusinessDay() = %v", dt)
+		}
+		seen[dt.Day()] = true
+	}
+	if len(seen) != 4 || seen[4] {
+		t.Errorf("BusinessDay() days = %v, want 1, 2, 3 and 5", seen)
+	}
+
+	weekend := NewPeriod(Date(2024, time.July, 6, 0, 0, 0, 0, time.UTC), Date(2024, time.July, 7, 23, 0, 0, 0, time.UTC))
+	if _, err := RandomBusinessDay(weekend, checker); !errors.Is(err, ErrInvalidRange) {
+		t.Errorf("RandomBusinessDay() on a weekend error = %v, want ErrInvalidRange", err)
+	}
+}
@@ -0,0 +1,78 @@
+package chronogo
+
+import "time"
+
+// LastNDays returns the n complete days before today: a half-open period from
+// midnight n days ago to midnight today, leaving out the partial current day as
+// analytics dashboards do. Today is taken from the testable Now() in loc (Now()'s
+// location when omitted), as for TrailingMonths and the to-date ranges. It returns
+// an empty period at the start of today when n is not positive.
+//
+// Example:
+//
+//	last30 := chronogo.LastNDays(30, ny) // [May 16 00:00, Jun 15 00:00) on June 15
+func LastNDays(n int, loc ...*time.Location) Period {
+	now := reportingNow(loc)
+	if n < 0 {
+		n = 0
+	}
+	return NewHalfOpenPeriod(
+		DateTime{midnight(now.Year(), now.Month(), now.Day()-n, now.Location())},
+		DateTime{midnight(now.Year(), now.Month(), now.Day(), now.Location())},
+	)
+}
+
+// TrailingMonths returns the n complete calendar months before the current month: a
+// half-open period from the first of the month n months ago to the first of this
+// month. TrailingMonths(12) is the trailing twelve months (TTM) of financial
+// reporting. It returns an empty period at the start of this month when n is not
+// positive.
+//
+// Example:
+//
+//	ttm := chronogo.TrailingMonths(12) // [Jun 1 2023, Jun 1 2024) during June 2024
+func TrailingMonths(n int, loc ...*time.Location) Period {
+	now := reportingNow(loc)
+	if n < 0 {
+		n = 0
+	}
+	return NewHalfOpenPeriod(
+		DateTime{midnight(now.Year(), now.Month()-time.Month(n), 1, now.Location())},
+		DateTime{midnight(now.Year(), now.Month(), 1, now.Location())},
+	)
+}
+
+// MonthToDate returns the closed period from the start of the current month to Now()
+// in loc.
+func MonthToDate(loc ...*time.Location) Period {
+	now := reportingNow(loc)
+	return NewPeriod(now.StartOfMonth(), now)
+}
+
+// QuarterToDate returns the closed period from the start of the current calendar
+// quarter to Now() in loc.
+func QuarterToDate(loc ...*time.Location) Period {
+	now := reportingNow(loc)
+	return NewPeriod(now.StartOfQuarter(), now)
+}
+
+// YearToDate returns the closed period from January 1 of the current year to Now()
+// in loc.
+//
+// Example:
+//
+//	ytd := chronogo.YearToDate(time.UTC)
+//	fmt.Println(ytd.DayCount(), "days so far this year")
+func YearToDate(loc ...*time.Location) Period {
+	now := reportingNow(loc)
+	return NewPeriod(now.StartOfYear(), now)
+}
+
+// reportingNow returns the testable Now() in the first location, if any.
+func reportingNow(loc []*time.Location) DateTime {
+	now := Now()
+	if len(loc) > 0 && loc[0] != nil {
+		return now.In(loc[0])
+	}
+	return now
+}
@@ -0,0 +1,43 @@
+package chronogo
+
+import (
+	"testing"
+	"time"
+)
+
+func TestReportingRanges(t *testing.T) {
+	ny, _ := time.LoadLocation("America/New_York")
+	FreezeTimeAt(UTC(2024, time.May, 15, 2, 30, 0, 0)) // May 14, 22:30 in New York
+	defer UnfreezeTime()
+	now := Now()
+
+	tests := []struct {
+		name       string
+		got        Period
+		start, end DateTime
+		halfOpen   bool
+	}{
+		{"LastNDays", LastNDays(7, ny), Date(2024, time.May, 7, 0, 0, 0, 0, ny), Date(2024, time.May, 14, 0, 0, 0, 0, ny), true},
+		{"LastNDays UTC", LastNDays(1, time.UTC), UTC(2024, time.May, 14, 0, 0, 0, 0), UTC(2024, time.May, 15, 0, 0, 0, 0), true},
+		{"LastNDays zero", LastNDays(0, time.UTC), UTC(2024, time.May, 15, 0, 0, 0, 0), UTC(2024, time.May, 15, 0, 0, 0, 0), true},
+		{"TrailingMonths", TrailingMonths(12, ny), Date(2023, time.May, 1, 0, 0, 0, 0, ny), Date(2024, time.May, 1, 0, 0, 0, 0, ny), true},
+		{"TrailingMonths across year", TrailingMonths(6, ny), Date(2023, time.November, 1, 0, 0, 0, 0, ny), Date(2024, time.May, 1, 0, 0, 0, 0, ny), true},
+		{"MonthToDate", MonthToDate(ny), Date(2024, time.May, 1, 0, 0, 0, 0, ny), now, false},
+		{"QuarterToDate", QuarterToDate(ny), Date(2024, time.April, 1, 0, 0, 0, 0, ny), now, false},
+		{"YearToDate", YearToDate(ny), Date(2024, time.January, 1, 0, 0, 0, 0, ny), now, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if !tt.got.Start.Equal(tt.start) || !tt.got.End.Equal(tt.end) || tt.got.IsHalfOpen() != tt.halfOpen {
+				t.Errorf("got %v (half-open %v), want %v to %v (half-open %v)", tt.got, tt.got.IsHalfOpen(), tt.start, tt.end, tt.halfOpen)
+			}
+		})
+	}
+
+	if got := LastNDays(30, ny).DayCount(); got != 30 {
+		t.Errorf("LastNDays(30).DayCount() = %d", got)
+	}
+	if got := MonthToDate().Start.Location(); got != now.Location() {
+		t.Errorf("MonthToDate() location = %v, want Now()'s %v", got, now.Location())
+	}
+}
@@ -0,0 +1,129 @@
+package chronogo
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"sync/atomic"
+	"time"
+)
+
+// JSON wire formats. DateTime marshals to an RFC 3339 string (JSON Schema and
+// OpenAPI "format: date-time"), with fractional seconds as set by
+// SetMarshalPrecision. DateTime.UnmarshalJSON is lenient and accepts anything Parse
+// does; ParseJSONStrict, StrictDateTime and SetStrictJSON accept only RFC 3339, the
+// format it emits.
+const (
+	// JSONSchemaType is the JSON Schema type of a serialized DateTime.
+	JSONSchemaType = "string"
+
+	// JSONSchemaFormatDateTime is the JSON Schema and OpenAPI format of a serialized
+	// DateTime.
+	JSONSchemaFormatDateTime = "date-time"
+
+	// JSONSchemaFormatDate is the format of calendar dates such as
+	// ToISO8601DateString output ("2024-06-15").
+	JSONSchemaFormatDate = "date"
+
+	// JSONSchemaFormatDuration is the format of ISO 8601 durations such as
+	// ChronoDuration.FormatISO output ("PT1H30M").
+	JSONSchemaFormatDuration = "duration"
+
+	// RFC3339Pattern is a regular expression matching the RFC 3339 date-times that
+	// MarshalJSON emits and ParseJSONStrict accepts, for schema "pattern" fields.
+	RFC3339Pattern = `^\d{4}-\d{2}-\d{2}T\d{2}:\d{2}:\d{2}(\.\d+)?(Z|[+-]\d{2}:\d{2})$`
+
+	// DatePattern is a regular expression matching ISO 8601 calendar dates.
+	DatePattern = `^\d{4}-\d{2}-\d{2}$`
+)
+
+var rfc3339Regexp = regexp.MustCompile(RFC3339Pattern)
+
+// DateTimeJSONSchema returns the JSON Schema of a serialized DateTime as a map, for
+// schema generators and hand-written OpenAPI documents.
+//
+// Example:
+//
+//	chronogo.DateTimeJSONSchema()
+//	// map[format:date-time pattern:^\d{4}-... type:string]
+func DateTimeJSONSchema() map[string]any {
+	return map[string]any{
+		"type":    JSONSchemaType,
+		"format":  JSONSchemaFormatDateTime,
+		"pattern": RFC3339Pattern,
+	}
+}
+
+// IsRFC3339 reports whether s is a valid RFC 3339 date-time such as
+// "2024-06-15T14:30:00Z" or "2024-06-15T14:30:00.5+02:00".
+func IsRFC3339(s string) bool {
+	if !rfc3339Regexp.MatchString(s) {
+		return false
+	}
+	_, err := time.Parse(time.RFC3339Nano, s)
+	return err == nil
+}
+
+// ParseJSONStrict decodes a JSON value as a DateTime, accepting only a quoted RFC
+// 3339 string (or null, which gives the zero DateTime). Unlike UnmarshalJSON it
+// rejects other formats such as "2024/06/15" and natural language such as
+// "tomorrow", which API servers usually should not accept.
+//
+// Example:
+//
+//	dt, err := chronogo.ParseJSONStrict([]byte(`"2024-06-15T14:30:00Z"`))
+func ParseJSONStrict(data []byte) (DateTime, error) {
+	s := strings.TrimSpace(string(data))
+	if s == "null" {
+		return DateTime{}, nil
+	}
+	if len(s) < 2 || s[0] != '"' || s[len(s)-1] != '"' {
+		return DateTime{}, ParseError(s, fmt.Errorf("%w: expected a JSON string", ErrInvalidFormat))
+	}
+	s = s[1 : len(s)-1]
+	if !rfc3339Regexp.MatchString(s) {
+		return DateTime{}, ParseError(s, fmt.Errorf("%w: expected RFC 3339 date-time", ErrInvalidFormat))
+	}
+	t, err := time.Parse(time.RFC3339Nano, s)
+	if err != nil {
+		return DateTime{}, ParseError(s, fmt.Errorf("%w: %v", ErrInvalidFormat, err))
+	}
+	return DateTime{t}, nil
+}
+
+// strictJSON makes DateTime.UnmarshalJSON accept only RFC 3339.
+var strictJSON atomic.Bool
+
+// SetStrictJSON restricts DateTime.UnmarshalJSON to RFC 3339 strings (and null),
+// so an API cannot accidentally accept "2024/06/15" or "tomorrow". It is off by
+// default. Safe for concurrent use.
+//
+// Example:
+//
+//	func init() { chronogo.SetStrictJSON(true) }
+func SetStrictJSON(enabled bool) {
+	strictJSON.Store(enabled)
+}
+
+// StrictDateTime is a DateTime whose UnmarshalJSON accepts only RFC 3339 strings,
+// whatever SetStrictJSON says. Use it for individual request fields; it marshals,
+// scans and formats exactly like DateTime.
+//
+// Example:
+//
+//	type CreateEvent struct {
+//		StartsAt chronogo.StrictDateTime `json:"starts_at"`
+//	}
+type StrictDateTime struct {
+	DateTime
+}
+
+// UnmarshalJSON implements json.Unmarshaler, accepting only RFC 3339 strings and null.
+func (s *StrictDateTime) UnmarshalJSON(data []byte) error {
+	dt, err := ParseJSONStrict(data)
+	if err != nil {
+		return err
+	}
+	s.DateTime = dt
+	return nil
+}
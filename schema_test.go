@@ -0,0 +1,110 @@
+package chronogo
+
+import (
+	"encoding/json"
+	"errors"
+	"regexp"
+	"testing"
+	"time"
+)
+
+func TestDateTimeJSONSchema(t *testing.T) {
+	schema := DateTimeJSONSchema()
+	if schema["type"] != "string" || schema["format"] != "date-time" {
+		t.Errorf("DateTimeJSONSchema() = %v", schema)
+	}
+
+	// Everything MarshalJSON emits matches the published pattern
+	defer SetMarshalPrecision(GetMarshalPrecision())
+	pattern := regexp.MustCompile(schema["pattern"].(string))
+	dt := Date(2024, time.June, 15, 14, 30, 0, 123456789, FixedZone("", 2*3600))
+	for _, precision := range []ISOPrecision{ISOPrecisionSeconds, ISOPrecisionMillis, ISOPrecisionAuto} {
+		SetMarshalPrecision(precision)
+		data, _ := json.Marshal(dt)
+		var s string
+		_ = json.Unmarshal(data, &s)
+		if !pattern.MatchString(s) || !IsRFC3339(s) {
+			t.Errorf("marshaled %q does not match RFC3339Pattern", s)
+		}
+	}
+
+	if !regexp.MustCompile(DatePattern).MatchString(dt.ToISO8601DateString()) {
+		t.Error("ToISO8601DateString() does not match DatePattern")
+	}
+}
+
+func TestIsRFC3339(t *testing.T) {
+	for _, s := range []string{"2024-06-15T14:30:00Z", "2024-06-15T14:30:00.5+02:00", "2024-06-15T14:30:00-07:00"} {
+		if !IsRFC3339(s) {
+			t.Errorf("IsRFC3339(%q) = false", s)
+		}
+	}
+	for _, s := range []string{"2024/06/15", "2024-06-15", "2024-06-15 14:30:00Z", "2024-13-15T14:30:00Z", "tomorrow", "2024-06-15T14:30:00"} {
+		if IsRFC3339(s) {
+			t.Errorf("IsRFC3339(%q) = true", s)
+		}
+	}
+}
+
+func TestParseJSONStrict(t *testing.T) {
+	dt, err := ParseJSONStrict([]byte(` "2024-06-15T14:30:00.25+02:00" `))
+	if err != nil {
+		t.Fatalf("ParseJSONStrict() error = %v", err)
+	}
+	if want := Date(2024, time.June, 15, 12, 30, 0, 250000000, time.UTC); !dt.Equal(want) {
+		t.Errorf("ParseJSONStrict() = %v, want %v", dt, want)
+	}
+	if dt, err := ParseJSONStrict([]byte("null")); err != nil || !dt.IsZero() {
+		t.Errorf("ParseJSONStrict(null) = %v, %v", dt, err)
+	}
+
+	for _, input := range []string{`"2024/06/15"`, `"tomorrow"`, `"2024-06-15"`, `"2024-02-30T00:00:00Z"`, `1718461800`, `"2024-06-15T14:30:00Z`} {
+		if _, err := ParseJSONStrict([]byte(input)); !errors.Is(err, ErrInvalidFormat) {
+			t.Errorf("ParseJSONStrict(%s) error = %v, want ErrInvalidFormat", input, err)
+		}
+	}
+}
+
+func TestStrictJSON(t *testing.T) {
+	defer SetStrictJSON(false)
+
+	var dt DateTime
+	if err := json.Unmarshal([]byte(`"2024/06/15"`), &dt); err != nil {
+		t.Fatalf("lenient Unmarshal() error = %v", err)
+	}
+
+	SetStrictJSON(true)
+	for _, input := range []string{`"2024/06/15"`, `"tomorrow"`} {
+		if err := json.Unmarshal([]byte(input), &dt); !errors.Is(err, ErrInvalidFormat) {
+			t.Errorf("strict Unmarshal(%s) error = %v, want ErrInvalidFormat", input, err)
+		}
+	}
+	if err := json.Unmarshal([]byte(`"2024-06-15T14:30:00Z"`), &dt); err != nil || dt.Hour() != 14 {
+		t.Errorf("strict Unmarshal(RFC 3339) = %v, %v", dt, err)
+	}
+	if err := json.Unmarshal([]byte(`null`), &dt); err != nil || !dt.IsZero() {
+		t.Errorf("strict Unmarshal(null) = %v, %v", dt, err)
+	}
+}
+
+func TestStrictDateTime(t *testing.T) {
+	type request struct {
+		StartsAt StrictDateTime `json:"starts_at"`
+	}
+
+	var req request
+	if err := json.Unmarshal([]byte(`{"starts_at":"tomorrow"}`), &req); !errors.Is(err, ErrInvalidFormat) {
+		t.Errorf("Unmarshal(tomorrow) error = %v, want ErrInvalidFormat", err)
+	}
+	if err := json.Unmarshal([]byte(`{"starts_at":"2024-06-15T14:30:00+02:00"}`), &req); err != nil {
+		t.Fatalf("Unmarshal() error = %v", err)
+	}
+	if want := Date(2024, time.June, 15, 12, 30, 0, 0, time.UTC); !req.StartsAt.Equal(want) {
+		t.Errorf("StartsAt = %v, want %v", req.StartsAt, want)
+	}
+
+	data, err := json.Marshal(req)
+	if err != nil || string(data) != `{"starts_at":"2024-06-15T14:30:00+02:00"}` {
+		t.Errorf("Marshal() = %s, %v", data, err)
+	}
+}
@@ -0,0 +1,76 @@
+package chronogo
+
+import (
+	"fmt"
+	"time"
+)
+
+// IsSorted reports whether dates are in non-decreasing chronological order. Equal
+// instants are allowed, whatever their locations.
+//
+// Example:
+//
+//	chronogo.IsSorted([]chronogo.DateTime{a, b, c}) // true if a <= b <= c
+func IsSorted(dates []DateTime) bool {
+	for i := 1; i < len(dates); i++ {
+		if dates[i].Before(dates[i-1]) {
+			return false
+		}
+	}
+	return true
+}
+
+// EnsureMonotonic validates that a timestamp stream never goes backwards by more
+// than tolerance, which absorbs clock jitter between sources. Each value is compared
+// with the latest value seen so far. It returns nil for a valid stream, or an error
+// wrapping ErrInvalidRange that names the first offending index.
+//
+// Example:
+//
+//	if err := chronogo.EnsureMonotonic(readings, 50*time.Millisecond); err != nil {
+//		log.Printf("sensor clock went backwards: %v", err)
+//	}
+func EnsureMonotonic(dates []DateTime, tolerance time.Duration) error {
+	if len(dates) == 0 {
+		return nil
+	}
+	latest := dates[0]
+	for i, dt := range dates[1:] {
+		if back := latest.Sub(dt); back > tolerance {
+			return &ChronoError{
+				Op:         "EnsureMonotonic",
+				Path:       fmt.Sprintf("index %d", i+1),
+				Err:        fmt.Errorf("%w: %s is %s before %s", ErrInvalidRange, dt.ToISO8601String(), back, latest.ToISO8601String()),
+				Suggestion: "Sort the values or correct them with ClampSequence",
+			}
+		}
+		if dt.After(latest) {
+			latest = dt
+		}
+	}
+	return nil
+}
+
+// ClampSequence returns a copy of dates in which every value earlier than the
+// latest value before it is raised to that value, so the result is non-decreasing
+// while in-order values are left untouched. Use it to correct streams where a few
+// timestamps went backwards; Sort is the better choice when the order of the values
+// themselves is wrong. Raised values keep their own location.
+//
+// Example:
+//
+//	fixed := chronogo.ClampSequence(readings) // 10:00, 10:05, 10:03 -> 10:00, 10:05, 10:05
+func ClampSequence(dates []DateTime) []DateTime {
+	result := make([]DateTime, len(dates))
+	var latest DateTime
+	for i, dt := range dates {
+		if i > 0 && dt.Before(latest) {
+			dt = latest.In(dt.Location())
+		}
+		result[i] = dt
+		if i == 0 || dt.After(latest) {
+			latest = dt
+		}
+	}
+	return result
+}
@@ -0,0 +1,74 @@
+package chronogo
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestIsSorted(t *testing.T) {
+	base := Date(2024, time.January, 15, 10, 0, 0, 0, time.UTC)
+	tokyo := FixedZone("JST", 9*3600)
+
+	if !IsSorted(nil) || !IsSorted([]DateTime{base}) {
+		t.Error("empty and single-value sequences are sorted")
+	}
+	if !IsSorted([]DateTime{base, base.In(tokyo), base.AddMinutes(1)}) {
+		t.Error("equal instants in different zones should count as sorted")
+	}
+	if IsSorted([]DateTime{base, base.AddMinutes(1), base}) {
+		t.Error("IsSorted() should detect a backwards step")
+	}
+}
+
+func TestEnsureMonotonic(t *testing.T) {
+	base := Date(2024, time.January, 15, 10, 0, 0, 0, time.UTC)
+	jittery := []DateTime{base, base.AddSeconds(10), base.AddSeconds(9), base.AddSeconds(20)}
+
+	if err := EnsureMonotonic(jittery, 2*time.Second); err != nil {
+		t.Errorf("EnsureMonotonic() within tolerance error = %v", err)
+	}
+	err := EnsureMonotonic(jittery, 0)
+	if !errors.Is(err, ErrInvalidRange) {
+		t.Fatalf("EnsureMonotonic() error = %v, want ErrInvalidRange", err)
+	}
+	if !strings.Contains(err.Error(), "index 2") {
+		t.Errorf("EnsureMonotonic() error = %v, want the offending index", err)
+	}
+
+	// Each value is checked against the latest so far, not just its neighbour
+	drifting := []DateTime{base, base.AddSeconds(10), base.AddSeconds(9), base.AddSeconds(8)}
+	if err := EnsureMonotonic(drifting, 1500*time.Millisecond); err == nil || !strings.Contains(err.Error(), "index 3") {
+		t.Errorf("EnsureMonotonic() on a drifting stream error = %v", err)
+	}
+	if err := EnsureMonotonic(nil, 0); err != nil {
+		t.Errorf("EnsureMonotonic(nil) error = %v", err)
+	}
+}
+
+func TestClampSequence(t *testing.T) {
+	base := Date(2024, time.January, 15, 10, 0, 0, 0, time.UTC)
+	tokyo := FixedZone("JST", 9*3600)
+	input := []DateTime{base, base.AddMinutes(5), base.AddMinutes(3).In(tokyo), base.AddMinutes(4), base.AddMinutes(6)}
+
+	got := ClampSequence(input)
+	want := []DateTime{base, base.AddMinutes(5), base.AddMinutes(5), base.AddMinutes(5), base.AddMinutes(6)}
+	for i := range want {
+		if !got[i].Equal(want[i]) {
+			t.Errorf("ClampSequence()[%d] = %v, want %v", i, got[i], want[i])
+		}
+	}
+	if got[2].Location() != tokyo {
+		t.Errorf("ClampSequence() should keep each value's location, got %v", got[2].Location())
+	}
+	if !IsSorted(got) {
+		t.Error("ClampSequence() result should be sorted")
+	}
+	if !input[2].Equal(base.AddMinutes(3)) {
+		t.Error("ClampSequence() should not modify its input")
+	}
+	if len(ClampSequence(nil)) != 0 {
+		t.Error("ClampSequence(nil) should be empty")
+	}
+}
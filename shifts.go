@@ -0,0 +1,137 @@
+package chronogo
+
+import (
+	"sort"
+	"time"
+)
+
+// Shift is a scheduled work shift for a worker. Shifts are treated as half-open
+// whatever the Period's bounds, so a shift ending at 16:00 and the next starting at
+// 16:00 are back to back rather than overlapping.
+type Shift struct {
+	Worker string
+	Period Period
+}
+
+// ShiftOverlap reports two shifts of the same worker that overlap in time.
+type ShiftOverlap struct {
+	Worker  string
+	First   Shift  // the shift that starts first
+	Second  Shift  // the shift that starts inside First
+	Overlap Period // the time both shifts cover, half-open
+}
+
+// RestGap is the rest a worker gets between two consecutive shifts.
+type RestGap struct {
+	Worker string
+	After  Shift         // the earlier shift
+	Before Shift         // the next shift
+	Rest   time.Duration // from the end of After to the start of Before; negative if they overlap
+}
+
+// RestViolation is a rest gap shorter than the required minimum rest.
+type RestViolation struct {
+	RestGap
+	Required  time.Duration // the minimum rest that applies
+	Shortfall time.Duration // how much rest is missing
+}
+
+// FindShiftOverlaps returns every pair of shifts of the same worker that overlap,
+// such as a double-booked worker, ordered by worker and then by start time.
+//
+// Example:
+//
+//	overlaps := chronogo.FindShiftOverlaps(shifts)
+//	for _, o := range overlaps {
+//	    fmt.Printf("%s is double-booked for %s\n", o.Worker, o.Overlap.Duration())
+//	}
+func FindShiftOverlaps(shifts []Shift) []ShiftOverlap {
+	var overlaps []ShiftOverlap
+	for _, worker := range shiftsByWorker(shifts) {
+		for i, first := range worker {
+			for _, second := range worker[i+1:] {
+				if !second.Period.Start.Before(first.Period.End) {
+					break
+				}
+				end := first.Period.End
+				if second.Period.End.Before(end) {
+					end = second.Period.End
+				}
+				overlaps = append(overlaps, ShiftOverlap{
+					Worker:  first.Worker,
+					First:   first,
+					Second:  second,
+					Overlap: NewHalfOpenPeriod(second.Period.Start, end),
+				})
+			}
+		}
+	}
+	return overlaps
+}
+
+// RestGaps returns the rest between each pair of consecutive shifts of every worker,
+// ordered by worker and then by start time.
+func RestGaps(shifts []Shift) []RestGap {
+	var gaps []RestGap
+	for _, worker := range shiftsByWorker(shifts) {
+		for i := 1; i < len(worker); i++ {
+			gaps = append(gaps, RestGap{
+				Worker: worker[i].Worker,
+				After:  worker[i-1],
+				Before: worker[i],
+				Rest:   worker[i].Period.Start.Sub(worker[i-1].Period.End),
+			})
+		}
+	}
+	return gaps
+}
+
+// ValidateMinimumRest checks that every worker gets at least minimum rest between
+// consecutive shifts, such as the 11 hours of the EU Working Time Directive, and
+// returns the violations ordered by worker and then by start time. Overlapping
+// shifts are violations too.
+//
+// Example:
+//
+//	for _, v := range chronogo.ValidateMinimumRest(shifts, 11*time.Hour) {
+//	    fmt.Printf("%s: %s rest before %s, %s short\n", v.Worker, v.Rest, v.Before.Period.Start, v.Shortfall)
+//	}
+func ValidateMinimumRest(shifts []Shift, minimum time.Duration) []RestViolation {
+	var violations []RestViolation
+	for _, gap := range RestGaps(shifts) {
+		if gap.Rest < minimum {
+			violations = append(violations, RestViolation{
+				RestGap:   gap,
+				Required:  minimum,
+				Shortfall: minimum - gap.Rest,
+			})
+		}
+	}
+	return violations
+}
+
+// shiftsByWorker groups shifts by worker, in worker order, with each worker's
+// shifts normalized and sorted by start time.
+func shiftsByWorker(shifts []Shift) [][]Shift {
+	groups := make(map[string][]Shift)
+	for _, shift := range shifts {
+		shift.Period = shift.Period.Abs()
+		groups[shift.Worker] = append(groups[shift.Worker], shift)
+	}
+
+	workers := make([]string, 0, len(groups))
+	for worker := range groups {
+		workers = append(workers, worker)
+	}
+	sort.Strings(workers)
+
+	result := make([][]Shift, 0, len(workers))
+	for _, worker := range workers {
+		group := groups[worker]
+		sort.SliceStable(group, func(i, j int) bool {
+			return group[i].Period.Start.Before(group[j].Period.Start)
+		})
+		result = append(result, group)
+	}
+	return result
+}
@@ -0,0 +1,55 @@
+package chronogo
+
+import (
+	"testing"
+	"time"
+)
+
+func TestShiftValidation(t *testing.T) {
+	shift := func(worker string, day, startHour, hours int) Shift {
+		start := UTC(2024, time.June, day, startHour, 0, 0, 0)
+		return Shift{Worker: worker, Period: NewPeriod(start, start.AddHours(hours))}
+	}
+	shifts := []Shift{
+		shift("bob", 3, 14, 8),   // 14:00-22:00
+		shift("alice", 3, 8, 8),  // 08:00-16:00
+		shift("alice", 3, 16, 8), // 16:00-24:00, back to back
+		shift("alice", 4, 6, 8),  // 06:00-14:00, 6h rest
+		shift("bob", 4, 9, 8),    // 09:00-17:00, 11h rest
+		shift("bob", 4, 15, 4),   // 15:00-19:00, double-booked
+	}
+
+	overlaps := FindShiftOverlaps(shifts)
+	if len(overlaps) != 1 {
+		t.Fatalf("FindShiftOverlaps() = %+v, want 1 overlap", overlaps)
+	}
+	if o := overlaps[0]; o.Worker != "bob" || o.Overlap.Duration() != 2*time.Hour || !o.Overlap.IsHalfOpen() {
+		t.Errorf("overlap = %+v, want bob for 2h", o)
+	}
+
+	gaps := RestGaps(shifts)
+	wantRest := []time.Duration{0, 6 * time.Hour, 11 * time.Hour, -2 * time.Hour}
+	if len(gaps) != len(wantRest) {
+		t.Fatalf("RestGaps() returned %d gaps, want %d", len(gaps), len(wantRest))
+	}
+	for i, gap := range gaps {
+		if gap.Rest != wantRest[i] {
+			t.Errorf("gap %d (%s) rest = %s, want %s", i, gap.Worker, gap.Rest, wantRest[i])
+		}
+	}
+
+	violations := ValidateMinimumRest(shifts, 11*time.Hour)
+	if len(violations) != 3 {
+		t.Fatalf("ValidateMinimumRest() = %+v, want 3 violations", violations)
+	}
+	if v := violations[1]; v.Worker != "alice" || v.Shortfall != 5*time.Hour || v.Required != 11*time.Hour {
+		t.Errorf("violation = %+v, want alice short by 5h", v)
+	}
+	if v := violations[2]; v.Worker != "bob" || v.Shortfall != 13*time.Hour {
+		t.Errorf("overlap violation = %+v, want bob short by 13h", v)
+	}
+
+	if got := FindShiftOverlaps(nil); got != nil {
+		t.Errorf("FindShiftOverlaps(nil) = %v", got)
+	}
+}
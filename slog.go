@@ -0,0 +1,53 @@
+package chronogo
+
+import "log/slog"
+
+// LogValue implements slog.LogValuer. A DateTime is logged as a time value, so
+// handlers format it natively (RFC 3339 for the JSON and text handlers) without
+// going through String.
+//
+// Example:
+//
+//	slog.Info("order shipped", "at", dt) // at=2024-01-15T10:30:00.000Z
+func (dt DateTime) LogValue() slog.Value {
+	return slog.TimeValue(dt.Time)
+}
+
+// LogValue implements slog.LogValuer, logging the period as a group with its start,
+// end and duration.
+//
+// Example:
+//
+//	slog.Info("maintenance", "window", p) // window.start=... window.end=... window.duration=2h0m0s
+func (p Period) LogValue() slog.Value {
+	return slog.GroupValue(
+		slog.Time("start", p.Start.Time),
+		slog.Time("end", p.End.Time),
+		slog.Duration("duration", p.Duration()),
+	)
+}
+
+// LogValue implements slog.LogValuer, logging the difference as a duration.
+func (d Diff) LogValue() slog.Value {
+	return slog.DurationValue(d.Duration())
+}
+
+// EpochAttr returns a compact slog attribute holding dt as Unix milliseconds, the
+// form most log pipelines index efficiently.
+//
+// Example:
+//
+//	logger.Info("request", chronogo.EpochAttr("ts", dt)) // "ts":1705314600000
+func EpochAttr(key string, dt DateTime) slog.Attr {
+	return slog.Int64(key, dt.UnixMilli())
+}
+
+// ISOAttr returns a slog attribute holding dt as an ISO 8601 string in its own
+// offset, for logs read by people or by systems that expect text timestamps.
+//
+// Example:
+//
+//	logger.Info("request", chronogo.ISOAttr("ts", dt)) // "ts":"2024-01-15T10:30:00+01:00"
+func ISOAttr(key string, dt DateTime) slog.Attr {
+	return slog.String(key, dt.ToISO8601String())
+}
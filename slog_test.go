@@ -0,0 +1,51 @@
+package chronogo
+
+import (
+	"bytes"
+	"encoding/json"
+	"log/slog"
+	"testing"
+	"time"
+)
+
+func TestLogValue(t *testing.T) {
+	dt := Date(2024, time.January, 15, 10, 30, 0, 0, FixedZone("", 3600))
+	var buf bytes.Buffer
+	logger := slog.New(slog.NewJSONHandler(&buf, nil))
+
+	logger.Info("event",
+		"at", dt,
+		"window", NewPeriod(dt, dt.AddHours(2)),
+		"elapsed", dt.Diff(dt.AddMinutes(90)),
+		EpochAttr("ts", dt),
+		ISOAttr("iso", dt),
+	)
+
+	var record map[string]any
+	if err := json.Unmarshal(buf.Bytes(), &record); err != nil {
+		t.Fatalf("invalid JSON %q: %v", buf.String(), err)
+	}
+	if record["at"] != "2024-01-15T10:30:00+01:00" {
+		t.Errorf("at = %v", record["at"])
+	}
+	window, ok := record["window"].(map[string]any)
+	if !ok || window["start"] != "2024-01-15T10:30:00+01:00" || window["end"] != "2024-01-15T12:30:00+01:00" || window["duration"] != float64(2*time.Hour) {
+		t.Errorf("window = %v", record["window"])
+	}
+	if record["elapsed"] != float64(-90*time.Minute) {
+		t.Errorf("elapsed = %v", record["elapsed"])
+	}
+	if record["ts"] != float64(dt.UnixMilli()) {
+		t.Errorf("ts = %v, want %d", record["ts"], dt.UnixMilli())
+	}
+	if record["iso"] != "2024-01-15T10:30:00+01:00" {
+		t.Errorf("iso = %v", record["iso"])
+	}
+}
+
+func TestLogValueNoAllocs(t *testing.T) {
+	dt := Date(2024, time.January, 15, 10, 30, 0, 0, time.UTC)
+	if allocs := testing.AllocsPerRun(100, func() { _ = dt.LogValue() }); allocs != 0 {
+		t.Errorf("LogValue() allocated %v times, want 0", allocs)
+	}
+}
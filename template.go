@@ -0,0 +1,123 @@
+package chronogo
+
+import (
+	"fmt"
+	"text/template"
+	"time"
+)
+
+// TemplateFuncs returns template functions exposing common chronogo operations to
+// text/template and html/template. Functions that take a datetime accept it as the
+// last argument, so they work in pipelines, and accept a DateTime, time.Time (or
+// pointers to either), a string parsed with Parse, or Unix seconds.
+//
+// Functions:
+//
+//	now                          current time (respects SetTestNow)
+//	parseDate "2024-01-15"       parse with Parse
+//	dateFormat "YYYY-MM-DD" v    format with FormatTokens
+//	isoDate v                    ISO 8601 string
+//	humanize v                   DiffForHumans relative to now
+//	inZone "Europe/Paris" v      convert to a timezone
+//	addDays 3 v, addHours 2 v    arithmetic
+//	startOfDay v, endOfDay v     day bounds
+//
+// Example:
+//
+//	tmpl := template.Must(template.New("t").Funcs(chronogo.TemplateFuncs()).Parse(
+//		`{{ .Created | inZone "Europe/Paris" | dateFormat "D MMM YYYY HH:mm" }} ({{ humanize .Created }})`))
+//
+// For html/template, convert the map: htmltemplate.FuncMap(chronogo.TemplateFuncs()).
+func TemplateFuncs() template.FuncMap {
+	return template.FuncMap{
+		"now": Now,
+		"parseDate": func(value string) (DateTime, error) {
+			return Parse(value)
+		},
+		"dateFormat": func(pattern string, v any) (string, error) {
+			dt, err := templateDateTime(v)
+			if err != nil {
+				return "", err
+			}
+			return dt.FormatTokens(pattern), nil
+		},
+		"isoDate": func(v any) (string, error) {
+			dt, err := templateDateTime(v)
+			if err != nil {
+				return "", err
+			}
+			return dt.ToISO8601String(), nil
+		},
+		"humanize": func(v any) (string, error) {
+			dt, err := templateDateTime(v)
+			if err != nil {
+				return "", err
+			}
+			return dt.DiffForHumans(), nil
+		},
+		"inZone": func(zone string, v any) (DateTime, error) {
+			dt, err := templateDateTime(v)
+			if err != nil {
+				return DateTime{}, err
+			}
+			loc, err := LoadLocation(zone)
+			if err != nil {
+				return DateTime{}, err
+			}
+			return dt.In(loc), nil
+		},
+		"addDays": func(days int, v any) (DateTime, error) {
+			dt, err := templateDateTime(v)
+			if err != nil {
+				return DateTime{}, err
+			}
+			return dt.AddDays(days), nil
+		},
+		"addHours": func(hours int, v any) (DateTime, error) {
+			dt, err := templateDateTime(v)
+			if err != nil {
+				return DateTime{}, err
+			}
+			return dt.AddHours(hours), nil
+		},
+		"startOfDay": func(v any) (DateTime, error) {
+			dt, err := templateDateTime(v)
+			if err != nil {
+				return DateTime{}, err
+			}
+			return dt.StartOfDay(), nil
+		},
+		"endOfDay": func(v any) (DateTime, error) {
+			dt, err := templateDateTime(v)
+			if err != nil {
+				return DateTime{}, err
+			}
+			return dt.EndOfDay(), nil
+		},
+	}
+}
+
+// templateDateTime converts a template argument to a DateTime.
+func templateDateTime(v any) (DateTime, error) {
+	switch value := v.(type) {
+	case DateTime:
+		return value, nil
+	case *DateTime:
+		if value != nil {
+			return *value, nil
+		}
+	case time.Time:
+		return DateTime{value}, nil
+	case *time.Time:
+		if value != nil {
+			return DateTime{*value}, nil
+		}
+	case string:
+		return Parse(value)
+	case int:
+		return FromUnix(int64(value), 0, time.UTC), nil
+	case int64:
+		return FromUnix(value, 0, time.UTC), nil
+	}
+	return DateTime{}, fmt.Errorf("%w: cannot use %T as a datetime", ErrInvalidOperation, v)
+}
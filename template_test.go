@@ -0,0 +1,62 @@
+package chronogo
+
+import (
+	htmltemplate "html/template"
+	"strings"
+	"testing"
+	"text/template"
+	"time"
+)
+
+func TestTemplateFuncs(t *testing.T) {
+	created := Date(2024, time.January, 15, 22, 30, 0, 0, time.UTC)
+	render := func(text string, data any) string {
+		t.Helper()
+		var b strings.Builder
+		tmpl := template.Must(template.New("t").Funcs(TemplateFuncs()).Parse(text))
+		if err := tmpl.Execute(&b, data); err != nil {
+			t.Fatalf("Execute(%q) error: %v", text, err)
+		}
+		return b.String()
+	}
+
+	tests := []struct {
+		text string
+		data any
+		want string
+	}{
+		{`{{ .Created | dateFormat "YYYY-MM-DD HH:mm" }}`, map[string]any{"Created": created}, "2024-01-15 22:30"},
+		{`{{ .Created | inZone "Asia/Tokyo" | dateFormat "YYYY-MM-DD HH:mm" }}`, map[string]any{"Created": created}, "2024-01-16 07:30"},
+		{`{{ .Created | addDays 2 | startOfDay | isoDate }}`, map[string]any{"Created": created.Time}, "2024-01-17T00:00:00Z"},
+		{`{{ addHours 3 .Created | dateFormat "HH:mm" }}`, map[string]any{"Created": &created}, "01:30"},
+		{`{{ parseDate "2024-06-15" | dateFormat "MMMM D" }}`, nil, "June 15"},
+		{`{{ dateFormat "YYYY" 0 }}`, nil, "1970"},
+		{`{{ "2024-03-01T10:00:00Z" | endOfDay | dateFormat "HH:mm:ss" }}`, nil, "23:59:59"},
+	}
+	for _, tt := range tests {
+		if got := render(tt.text, tt.data); got != tt.want {
+			t.Errorf("%s = %q, want %q", tt.text, got, tt.want)
+		}
+	}
+
+	WithTestNow(created.AddHours(2), func() {
+		if got := render(`{{ humanize .Created }}`, map[string]any{"Created": created}); got != "2 hours ago" {
+			t.Errorf("humanize = %q, want %q", got, "2 hours ago")
+		}
+		if got := render(`{{ now | dateFormat "HH:mm" }}`, nil); got != "00:30" {
+			t.Errorf("now = %q, want 00:30", got)
+		}
+	})
+
+	tmpl := template.Must(template.New("t").Funcs(TemplateFuncs()).Parse(`{{ dateFormat "YYYY" .V }}`))
+	if err := tmpl.Execute(&strings.Builder{}, map[string]any{"V": 1.5}); err == nil {
+		t.Error("Expected an error for an unsupported argument type")
+	}
+
+	// The map converts for html/template
+	htmlTmpl := htmltemplate.Must(htmltemplate.New("t").Funcs(htmltemplate.FuncMap(TemplateFuncs())).Parse(`<p>{{ dateFormat "YYYY" .V }}</p>`))
+	var b strings.Builder
+	if err := htmlTmpl.Execute(&b, map[string]any{"V": created}); err != nil || b.String() != "<p>2024</p>" {
+		t.Errorf("html/template = %q, %v", b.String(), err)
+	}
+}
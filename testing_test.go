@@ -285,6 +285,50 @@ func TestTodayWithTestTime(t *testing.T) {
 	}
 }
 
+func TestFrozenClockConsistency(t *testing.T) {
+	frozen := Date(2024, time.March, 15, 22, 30, 0, 0, time.UTC)
+	tokyo, _ := time.LoadLocation("Asia/Tokyo")
+
+	WithFrozenTimeAt(frozen, func() {
+		if !Now().Equal(frozen) || !NowUTC().Equal(frozen) || !NowIn(tokyo).Equal(frozen) {
+			t.Error("Now, NowUTC and NowIn should all return the frozen instant")
+		}
+
+		// Day boundaries follow the frozen instant in each location
+		if got := TodayIn(time.UTC); !got.Equal(Date(2024, time.March, 15, 0, 0, 0, 0, time.UTC)) {
+			t.Errorf("TodayIn(UTC) = %v", got)
+		}
+		if got := TodayIn(tokyo); !got.Equal(Date(2024, time.March, 16, 0, 0, 0, 0, tokyo)) {
+			t.Errorf("TodayIn(Tokyo) = %v", got)
+		}
+		if !Today().Equal(TodayIn(time.UTC)) || !Tomorrow().Equal(Today().AddDays(1)) || !Yesterday().Equal(Today().AddDays(-1)) {
+			t.Error("Today, Tomorrow and Yesterday should agree with TodayIn")
+		}
+
+		before, after := frozen.AddSeconds(-1), frozen.AddSeconds(1)
+		if !before.IsPast() || before.IsFuture() || !after.IsFuture() || after.IsPast() {
+			t.Error("IsPast/IsFuture should compare against the frozen instant")
+		}
+		if frozen.IsPast() || frozen.IsFuture() {
+			t.Error("the frozen instant should be neither past nor future")
+		}
+		if !frozen.IsToday() || !frozen.AddDays(1).IsTomorrow() || !frozen.AddDays(-1).IsYesterday() {
+			t.Error("IsToday/IsTomorrow/IsYesterday should use the frozen instant")
+		}
+		if got := Since(before).Duration; got != time.Second {
+			t.Errorf("Since() = %v, want 1s", got)
+		}
+		if got := frozen.AddHours(-2).DiffForHumans(); got != "2 hours ago" {
+			t.Errorf("DiffForHumans() = %q", got)
+		}
+
+		tomorrow, err := ParseWith("tomorrow", ParseConfig{Location: time.UTC})
+		if err != nil || tomorrow.Day() != 16 {
+			t.Errorf("ParseWith(tomorrow) = %v, %v", tomorrow, err)
+		}
+	})
+}
+
 func TestConcurrentTestNowAccess(t *testing.T) {
 	defer ClearTestNow()
 
@@ -0,0 +1,51 @@
+package chronogo
+
+import "time"
+
+// TimecardRounding is a payroll rounding policy for clock-in and clock-out times.
+type TimecardRounding int
+
+const (
+	// TimecardNearest rounds to the nearest interval; an exact half rounds up.
+	TimecardNearest TimecardRounding = iota
+	// TimecardUp rounds up to the next interval. Applied to clock-ins (and
+	// TimecardDown to clock-outs) it never pays for unworked time.
+	TimecardUp
+	// TimecardDown rounds down to the previous interval.
+	TimecardDown
+	// TimecardSevenEight applies the "7/8-minute rule" used with quarter-hour
+	// intervals under the US FLSA: seconds are dropped, then up to 7 minutes past an
+	// interval round down and 8 or more round up. For other intervals the split is
+	// the whole minutes below half the interval.
+	TimecardSevenEight
+)
+
+// RoundTimecard rounds a clock-in or clock-out time to interval (such as 6, 10 or 15
+// minutes) under policy, on the local wall clock counted from midnight, as payroll
+// and time-tracking systems do. dt is returned unchanged if interval is not positive.
+//
+// Example:
+//
+//	in := chronogo.Date(2024, 6, 3, 8, 7, 45, 0, time.UTC)
+//	chronogo.RoundTimecard(in, 15*time.Minute, chronogo.TimecardSevenEight) // 08:00
+//	chronogo.RoundTimecard(in, 15*time.Minute, chronogo.TimecardNearest)    // 08:15
+//	chronogo.RoundTimecard(in, 15*time.Minute, chronogo.TimecardUp)         // 08:15
+func RoundTimecard(dt DateTime, interval time.Duration, policy TimecardRounding) DateTime {
+	if interval <= 0 {
+		return dt
+	}
+	switch policy {
+	case TimecardUp:
+		return dt.CeilToDuration(interval)
+	case TimecardDown:
+		return dt.FloorToDuration(interval)
+	case TimecardSevenEight:
+		minutes := dt.FloorToDuration(time.Minute)
+		if clockOf(minutes)%interval*2 < interval {
+			return minutes.FloorToDuration(interval)
+		}
+		return minutes.CeilToDuration(interval)
+	default:
+		return dt.RoundToDuration(interval)
+	}
+}
@@ -0,0 +1,40 @@
+package chronogo
+
+import (
+	"testing"
+	"time"
+)
+
+func TestRoundTimecard(t *testing.T) {
+	at := func(hour, min, sec int) DateTime {
+		return UTC(2024, time.June, 3, hour, min, sec, 0)
+	}
+	quarter := 15 * time.Minute
+	tests := []struct {
+		name     string
+		dt       DateTime
+		interval time.Duration
+		policy   TimecardRounding
+		want     DateTime
+	}{
+		{"7/8 seven minutes", at(8, 7, 59), quarter, TimecardSevenEight, at(8, 0, 0)},
+		{"7/8 eight minutes", at(8, 8, 0), quarter, TimecardSevenEight, at(8, 15, 0)},
+		{"7/8 on the grid", at(8, 15, 30), quarter, TimecardSevenEight, at(8, 15, 0)},
+		{"7/8 tenth-hour", at(8, 2, 59), 6 * time.Minute, TimecardSevenEight, at(8, 0, 0)},
+		{"7/8 tenth-hour up", at(8, 3, 0), 6 * time.Minute, TimecardSevenEight, at(8, 6, 0)},
+		{"nearest half rounds up", at(8, 7, 30), quarter, TimecardNearest, at(8, 15, 0)},
+		{"nearest below half", at(8, 7, 29), quarter, TimecardNearest, at(8, 0, 0)},
+		{"up", at(8, 0, 1), quarter, TimecardUp, at(8, 15, 0)},
+		{"up on the grid", at(8, 0, 0), quarter, TimecardUp, at(8, 0, 0)},
+		{"down", at(16, 59, 59), quarter, TimecardDown, at(16, 45, 0)},
+		{"up past midnight", at(23, 50, 0), quarter, TimecardUp, UTC(2024, time.June, 4, 0, 0, 0, 0)},
+		{"zero interval", at(8, 7, 0), 0, TimecardNearest, at(8, 7, 0)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := RoundTimecard(tt.dt, tt.interval, tt.policy); !got.Equal(tt.want) {
+				t.Errorf("RoundTimecard() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,272 @@
+package chronogo
+
+import (
+	"archive/zip"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"runtime"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// zoneNames caches the zone names found in the time zone database.
+var zoneNames struct {
+	once  sync.Once
+	names []string
+}
+
+// AvailableTimezones returns the IANA time zone names in the time zone database
+// that time.LoadLocation reads, sorted, such as "Africa/Abidjan" through "Zulu". The
+// database is searched where the time package looks for it: the ZONEINFO
+// environment variable, the system zoneinfo directories, then the copy shipped with
+// Go. If none is readable (for example on Windows with only the embedded
+// time/tzdata package) a list of commonly used zones is returned instead. The
+// result is computed once and cached; callers receive their own copy.
+//
+// Example:
+//
+//	for _, name := range chronogo.AvailableTimezones() {
+//	    fmt.Println(name)
+//	}
+func AvailableTimezones() []string {
+	zoneNames.once.Do(func() {
+		zoneNames.names = loadZoneNames()
+		if len(zoneNames.names) == 0 {
+			zoneNames.names = commonTimezones
+		}
+	})
+	return append([]string(nil), zoneNames.names...)
+}
+
+// TimezonesInRegion returns the available time zones under an IANA region such
+// as "Europe", "America" or "America/Argentina", sorted. Nested zones are
+// included, so "America" also returns "America/Argentina/Salta".
+//
+// Example:
+//
+//	chronogo.TimezonesInRegion("Australia") // ["Australia/ACT", "Australia/Adelaide", ...]
+func TimezonesInRegion(region string) []string {
+	prefix := strings.TrimSuffix(region, "/") + "/"
+	var names []string
+	for _, name := range AvailableTimezones() {
+		if strings.HasPrefix(name, prefix) {
+			names = append(names, name)
+		}
+	}
+	return names
+}
+
+// loadZoneNames lists the zones of the first readable time zone database, in the
+// order the time package searches them.
+func loadZoneNames() []string {
+	var sources []string
+	if env := os.Getenv("ZONEINFO"); env != "" {
+		sources = append(sources, env)
+	}
+	sources = append(sources, "/usr/share/zoneinfo/", "/usr/share/lib/zoneinfo/", "/usr/lib/locale/TZ/", "/etc/zoneinfo/")
+	sources = append(sources, filepath.Join(runtime.GOROOT(), "lib", "time", "zoneinfo.zip"))
+
+	for _, source := range sources {
+		var names []string
+		if strings.HasSuffix(source, ".zip") {
+			names = zoneNamesFromZip(source)
+		} else {
+			names = zoneNamesFromDir(source)
+		}
+		if len(names) > 0 {
+			sort.Strings(names)
+			return names
+		}
+	}
+	return nil
+}
+
+// zoneNamesFromDir lists the zone files under a zoneinfo directory.
+func zoneNamesFromDir(dir string) []string {
+	root := os.DirFS(dir)
+	var names []string
+	_ = fs.WalkDir(root, ".", func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return nil
+		}
+		if d.IsDir() {
+			if path == "posix" || path == "right" {
+				return fs.SkipDir
+			}
+			return nil
+		}
+		if isZoneName(path) && isTZifFile(root, path) {
+			names = append(names, path)
+		}
+		return nil
+	})
+	return names
+}
+
+// zoneNamesFromZip lists the zone files in a zoneinfo.zip archive such as Go's.
+func zoneNamesFromZip(path string) []string {
+	r, err := zip.OpenReader(path)
+	if err != nil {
+		return nil
+	}
+	defer func() { _ = r.Close() }()
+
+	var names []string
+	for _, f := range r.File {
+		if !strings.HasSuffix(f.Name, "/") && isZoneName(f.Name) {
+			names = append(names, f.Name)
+		}
+	}
+	return names
+}
+
+// isZoneName excludes the database's metadata files (zone.tab, leapseconds, ...)
+// and the placeholder entries that are not meaningful zone choices.
+func isZoneName(name string) bool {
+	switch name {
+	case "Factory", "localtime", "posixrules":
+		return false
+	}
+	first := name[0]
+	return first >= 'A' && first <= 'Z' && !strings.Contains(name, ".")
+}
+
+// isTZifFile reports whether the file starts with the TZif magic number.
+func isTZifFile(root fs.FS, path string) bool {
+	f, err := root.Open(path)
+	if err != nil {
+		return false
+	}
+	defer func() { _ = f.Close() }()
+	magic := make([]byte, 4)
+	_, err = io.ReadFull(f, magic)
+	return err == nil && string(magic) == "TZif"
+}
+
+// commonTimezones is the fallback for AvailableTimezones when no time zone
+// database can be read.
+var commonTimezones = []string{
+	"Africa/Cairo",
+	"Africa/Johannesburg",
+	"Africa/Lagos",
+	"America/Argentina/Buenos_Aires",
+	"America/Chicago",
+	"America/Denver",
+	"America/Los_Angeles",
+	"America/Mexico_City",
+	"America/New_York",
+	"America/Sao_Paulo",
+	"America/Toronto",
+	"America/Vancouver",
+	"Asia/Bangkok",
+	"Asia/Dubai",
+	"Asia/Hong_Kong",
+	"Asia/Jakarta",
+	"Asia/Kolkata",
+	"Asia/Manila",
+	"Asia/Seoul",
+	"Asia/Shanghai",
+	"Asia/Singapore",
+	"Asia/Tokyo",
+	"Australia/Melbourne",
+	"Australia/Perth",
+	"Australia/Sydney",
+	"Europe/Amsterdam",
+	"Europe/Berlin",
+	"Europe/London",
+	"Europe/Madrid",
+	"Europe/Moscow",
+	"Europe/Paris",
+	"Europe/Rome",
+	"Europe/Stockholm",
+	"Pacific/Auckland",
+	"Pacific/Honolulu",
+	"UTC",
+}
+
+// timezoneRegions are the geographic IANA regions included by TimezoneInfos.
+var timezoneRegions = map[string]bool{
+	"Africa": true, "America": true, "Antarctica": true, "Arctic": true, "Asia": true,
+	"Atlantic": true, "Australia": true, "Europe": true, "Indian": true, "Pacific": true,
+}
+
+// pickerZones caches the locations listed by TimezoneInfos.
+var pickerZones struct {
+	once      sync.Once
+	locations []*time.Location
+}
+
+// TimezoneInfo describes a time zone for pickers and dropdowns.
+type TimezoneInfo struct {
+	Name         string    // IANA name, e.g. "America/Argentina/Buenos_Aires"
+	Region       string    // first name component, e.g. "America"; empty for UTC
+	City         string    // example city for display, e.g. "Buenos Aires"
+	Offset       UTCOffset // offset in effect at the reference time
+	Abbreviation string    // zone abbreviation at the reference time, e.g. "EDT" or "-03"
+	IsDST        bool      // whether daylight saving time is in effect at the reference time
+}
+
+// TimezoneInfos returns display data for UTC and every zone in the geographic IANA
+// regions (Africa, America, Antarctica, Arctic, Asia, Atlantic, Australia, Europe,
+// Indian and Pacific), sorted by current offset and then by name. Legacy aliases such
+// as "US/Eastern" are left out. Offsets and DST flags are taken at reference, or at
+// Now() when it is omitted. The zones are loaded once and cached.
+//
+// Example:
+//
+//	for _, tz := range chronogo.TimezoneInfos() {
+//	    fmt.Printf("(UTC%s) %s\n", tz.Offset, tz.City) // "(UTC-05:00) New York"
+//	}
+func TimezoneInfos(reference ...DateTime) []TimezoneInfo {
+	pickerZones.once.Do(func() {
+		for _, name := range AvailableTimezones() {
+			region, _, ok := strings.Cut(name, "/")
+			if name != "UTC" && (!ok || !timezoneRegions[region]) {
+				continue
+			}
+			if loc, err := time.LoadLocation(name); err == nil {
+				pickerZones.locations = append(pickerZones.locations, loc)
+			}
+		}
+	})
+
+	at := referenceTime(reference)
+	infos := make([]TimezoneInfo, 0, len(pickerZones.locations))
+	for _, loc := range pickerZones.locations {
+		dt := DateTime{at.In(loc)}
+		name := loc.String()
+		region := ""
+		if i := strings.Index(name, "/"); i >= 0 {
+			region = name[:i]
+		}
+		infos = append(infos, TimezoneInfo{
+			Name:         name,
+			Region:       region,
+			City:         strings.ReplaceAll(name[strings.LastIndex(name, "/")+1:], "_", " "),
+			Offset:       dt.UTCOffset(),
+			Abbreviation: dt.ZoneAbbr(),
+			IsDST:        dt.IsDST(),
+		})
+	}
+	sort.SliceStable(infos, func(i, j int) bool {
+		if infos[i].Offset.Seconds != infos[j].Offset.Seconds {
+			return infos[i].Offset.Seconds < infos[j].Offset.Seconds
+		}
+		return infos[i].Name < infos[j].Name
+	})
+	return infos
+}
+
+// TimezoneInfosByRegion groups TimezoneInfos by region for grouped dropdowns. Each
+// group keeps the offset-then-name order; UTC is under the empty region.
+func TimezoneInfosByRegion(reference ...DateTime) map[string][]TimezoneInfo {
+	groups := make(map[string][]TimezoneInfo)
+	for _, info := range TimezoneInfos(reference...) {
+		groups[info.Region] = append(groups[info.Region], info)
+	}
+	return groups
+}
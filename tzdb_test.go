@@ -0,0 +1,104 @@
+package chronogo
+
+import (
+	"sort"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestAvailableTimezones(t *testing.T) {
+	timezones := AvailableTimezones()
+
+	// Should return a non-empty slice
+	if len(timezones) == 0 {
+		t.Error("AvailableTimezones() should return non-empty slice")
+	}
+
+	// Should contain common timezones
+	found := false
+	for _, tz := range timezones {
+		if tz == "UTC" {
+			found = true
+			break
+		}
+	}
+	if !found {
+		t.Error("AvailableTimezones() should include 'UTC'")
+	}
+
+	// Check that we have a reasonable number of timezones
+	if len(timezones) < 10 {
+		t.Errorf("Expected more timezones, got %d", len(timezones))
+	}
+
+	// Every name must be sorted and loadable
+	if !sort.StringsAreSorted(timezones) {
+		t.Error("AvailableTimezones() should be sorted")
+	}
+	for _, tz := range timezones {
+		if !IsValidTimezone(tz) {
+			t.Errorf("AvailableTimezones() includes unloadable zone %q", tz)
+		}
+	}
+
+	// Callers get their own copy of the cached list
+	timezones[0] = "Mutated"
+	if AvailableTimezones()[0] == "Mutated" {
+		t.Error("AvailableTimezones() should return a copy")
+	}
+}
+
+func TestTimezonesInRegion(t *testing.T) {
+	europe := TimezonesInRegion("Europe")
+	found := false
+	for _, tz := range europe {
+		if !strings.HasPrefix(tz, "Europe/") {
+			t.Errorf("TimezonesInRegion(Europe) includes %q", tz)
+		}
+		found = found || tz == "Europe/Berlin"
+	}
+	if !found {
+		t.Error("TimezonesInRegion(Europe) should include Europe/Berlin")
+	}
+	if got := TimezonesInRegion("Atlantis"); len(got) != 0 {
+		t.Errorf("TimezonesInRegion(Atlantis) = %v", got)
+	}
+}
+
+func TestTimezoneInfos(t *testing.T) {
+	july := UTC(2024, time.July, 1, 12, 0, 0, 0)
+	infos := TimezoneInfos(july)
+	if len(infos) < 10 {
+		t.Fatalf("TimezoneInfos() returned %d zones", len(infos))
+	}
+
+	byName := make(map[string]TimezoneInfo, len(infos))
+	for i, info := range infos {
+		byName[info.Name] = info
+		if i > 0 && infos[i-1].Offset.Seconds > info.Offset.Seconds {
+			t.Fatalf("TimezoneInfos() not sorted by offset at %s", info.Name)
+		}
+		if strings.HasPrefix(info.Name, "US/") {
+			t.Errorf("TimezoneInfos() includes legacy alias %s", info.Name)
+		}
+	}
+
+	ny, ok := byName["America/New_York"]
+	if !ok || ny.Region != "America" || ny.City != "New York" || ny.Offset.String() != "-04:00" || !ny.IsDST || ny.Abbreviation != "EDT" {
+		t.Errorf("America/New_York = %+v", ny)
+	}
+	if utc, ok := byName["UTC"]; !ok || utc.Region != "" || utc.IsDST {
+		t.Errorf("UTC = %+v, %v", utc, ok)
+	}
+
+	january := TimezoneInfosByRegion(UTC(2024, time.January, 15, 12, 0, 0, 0))
+	for _, info := range january["America"] {
+		if info.Name == "America/New_York" && (info.Offset.Hours != -5 || info.IsDST) {
+			t.Errorf("America/New_York in January = %+v", info)
+		}
+	}
+	if len(january["Europe"]) == 0 || january["Europe"][0].Region != "Europe" {
+		t.Error("TimezoneInfosByRegion() should group European zones")
+	}
+}
@@ -295,3 +295,58 @@ func (dt DateTime) WeekdayOccurrenceInMonth() int {
 
 	return 0
 }
+
+// WeekdayOccurrences returns the days of a month grouped by weekday, each at midnight
+// in loc (UTC when nil) and in date order, so every weekday maps to its four or five
+// occurrences.
+//
+// Example:
+//
+//	occ := chronogo.WeekdayOccurrences(2024, time.March, time.UTC)
+//	occ[time.Friday]     // Mar 1, 8, 15, 22 and 29
+//	occ[time.Tuesday][1] // Mar 12, the 2nd Tuesday
+func WeekdayOccurrences(year int, month time.Month, loc *time.Location) map[time.Weekday][]DateTime {
+	if loc == nil {
+		loc = time.UTC
+	}
+	occurrences := make(map[time.Weekday][]DateTime, 7)
+	for day := 1; day <= DaysInMonthOf(year, month); day++ {
+		dt := DateTime{midnight(year, month, day, loc)}
+		occurrences[dt.Weekday()] = append(occurrences[dt.Weekday()], dt)
+	}
+	return occurrences
+}
+
+// AllNthWeekdays returns the nth occurrence of weekday in every month of year, such as
+// all second Tuesdays for a monthly meeting, at midnight in loc (UTC when omitted). n
+// is 1-5, or -1 for the last occurrence; months without a fifth occurrence are
+// skipped. It returns nil for any other n.
+//
+// Example:
+//
+//	meetings := chronogo.AllNthWeekdays(2024, time.Tuesday, 2) // Jan 9, Feb 13, Mar 12, ...
+func AllNthWeekdays(year int, weekday time.Weekday, n int, loc ...*time.Location) []DateTime {
+	if n == 0 || n < -1 || n > 5 {
+		return nil
+	}
+	zone := time.UTC
+	if len(loc) > 0 && loc[0] != nil {
+		zone = loc[0]
+	}
+
+	dates := make([]DateTime, 0, 12)
+	for month := time.January; month <= time.December; month++ {
+		days := DaysInMonthOf(year, month)
+		first := time.Date(year, month, 1, 12, 0, 0, 0, time.UTC).Weekday()
+		day := 1 + (int(weekday)-int(first)+7)%7
+		if n == -1 {
+			day += (days - day) / 7 * 7
+		} else {
+			day += (n - 1) * 7
+		}
+		if day <= days {
+			dates = append(dates, DateTime{midnight(year, month, day, zone)})
+		}
+	}
+	return dates
+}
@@ -398,3 +398,60 @@ func TestFarthestWeekday(t *testing.T) {
 		t.Errorf("Expected farthest Sunday %v, got %v", expectedSunday, farthestSunday)
 	}
 }
+
+func TestWeekdayOccurrences(t *testing.T) {
+	occ := WeekdayOccurrences(2024, time.March, time.UTC)
+	if len(occ) != 7 {
+		t.Fatalf("WeekdayOccurrences() has %d weekdays, want 7", len(occ))
+	}
+	fridays := occ[time.Friday]
+	if len(fridays) != 5 || fridays[0].Day() != 1 || fridays[4].Day() != 29 {
+		t.Errorf("Fridays = %v", fridays)
+	}
+	if got := occ[time.Tuesday]; len(got) != 4 || got[1].Day() != 12 {
+		t.Errorf("Tuesdays = %v", got)
+	}
+
+	// Santiago skips midnight on its spring-forward day
+	santiago, err := time.LoadLocation("America/Santiago")
+	if err != nil {
+		t.Skip("America/Santiago not available")
+	}
+	for _, dt := range WeekdayOccurrences(2024, time.September, santiago)[time.Sunday] {
+		if dt.Month() != time.September || dt.Weekday() != time.Sunday {
+			t.Errorf("Santiago Sunday = %v", dt)
+		}
+	}
+}
+
+func TestAllNthWeekdays(t *testing.T) {
+	second := AllNthWeekdays(2024, time.Tuesday, 2)
+	if len(second) != 12 {
+		t.Fatalf("AllNthWeekdays(2nd Tuesday) returned %d dates", len(second))
+	}
+	for _, dt := range second {
+		if want := dt.NthWeekdayOfMonth(2, time.Tuesday); !dt.Equal(want) {
+			t.Errorf("%v is not the 2nd Tuesday (%v)", dt, want)
+		}
+	}
+
+	last := AllNthWeekdays(2024, time.Friday, -1)
+	for _, dt := range last {
+		if want := dt.LastWeekdayOf(time.Friday).StartOfDay(); !dt.Equal(want) {
+			t.Errorf("%v is not the last Friday (%v)", dt, want)
+		}
+	}
+
+	// Five Fridays only in March, May, August and November 2024
+	if fifth := AllNthWeekdays(2024, time.Friday, 5); len(fifth) != 4 {
+		t.Errorf("AllNthWeekdays(5th Friday) = %v", fifth)
+	}
+
+	tokyo, _ := time.LoadLocation("Asia/Tokyo")
+	if got := AllNthWeekdays(2024, time.Monday, 1, tokyo); got[0].Location() != tokyo || got[0].Day() != 1 {
+		t.Errorf("AllNthWeekdays in Tokyo = %v", got[0])
+	}
+	if got := AllNthWeekdays(2024, time.Monday, 6); got != nil {
+		t.Errorf("AllNthWeekdays(n=6) = %v, want nil", got)
+	}
+}
@@ -0,0 +1,326 @@
+package chronogo
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// maxOffsetSeconds bounds fixed UTC offsets to ±18:00, the widest range
+// accepted by ISO 8601 tooling in practice.
+const maxOffsetSeconds = 18 * 60 * 60
+
+// FixedZone returns a location with a constant UTC offset.
+// When name is empty the zone is named after its offset (e.g. "+05:30"), so
+// it formats the same way as an offset from FromOffsetString.
+//
+// Example:
+//
+//	loc := chronogo.FixedZone("", 5*3600+30*60) // "+05:30"
+//	dt := chronogo.Date(2024, 1, 15, 9, 0, 0, 0, loc)
+func FixedZone(name string, offsetSeconds int) *time.Location {
+	if name == "" {
+		name = formatOffset(offsetSeconds, true)
+	}
+	return time.FixedZone(name, offsetSeconds)
+}
+
+// FromOffsetString returns a fixed-offset location for a numeric UTC offset such as
+// "+05:30", "-07:00", "+0530", "-07" or "Z". The returned location is named after the
+// normalized offset ("+05:30", or "UTC" for zero offsets).
+//
+// Example:
+//
+//	loc, err := chronogo.FromOffsetString("+05:30")
+//	dt := chronogo.Now().In(loc)
+func FromOffsetString(offset string) (*time.Location, error) {
+	seconds, err := parseOffsetString(offset)
+	if err != nil {
+		return nil, &ChronoError{
+			Op:         "FromOffsetString",
+			Input:      offset,
+			Err:        fmt.Errorf("%w: %v", ErrInvalidTimezone, err),
+			Suggestion: "Use an offset like \"+05:30\", \"-0700\" or \"Z\"",
+		}
+	}
+	if seconds == 0 {
+		return time.UTC, nil
+	}
+	return FixedZone("", seconds), nil
+}
+
+// TravelDuration returns the elapsed time between a departure and an arrival given in
+// their own local zones, such as the times printed on a ticket. Each DateTime carries
+// its zone, so the result is the real flight time rather than the naive difference of
+// the wall clocks. It returns an error wrapping ErrInvalidRange if arrival is before
+// departure, which usually means the arrival date or zone is wrong.
+//
+// Example:
+//
+//	dep := chronogo.Date(2024, time.June, 15, 22, 0, 0, 0, newYork)
+//	arr := chronogo.Date(2024, time.June, 16, 10, 0, 0, 0, london)
+//	d, _ := chronogo.TravelDuration(dep, arr) // 7h, not the 12h the clocks suggest
+func TravelDuration(departure, arrival DateTime) (ChronoDuration, error) {
+	if arrival.Before(departure) {
+		return ChronoDuration{}, &ChronoError{
+			Op:         "TravelDuration",
+			Path:       fmt.Sprintf("%s to %s", departure.Format(time.RFC3339), arrival.Format(time.RFC3339)),
+			Err:        fmt.Errorf("%w: arrival is before departure", ErrInvalidRange),
+			Suggestion: "Check the arrival date and that each time is in its own airport's zone",
+		}
+	}
+	return ChronoDuration{arrival.Sub(departure)}, nil
+}
+
+// ArrivalLocal returns the arrival time, in the destination's local zone, of a trip
+// that departs at departure and lasts d. A nil arrivalZone means UTC.
+//
+// Example:
+//
+//	dep := chronogo.Date(2024, time.June, 15, 22, 0, 0, 0, newYork)
+//	chronogo.ArrivalLocal(dep, 7*time.Hour, london) // 2024-06-16 10:00 BST
+func ArrivalLocal(departure DateTime, d time.Duration, arrivalZone *time.Location) DateTime {
+	if arrivalZone == nil {
+		arrivalZone = time.UTC
+	}
+	return departure.Add(d).In(arrivalZone)
+}
+
+// OffsetSeconds returns the datetime's offset from UTC in seconds (east of UTC is positive).
+func (dt DateTime) OffsetSeconds() int {
+	_, offset := dt.Zone()
+	return offset
+}
+
+// OffsetString returns the datetime's UTC offset formatted as "±HH:MM" (e.g. "-07:00").
+// UTC is returned as "+00:00".
+func (dt DateTime) OffsetString() string {
+	return formatOffset(dt.OffsetSeconds(), true)
+}
+
+// UTCOffset is a UTC offset broken into signed components.
+// Hours and Minutes share the sign of the offset, so -09:30 is {-34200, -9, -30}.
+type UTCOffset struct {
+	Seconds int // total seconds east of UTC
+	Hours   int // whole hours
+	Minutes int // remaining minutes
+}
+
+// String formats the offset as "±HH:MM".
+func (o UTCOffset) String() string {
+	return formatOffset(o.Seconds, true)
+}
+
+// InHours returns the offset as fractional hours (e.g. 5.5 for +05:30).
+func (o UTCOffset) InHours() float64 {
+	return float64(o.Seconds) / 3600
+}
+
+// UTCOffset returns the datetime's offset from UTC as structured components.
+//
+// Example:
+//
+//	off := dt.UTCOffset() // for +05:30: {Seconds: 19800, Hours: 5, Minutes: 30}
+func (dt DateTime) UTCOffset() UTCOffset {
+	seconds := dt.OffsetSeconds()
+	return UTCOffset{
+		Seconds: seconds,
+		Hours:   seconds / 3600,
+		Minutes: (seconds % 3600) / 60,
+	}
+}
+
+// ZoneName returns the name of the datetime's location, usually its IANA name
+// (e.g. "America/New_York"), "UTC" or "Local".
+func (dt DateTime) ZoneName() string {
+	return dt.Location().String()
+}
+
+// ZoneAbbr returns the abbreviation of the zone in effect at the datetime
+// (e.g. "EST" or "EDT"). Some locations only provide numeric names such as "+03".
+func (dt DateTime) ZoneAbbr() string {
+	name, _ := dt.Zone()
+	return name
+}
+
+// parseOffsetString parses a numeric UTC offset into seconds east of UTC.
+func parseOffsetString(offset string) (int, error) {
+	s := strings.TrimSpace(offset)
+	if s == "Z" || s == "z" {
+		return 0, nil
+	}
+	if len(s) < 2 || (s[0] != '+' && s[0] != '-') {
+		return 0, errors.New("offset must start with '+' or '-'")
+	}
+	sign := 1
+	if s[0] == '-' {
+		sign = -1
+	}
+	digits := strings.Replace(s[1:], ":", "", 1)
+	if strings.Contains(s[1:], ":") && len(digits) != 4 {
+		return 0, errors.New("offset must be in the form ±HH:MM")
+	}
+
+	var hours, minutes int
+	var err error
+	switch len(digits) {
+	case 2:
+		hours, err = strconv.Atoi(digits)
+	case 4:
+		hours, err = strconv.Atoi(digits[:2])
+		if err == nil {
+			minutes, err = strconv.Atoi(digits[2:])
+		}
+	default:
+		return 0, errors.New("offset must have 2 or 4 digits")
+	}
+	if err != nil || strings.ContainsAny(digits, "+-") {
+		return 0, errors.New("offset contains non-digit characters")
+	}
+	if minutes >= 60 {
+		return 0, fmt.Errorf("offset minutes %d out of range", minutes)
+	}
+
+	seconds := sign * (hours*3600 + minutes*60)
+	if seconds > maxOffsetSeconds || seconds < -maxOffsetSeconds {
+		return 0, fmt.Errorf("offset %s exceeds ±18:00", s)
+	}
+	return seconds, nil
+}
+
+// formatOffset formats seconds east of UTC as "±HH:MM" (or "±HHMM" without colon).
+func formatOffset(seconds int, colon bool) string {
+	sign := '+'
+	if seconds < 0 {
+		sign = '-'
+		seconds = -seconds
+	}
+	hours := seconds / 3600
+	minutes := (seconds % 3600) / 60
+	if colon {
+		return fmt.Sprintf("%c%02d:%02d", sign, hours, minutes)
+	}
+	return fmt.Sprintf("%c%02d%02d", sign, hours, minutes)
+}
+
+// ZoneAbbreviation describes one meaning of a time zone abbreviation such as "EST".
+type ZoneAbbreviation struct {
+	Abbr   string // abbreviation, e.g. "CST"
+	Offset int    // seconds east of UTC
+	Region string // ISO 3166 country code where the meaning is used, e.g. "US"
+	Name   string // descriptive name, e.g. "Central Standard Time"
+	DST    bool   // whether the abbreviation denotes daylight saving time
+}
+
+// Location returns a fixed-offset location named after the abbreviation.
+func (z ZoneAbbreviation) Location() *time.Location {
+	return time.FixedZone(z.Abbr, z.Offset)
+}
+
+// zoneAbbreviations lists known abbreviations. Ambiguous abbreviations have several
+// entries, the first being the default when no preferred region matches.
+var zoneAbbreviations = map[string][]ZoneAbbreviation{
+	"UTC": {{"UTC", 0, "", "Coordinated Universal Time", false}},
+	"GMT": {{"GMT", 0, "GB", "Greenwich Mean Time", false}},
+
+	// North America
+	"EST":  {{"EST", -5 * 3600, "US", "Eastern Standard Time", false}},
+	"EDT":  {{"EDT", -4 * 3600, "US", "Eastern Daylight Time", true}},
+	"CST":  {{"CST", -6 * 3600, "US", "Central Standard Time", false}, {"CST", 8 * 3600, "CN", "China Standard Time", false}, {"CST", -5 * 3600, "CU", "Cuba Standard Time", false}},
+	"CDT":  {{"CDT", -5 * 3600, "US", "Central Daylight Time", true}, {"CDT", -4 * 3600, "CU", "Cuba Daylight Time", true}},
+	"MST":  {{"MST", -7 * 3600, "US", "Mountain Standard Time", false}},
+	"MDT":  {{"MDT", -6 * 3600, "US", "Mountain Daylight Time", true}},
+	"PST":  {{"PST", -8 * 3600, "US", "Pacific Standard Time", false}},
+	"PDT":  {{"PDT", -7 * 3600, "US", "Pacific Daylight Time", true}},
+	"AKST": {{"AKST", -9 * 3600, "US", "Alaska Standard Time", false}},
+	"AKDT": {{"AKDT", -8 * 3600, "US", "Alaska Daylight Time", true}},
+	"HST":  {{"HST", -10 * 3600, "US", "Hawaii Standard Time", false}},
+	"AST":  {{"AST", -4 * 3600, "CA", "Atlantic Standard Time", false}, {"AST", 3 * 3600, "SA", "Arabia Standard Time", false}},
+	"ADT":  {{"ADT", -3 * 3600, "CA", "Atlantic Daylight Time", true}},
+	"NST":  {{"NST", -(3*3600 + 30*60), "CA", "Newfoundland Standard Time", false}},
+	"NDT":  {{"NDT", -(2*3600 + 30*60), "CA", "Newfoundland Daylight Time", true}},
+
+	// South America and Africa
+	"BRT":  {{"BRT", -3 * 3600, "BR", "Brasília Time", false}},
+	"ART":  {{"ART", -3 * 3600, "AR", "Argentina Time", false}},
+	"SAST": {{"SAST", 2 * 3600, "ZA", "South Africa Standard Time", false}},
+
+	// Europe
+	"WET":  {{"WET", 0, "PT", "Western European Time", false}},
+	"WEST": {{"WEST", 1 * 3600, "PT", "Western European Summer Time", true}},
+	"CET":  {{"CET", 1 * 3600, "DE", "Central European Time", false}},
+	"CEST": {{"CEST", 2 * 3600, "DE", "Central European Summer Time", true}},
+	"EET":  {{"EET", 2 * 3600, "GR", "Eastern European Time", false}},
+	"EEST": {{"EEST", 3 * 3600, "GR", "Eastern European Summer Time", true}},
+	"BST":  {{"BST", 1 * 3600, "GB", "British Summer Time", true}, {"BST", 6 * 3600, "BD", "Bangladesh Standard Time", false}},
+	"MSK":  {{"MSK", 3 * 3600, "RU", "Moscow Standard Time", false}},
+
+	// Asia and the Middle East
+	"IST": {{"IST", 5*3600 + 30*60, "IN", "India Standard Time", false}, {"IST", 1 * 3600, "IE", "Irish Standard Time", true}, {"IST", 2 * 3600, "IL", "Israel Standard Time", false}},
+	"IDT": {{"IDT", 3 * 3600, "IL", "Israel Daylight Time", true}},
+	"PKT": {{"PKT", 5 * 3600, "PK", "Pakistan Standard Time", false}},
+	"WIB": {{"WIB", 7 * 3600, "ID", "Western Indonesia Time", false}},
+	"HKT": {{"HKT", 8 * 3600, "HK", "Hong Kong Time", false}},
+	"SGT": {{"SGT", 8 * 3600, "SG", "Singapore Time", false}},
+	"JST": {{"JST", 9 * 3600, "JP", "Japan Standard Time", false}},
+	"KST": {{"KST", 9 * 3600, "KR", "Korea Standard Time", false}},
+
+	// Oceania
+	"AWST": {{"AWST", 8 * 3600, "AU", "Australian Western Standard Time", false}},
+	"ACST": {{"ACST", 9*3600 + 30*60, "AU", "Australian Central Standard Time", false}},
+	"ACDT": {{"ACDT", 10*3600 + 30*60, "AU", "Australian Central Daylight Time", true}},
+	"AEST": {{"AEST", 10 * 3600, "AU", "Australian Eastern Standard Time", false}},
+	"AEDT": {{"AEDT", 11 * 3600, "AU", "Australian Eastern Daylight Time", true}},
+	"NZST": {{"NZST", 12 * 3600, "NZ", "New Zealand Standard Time", false}},
+	"NZDT": {{"NZDT", 13 * 3600, "NZ", "New Zealand Daylight Time", true}},
+}
+
+// LookupZoneAbbreviation resolves a time zone abbreviation to a fixed UTC offset.
+// Ambiguous abbreviations ("CST", "IST", "BST", "AST") resolve to the meaning used in
+// preferredRegion (an ISO 3166 country code such as "CN") when one matches, otherwise
+// to the most common meaning. Lookups are case-insensitive.
+//
+// Example:
+//
+//	z, _ := chronogo.LookupZoneAbbreviation("IST", "")   // India, +05:30
+//	z, _ = chronogo.LookupZoneAbbreviation("IST", "IE")  // Ireland, +01:00
+//	loc := z.Location()
+func LookupZoneAbbreviation(abbr, preferredRegion string) (ZoneAbbreviation, bool) {
+	candidates := zoneAbbreviations[strings.ToUpper(abbr)]
+	if len(candidates) == 0 {
+		return ZoneAbbreviation{}, false
+	}
+	for _, z := range candidates {
+		if preferredRegion != "" && strings.EqualFold(z.Region, preferredRegion) {
+			return z, true
+		}
+	}
+	return candidates[0], true
+}
+
+// ZoneAbbreviationCandidates returns every known meaning of an abbreviation, most
+// common first. More than one result means the abbreviation is ambiguous.
+func ZoneAbbreviationCandidates(abbr string) []ZoneAbbreviation {
+	candidates := zoneAbbreviations[strings.ToUpper(abbr)]
+	return append([]ZoneAbbreviation(nil), candidates...)
+}
+
+// splitZoneAbbreviation detects a trailing upper-case zone abbreviation such as
+// "2024-06-15 14:30 EST" and returns the remaining input and the zone it denotes.
+func splitZoneAbbreviation(value, preferredRegion string) (string, *time.Location, bool) {
+	i := strings.LastIndexAny(value, " \t")
+	if i <= 0 {
+		return value, nil, false
+	}
+	abbr := value[i+1:]
+	if abbr != strings.ToUpper(abbr) {
+		return value, nil, false
+	}
+	z, ok := LookupZoneAbbreviation(abbr, preferredRegion)
+	if !ok {
+		return value, nil, false
+	}
+	return strings.TrimSpace(value[:i]), z.Location(), true
+}
@@ -0,0 +1,204 @@
+package chronogo
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestFixedZone(t *testing.T) {
+	loc := FixedZone("", 5*3600+30*60)
+	if loc.String() != "+05:30" {
+		t.Errorf("FixedZone name = %q, want %q", loc.String(), "+05:30")
+	}
+	dt := Date(2024, time.January, 15, 9, 0, 0, 0, loc)
+	if got := dt.UTC(); got.Hour() != 3 || got.Minute() != 30 {
+		t.Errorf("UTC conversion = %v, want 03:30", got)
+	}
+
+	named := FixedZone("IST", 19800)
+	if name, offset := Date(2024, time.January, 1, 0, 0, 0, 0, named).Zone(); name != "IST" || offset != 19800 {
+		t.Errorf("FixedZone(IST) zone = %s %d", name, offset)
+	}
+}
+
+func TestFromOffsetString(t *testing.T) {
+	tests := []struct {
+		input   string
+		seconds int
+		name    string
+	}{
+		{"+05:30", 19800, "+05:30"},
+		{"-07:00", -25200, "-07:00"},
+		{"+0530", 19800, "+05:30"},
+		{"-07", -25200, "-07:00"},
+		{"+14:00", 50400, "+14:00"},
+		{"Z", 0, "UTC"},
+		{"+00:00", 0, "UTC"},
+	}
+	for _, tt := range tests {
+		loc, err := FromOffsetString(tt.input)
+		if err != nil {
+			t.Errorf("FromOffsetString(%q) error: %v", tt.input, err)
+			continue
+		}
+		dt := Date(2024, time.June, 1, 12, 0, 0, 0, loc)
+		if dt.OffsetSeconds() != tt.seconds || loc.String() != tt.name {
+			t.Errorf("FromOffsetString(%q) = %s (%d), want %s (%d)", tt.input, loc, dt.OffsetSeconds(), tt.name, tt.seconds)
+		}
+	}
+
+	for _, input := range []string{"", "05:30", "+5:30", "+05:60", "+19:00", "+05:3a", "+0-30", "+05:30:00", "UTC"} {
+		if _, err := FromOffsetString(input); !errors.Is(err, ErrInvalidTimezone) {
+			t.Errorf("FromOffsetString(%q) error = %v, want ErrInvalidTimezone", input, err)
+		}
+	}
+}
+
+func TestOffsetAccessors(t *testing.T) {
+	dt := Date(2024, time.June, 1, 12, 0, 0, 0, time.FixedZone("", -7*3600))
+	if dt.OffsetSeconds() != -25200 {
+		t.Errorf("OffsetSeconds() = %d, want -25200", dt.OffsetSeconds())
+	}
+	if dt.OffsetString() != "-07:00" {
+		t.Errorf("OffsetString() = %q, want %q", dt.OffsetString(), "-07:00")
+	}
+	if got := UTC(2024, time.June, 1, 12, 0, 0, 0).OffsetString(); got != "+00:00" {
+		t.Errorf("UTC OffsetString() = %q, want %q", got, "+00:00")
+	}
+	if got := Date(2024, time.June, 1, 0, 0, 0, 0, FixedZone("", -(9*3600+30*60))).OffsetString(); got != "-09:30" {
+		t.Errorf("OffsetString() = %q, want %q", got, "-09:30")
+	}
+}
+
+func TestZoneAccessors(t *testing.T) {
+	ny, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Skip("America/New_York timezone not available")
+	}
+	winter := Date(2024, time.January, 15, 12, 0, 0, 0, ny)
+	summer := Date(2024, time.July, 15, 12, 0, 0, 0, ny)
+
+	if winter.ZoneName() != "America/New_York" {
+		t.Errorf("ZoneName() = %q", winter.ZoneName())
+	}
+	if winter.ZoneAbbr() != "EST" || summer.ZoneAbbr() != "EDT" {
+		t.Errorf("ZoneAbbr() = %q/%q, want EST/EDT", winter.ZoneAbbr(), summer.ZoneAbbr())
+	}
+	if off := summer.UTCOffset(); off != (UTCOffset{Seconds: -14400, Hours: -4, Minutes: 0}) {
+		t.Errorf("UTCOffset() = %+v", off)
+	}
+
+	off := Date(2024, time.June, 1, 0, 0, 0, 0, FixedZone("", -(9*3600+30*60))).UTCOffset()
+	if off.Hours != -9 || off.Minutes != -30 || off.String() != "-09:30" || off.InHours() != -9.5 {
+		t.Errorf("UTCOffset() = %+v (%s, %v)", off, off, off.InHours())
+	}
+	if UTC(2024, time.June, 1, 0, 0, 0, 0).ZoneName() != "UTC" {
+		t.Error("Expected ZoneName() of UTC datetime to be UTC")
+	}
+}
+
+func TestLookupZoneAbbreviation(t *testing.T) {
+	tests := []struct {
+		abbr, region string
+		offset       int
+	}{
+		{"EST", "", -5 * 3600},
+		{"pdt", "", -7 * 3600},
+		{"CEST", "", 2 * 3600},
+		{"IST", "", 5*3600 + 30*60},
+		{"IST", "IE", 3600},
+		{"IST", "il", 2 * 3600},
+		{"CST", "", -6 * 3600},
+		{"CST", "CN", 8 * 3600},
+		{"CST", "JP", -6 * 3600}, // no meaning for the region: default
+		{"NST", "", -(3*3600 + 30*60)},
+	}
+	for _, tt := range tests {
+		z, ok := LookupZoneAbbreviation(tt.abbr, tt.region)
+		if !ok || z.Offset != tt.offset {
+			t.Errorf("LookupZoneAbbreviation(%q, %q) = %+v, %v; want offset %d", tt.abbr, tt.region, z, ok, tt.offset)
+		}
+	}
+
+	if _, ok := LookupZoneAbbreviation("XYZ", ""); ok {
+		t.Error("Expected unknown abbreviation to fail")
+	}
+	if n := len(ZoneAbbreviationCandidates("IST")); n != 3 {
+		t.Errorf("ZoneAbbreviationCandidates(IST) = %d entries, want 3", n)
+	}
+	if n := len(ZoneAbbreviationCandidates("JST")); n != 1 {
+		t.Errorf("ZoneAbbreviationCandidates(JST) = %d entries, want 1", n)
+	}
+
+	z, _ := LookupZoneAbbreviation("EDT", "")
+	if name, offset := Date(2024, time.January, 1, 0, 0, 0, 0, z.Location()).Zone(); name != "EDT" || offset != -4*3600 {
+		t.Errorf("Location() zone = %s %d, want EDT -14400", name, offset)
+	}
+}
+
+func TestWithZoneSameLocal(t *testing.T) {
+	ny, _ := time.LoadLocation("America/New_York")
+	tokyo, _ := time.LoadLocation("Asia/Tokyo")
+
+	meeting := Date(2024, time.June, 15, 14, 30, 0, 0, ny)
+	got := meeting.WithZoneSameLocal(tokyo)
+	if !got.Equal(Date(2024, time.June, 15, 14, 30, 0, 0, tokyo)) || got.Location() != tokyo {
+		t.Errorf("WithZoneSameLocal(Tokyo) = %v, want 14:30 JST", got)
+	}
+	if got.Sub(meeting) != -13*time.Hour {
+		t.Errorf("WithZoneSameLocal should change the instant by the offset difference, got %v", got.Sub(meeting))
+	}
+	if in := meeting.In(tokyo); in.Hour() != 3 || !in.Equal(meeting) {
+		t.Errorf("In(Tokyo) = %v, want the same instant at 03:30", in)
+	}
+
+	// Wall-clock times in a DST gap move forward to the transition
+	gap := Date(2024, time.March, 10, 2, 30, 0, 0, time.UTC).WithZoneSameLocal(ny)
+	if gap.Hour() != 3 || gap.Minute() != 0 {
+		t.Errorf("WithZoneSameLocal into a DST gap = %v, want 03:00 EDT", gap)
+	}
+
+	if got := meeting.WithZoneSameLocal(nil); got.Location() != time.UTC || got.Hour() != 14 {
+		t.Errorf("WithZoneSameLocal(nil) = %v, want 14:30 UTC", got)
+	}
+}
+
+func TestTravelDuration(t *testing.T) {
+	ny, _ := time.LoadLocation("America/New_York")
+	london, _ := time.LoadLocation("Europe/London")
+	tokyo, _ := time.LoadLocation("Asia/Tokyo")
+
+	dep := Date(2024, time.June, 15, 22, 0, 0, 0, ny)
+	arr := Date(2024, time.June, 16, 10, 0, 0, 0, london)
+	d, err := TravelDuration(dep, arr)
+	if err != nil || d.Duration != 7*time.Hour {
+		t.Errorf("TravelDuration(NYC-LHR) = %v, %v, want 7h", d, err)
+	}
+
+	// Eastbound across the date line: arrival wall clock earlier than departure's
+	dep = Date(2024, time.June, 15, 13, 0, 0, 0, tokyo)
+	arr = Date(2024, time.June, 15, 12, 30, 0, 0, ny)
+	if d, err := TravelDuration(dep, arr); err != nil || d.Duration != 12*time.Hour+30*time.Minute {
+		t.Errorf("TravelDuration(HND-JFK) = %v, %v, want 12h30m", d, err)
+	}
+
+	_, err = TravelDuration(arr, dep)
+	if !errors.Is(err, ErrInvalidRange) {
+		t.Errorf("TravelDuration with arrival before departure error = %v, want ErrInvalidRange", err)
+	}
+}
+
+func TestArrivalLocal(t *testing.T) {
+	ny, _ := time.LoadLocation("America/New_York")
+	london, _ := time.LoadLocation("Europe/London")
+
+	dep := Date(2024, time.June, 15, 22, 0, 0, 0, ny)
+	got := ArrivalLocal(dep, 7*time.Hour, london)
+	if got.Location() != london || got.Day() != 16 || got.Hour() != 10 {
+		t.Errorf("ArrivalLocal() = %v, want 2024-06-16 10:00 London", got)
+	}
+	if got := ArrivalLocal(dep, time.Hour, nil); got.Location() != time.UTC || got.Hour() != 3 {
+		t.Errorf("ArrivalLocal(nil zone) = %v, want 03:00 UTC", got)
+	}
+}